@@ -62,9 +62,17 @@ func fname(prefix string, vu *detect.VersionedUnmarshaler, slot types.Slot, root
 // DownloadFinalizedData downloads the most recently finalized state, and the block most recently applied to that state.
 // This pair can be used to initialize a new beacon node via checkpoint sync.
 func DownloadFinalizedData(ctx context.Context, client *Client) (*OriginData, error) {
-	sb, err := client.GetState(ctx, IdFinalized)
+	sb, err := client.GetFinalizedCheckpointState(ctx)
 	if err != nil {
-		return nil, err
+		if !errors.Is(err, ErrNotOK) {
+			return nil, err
+		}
+		// fall back to the debug api for beacon nodes which do not serve checkpoint sync data
+		log.Print("checkpoint sync serving api not supported by server, requesting finalized state from the debug api")
+		sb, err = client.GetState(ctx, IdFinalized)
+		if err != nil {
+			return nil, err
+		}
 	}
 	vu, err := detect.FromState(sb)
 	if err != nil {
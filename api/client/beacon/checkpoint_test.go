@@ -484,4 +484,27 @@ func TestDownloadFinalizedData(t *testing.T) {
 	require.Equal(t, true, bytes.Equal(expected.bb, od.bb))
 	require.Equal(t, expected.br, od.br)
 	require.Equal(t, expected.sr, od.sr)
+
+	// beacon nodes serving checkpoint sync data do not need the debug api
+	c.hc = &http.Client{
+		Transport: &testRT{rt: func(req *http.Request) (*http.Response, error) {
+			res := &http.Response{Request: req}
+			switch req.URL.Path {
+			case getFinalizedCheckpointStatePath:
+				res.StatusCode = http.StatusOK
+				res.Body = io.NopCloser(bytes.NewBuffer(ms))
+			case renderGetBlockPath(IdFromRoot(br)):
+				res.StatusCode = http.StatusOK
+				res.Body = io.NopCloser(bytes.NewBuffer(mb))
+			default:
+				res.StatusCode = http.StatusNotFound
+				res.Body = io.NopCloser(bytes.NewBufferString(""))
+			}
+			return res, nil
+		}},
+	}
+	od, err = DownloadFinalizedData(ctx, c)
+	require.NoError(t, err)
+	require.Equal(t, true, bytes.Equal(expected.sb, od.sb))
+	require.Equal(t, true, bytes.Equal(expected.bb, od.bb))
 }
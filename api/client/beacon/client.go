@@ -35,6 +35,8 @@ const (
 	getForkSchedulePath     = "/eth/v1/config/fork_schedule"
 	getStatePath            = "/eth/v2/debug/beacon/states"
 	getNodeVersionPath      = "/eth/v1/node/version"
+
+	getFinalizedCheckpointStatePath = "/prysm/checkpoint/finalized/state"
 )
 
 // StateOrBlockId represents the block_id / state_id parameters that several of the Eth Beacon API methods accept.
@@ -311,6 +313,17 @@ func (c *Client) GetState(ctx context.Context, stateId StateOrBlockId) ([]byte,
 	return b, nil
 }
 
+// GetFinalizedCheckpointState retrieves the finalized BeaconState from the endpoint prysm beacon nodes serve
+// checkpoint sync data on, when started with --enable-checkpoint-sync-serving.
+// The return value contains the ssz-encoded bytes.
+func (c *Client) GetFinalizedCheckpointState(ctx context.Context) ([]byte, error) {
+	b, err := c.get(ctx, getFinalizedCheckpointStatePath, withSSZEncoding())
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting finalized checkpoint state")
+	}
+	return b, nil
+}
+
 // GetWeakSubjectivity calls a proposed API endpoint that is unique to prysm
 // This api method does the following:
 // - computes weak subjectivity epoch
@@ -0,0 +1,196 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/encoding/ssz/detect"
+	"github.com/prysmaticlabs/prysm/network/forks"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+var (
+	// ErrOriginBlockMismatch indicates that the checkpoint block is not the block most recently applied to the
+	// checkpoint state.
+	ErrOriginBlockMismatch = errors.New("checkpoint block does not match the latest block header of the checkpoint state")
+	// ErrOriginForkMismatch indicates that the fork version of the checkpoint state does not match the fork schedule
+	// of the configured network.
+	ErrOriginForkMismatch = errors.New("checkpoint state fork version does not match the configured network")
+	// ErrOriginOutsideWeakSubjectivityPeriod indicates that the checkpoint state is too old to safely sync from.
+	ErrOriginOutsideWeakSubjectivityPeriod = errors.New("checkpoint state is outside of the weak subjectivity period")
+	// ErrOriginGenesisMismatch indicates that the genesis validators root of the checkpoint state is not the one of
+	// the configured network.
+	ErrOriginGenesisMismatch = errors.New("checkpoint state genesis validators root does not match the configured network")
+	// ErrUnknownGenesisValidatorsRoot indicates that the genesis validators root of a network is not known.
+	ErrUnknownGenesisValidatorsRoot = errors.New("genesis validators root of the network is unknown")
+	// ErrOriginRootMismatch indicates that a trusted beacon node has a different canonical block at the slot of the
+	// checkpoint block.
+	ErrOriginRootMismatch = errors.New("checkpoint block root does not match the trusted beacon node")
+)
+
+// genesisValidatorsRoots holds the genesis validators root of the public networks, by config name.
+var genesisValidatorsRoots = map[string]string{
+	params.MainnetName: "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95",
+	params.PraterName:  "0x043db0d9a83813551ee2f33450d23797757d430911a9320530ad8a0eabc43efb",
+	params.RopstenName: "0x44f1e56283ca88b35c789f7f449e52339bc1fefe3a45913a43a6d16edcd33cf1",
+	params.SepoliaName: "0xd8ea171f3c94aea21ebc42a1ed61052acf3f9209c00e4efbaaddac09ed9b8078",
+}
+
+// GenesisValidatorsRoot returns the genesis validators root of the public network with the given config name.
+func GenesisValidatorsRoot(configName string) ([32]byte, error) {
+	h, ok := genesisValidatorsRoots[configName]
+	if !ok {
+		return [32]byte{}, errors.Wrapf(ErrUnknownGenesisValidatorsRoot, "network %s", configName)
+	}
+	return bytesutil.ToBytes32(hexutil.MustDecode(h)), nil
+}
+
+// OriginDataFromBytes unmarshals ssz-encoded checkpoint state and block values, for instance read from the files
+// written by OriginData.SaveState and OriginData.SaveBlock, so that they can be verified before being used to
+// initialize a beacon node.
+func OriginDataFromBytes(ctx context.Context, sb, bb []byte) (*OriginData, error) {
+	vu, err := detect.FromState(sb)
+	if err != nil {
+		return nil, errors.Wrap(err, "error detecting chain config for checkpoint state")
+	}
+	s, err := vu.UnmarshalBeaconState(sb)
+	if err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling checkpoint state to correct version")
+	}
+	sr, err := s.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to compute htr for checkpoint state at slot=%d", s.Slot())
+	}
+	// The state root in the latest block header is only filled in when the next slot is processed, so it is zero
+	// unless empty slots were applied to the state after the block.
+	header := s.LatestBlockHeader()
+	if bytes.Equal(header.StateRoot, params.BeaconConfig().ZeroHash[:]) {
+		header.StateRoot = sr[:]
+	}
+	br, err := header.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "error while computing block root using state data")
+	}
+	b, err := vu.UnmarshalBeaconBlock(bb)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to unmarshal checkpoint block to a supported type using the detected fork schedule")
+	}
+	return &OriginData{
+		st: s,
+		b:  b,
+		sb: sb,
+		bb: bb,
+		vu: vu,
+		br: br,
+		sr: sr,
+	}, nil
+}
+
+// BlockRoot returns the root of the block most recently applied to the checkpoint state.
+func (o *OriginData) BlockRoot() [32]byte {
+	return o.br
+}
+
+// VerifyBlock checks that the checkpoint block is the block most recently applied to the checkpoint state. This also
+// verifies the state root committed to by the block, as it is part of the block header.
+func (o *OriginData) VerifyBlock() error {
+	r, err := o.b.Block().HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "error computing hash_tree_root of checkpoint block")
+	}
+	if r != o.br {
+		return errors.Wrapf(ErrOriginBlockMismatch, "block slot=%d, htr=%#x, state latest_block_header htr=%#x", o.b.Block().Slot(), r, o.br)
+	}
+	return nil
+}
+
+// VerifyFork checks that the fork version of the checkpoint state is the one scheduled by the given config at the
+// epoch of the state.
+func (o *OriginData) VerifyFork(cfg *params.BeaconChainConfig) error {
+	epoch := slots.ToEpoch(o.st.Slot())
+	expected, err := forks.NewOrderedSchedule(cfg).VersionForEpoch(epoch)
+	if err != nil {
+		return errors.Wrapf(err, "unable to determine fork version of network %s at epoch %d", cfg.ConfigName, epoch)
+	}
+	current := o.st.Fork().CurrentVersion
+	if !bytes.Equal(current, expected[:]) {
+		return errors.Wrapf(ErrOriginForkMismatch, "state fork version=%#x (detected config=%s, fork=%s), network %s expects %#x at epoch %d",
+			current, o.vu.Config.ConfigName, version.String(o.vu.Fork), cfg.ConfigName, expected, epoch)
+	}
+	return nil
+}
+
+// VerifyGenesisValidatorsRoot checks that the genesis validators root of the checkpoint state is the expected one,
+// which identifies the network the state belongs to. Networks sharing a fork schedule, such as devnets forked from a
+// public network config, are told apart this way.
+func (o *OriginData) VerifyGenesisValidatorsRoot(expected [32]byte) error {
+	gvr := o.st.GenesisValidatorsRoot()
+	if !bytes.Equal(gvr, expected[:]) {
+		return errors.Wrapf(ErrOriginGenesisMismatch, "state genesis_validators_root=%#x, expected %#x", gvr, expected)
+	}
+	return nil
+}
+
+// VerifyWeakSubjectivityPeriod checks that the given current epoch is within the weak subjectivity period of the
+// checkpoint state.
+func (o *OriginData) VerifyWeakSubjectivityPeriod(ctx context.Context, current types.Epoch, cfg *params.BeaconChainConfig) error {
+	wsp, err := helpers.ComputeWeakSubjectivityPeriod(ctx, o.st, cfg)
+	if err != nil {
+		return errors.Wrap(err, "error computing the weak subjectivity period of checkpoint state")
+	}
+	epoch := slots.ToEpoch(o.st.Slot())
+	if current > epoch+wsp {
+		return errors.Wrapf(ErrOriginOutsideWeakSubjectivityPeriod, "state epoch=%d, period=%d epochs, current epoch=%d", epoch, wsp, current)
+	}
+	return nil
+}
+
+// CurrentEpoch returns the current epoch of the chain of the checkpoint state, based on its genesis time.
+func (o *OriginData) CurrentEpoch() types.Epoch {
+	return slots.ToEpoch(slots.CurrentSlot(o.st.GenesisTime()))
+}
+
+// VerifyWithClient checks that the beacon node behind the given client has the checkpoint block as its canonical
+// block at the slot of the block.
+func (o *OriginData) VerifyWithClient(ctx context.Context, client *Client) error {
+	slot := o.b.Block().Slot()
+	r, err := client.GetBlockRoot(ctx, IdFromSlot(slot))
+	if err != nil {
+		return err
+	}
+	if r != o.br {
+		return errors.Wrapf(ErrOriginRootMismatch, "%s has root=%#x at slot=%d, expected %#x", client.NodeURL(), r, slot, o.br)
+	}
+	return nil
+}
+
+// ReplaceBlock downloads the block most recently applied to the checkpoint state from the beacon node behind the
+// given client, and uses it as the checkpoint block. This repairs origin data with a mismatched block.
+func (o *OriginData) ReplaceBlock(ctx context.Context, client *Client) error {
+	bb, err := client.GetBlock(ctx, IdFromRoot(o.br))
+	if err != nil {
+		return errors.Wrapf(err, "error requesting block by root = %#x", o.br)
+	}
+	b, err := o.vu.UnmarshalBeaconBlock(bb)
+	if err != nil {
+		return errors.Wrap(err, "unable to unmarshal block to a supported type using the detected fork schedule")
+	}
+	r, err := b.Block().HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "error computing hash_tree_root of retrieved block")
+	}
+	if r != o.br {
+		return fmt.Errorf("%s returned block with root=%#x when requesting root=%#x", client.NodeURL(), r, o.br)
+	}
+	o.b = b
+	o.bb = bb
+	return nil
+}
@@ -0,0 +1,183 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+type testOrigin struct {
+	sb []byte
+	bb []byte
+	br [32]byte
+	// otherBlock is a valid block which is not the block of the state.
+	otherBlock []byte
+	epoch      types.Epoch
+}
+
+func setupTestOrigin(t *testing.T, cfg *params.BeaconChainConfig) *testOrigin {
+	ctx := context.Background()
+	epoch := cfg.AltairForkEpoch - 1
+	slot, err := slots.EpochStart(epoch)
+	require.NoError(t, err)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	fork, err := forkForEpoch(cfg, epoch)
+	require.NoError(t, err)
+	require.NoError(t, st.SetFork(fork))
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, populateValidators(cfg, st, 100, 32))
+	gvr, err := GenesisValidatorsRoot(params.MainnetName)
+	require.NoError(t, err)
+	require.NoError(t, st.SetGenesisValidatorsRoot(gvr[:]))
+
+	b, err := wrapper.WrappedSignedBeaconBlock(util.NewBeaconBlock())
+	require.NoError(t, err)
+	require.NoError(t, wrapper.SetBlockSlot(b, slot))
+	header, err := b.Header()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(header.Header))
+	sr, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	require.NoError(t, wrapper.SetBlockStateRoot(b, sr))
+	br, err := b.Block().HashTreeRoot()
+	require.NoError(t, err)
+	bb, err := b.MarshalSSZ()
+	require.NoError(t, err)
+
+	require.NoError(t, wrapper.SetBlockSlot(b, slot-1))
+	otherBlock, err := b.MarshalSSZ()
+	require.NoError(t, err)
+
+	sb, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	return &testOrigin{sb: sb, bb: bb, br: br, otherBlock: otherBlock, epoch: epoch}
+}
+
+func testClient(serve map[string][]byte) *Client {
+	hc := &http.Client{
+		Transport: &testRT{rt: func(req *http.Request) (*http.Response, error) {
+			res := &http.Response{Request: req}
+			body, ok := serve[req.URL.Path]
+			if !ok {
+				res.StatusCode = http.StatusNotFound
+				res.Body = io.NopCloser(bytes.NewBufferString(""))
+				return res, nil
+			}
+			res.StatusCode = http.StatusOK
+			res.Body = io.NopCloser(bytes.NewBuffer(body))
+			return res, nil
+		}},
+	}
+	return &Client{
+		hc:      hc,
+		baseURL: &url.URL{Host: "localhost:3500", Scheme: "http"},
+	}
+}
+
+func TestOriginData_VerifyBlock(t *testing.T) {
+	ctx := context.Background()
+	to := setupTestOrigin(t, params.MainnetConfig())
+
+	od, err := OriginDataFromBytes(ctx, to.sb, to.bb)
+	require.NoError(t, err)
+	require.Equal(t, to.br, od.BlockRoot())
+	require.NoError(t, od.VerifyBlock())
+
+	od, err = OriginDataFromBytes(ctx, to.sb, to.otherBlock)
+	require.NoError(t, err)
+	require.ErrorIs(t, od.VerifyBlock(), ErrOriginBlockMismatch)
+}
+
+func TestOriginData_VerifyFork(t *testing.T) {
+	ctx := context.Background()
+	to := setupTestOrigin(t, params.MainnetConfig())
+	od, err := OriginDataFromBytes(ctx, to.sb, to.bb)
+	require.NoError(t, err)
+
+	require.NoError(t, od.VerifyFork(params.MainnetConfig()))
+	require.ErrorIs(t, od.VerifyFork(params.PraterConfig()), ErrOriginForkMismatch)
+}
+
+func TestOriginData_VerifyGenesisValidatorsRoot(t *testing.T) {
+	ctx := context.Background()
+	to := setupTestOrigin(t, params.MainnetConfig())
+	od, err := OriginDataFromBytes(ctx, to.sb, to.bb)
+	require.NoError(t, err)
+
+	mainnet, err := GenesisValidatorsRoot(params.MainnetName)
+	require.NoError(t, err)
+	require.NoError(t, od.VerifyGenesisValidatorsRoot(mainnet))
+	prater, err := GenesisValidatorsRoot(params.PraterName)
+	require.NoError(t, err)
+	require.ErrorIs(t, od.VerifyGenesisValidatorsRoot(prater), ErrOriginGenesisMismatch)
+	_, err = GenesisValidatorsRoot(params.MinimalName)
+	require.ErrorIs(t, err, ErrUnknownGenesisValidatorsRoot)
+}
+
+func TestOriginData_VerifyWeakSubjectivityPeriod(t *testing.T) {
+	ctx := context.Background()
+	cfg := params.MainnetConfig()
+	to := setupTestOrigin(t, cfg)
+	od, err := OriginDataFromBytes(ctx, to.sb, to.bb)
+	require.NoError(t, err)
+
+	require.NoError(t, od.VerifyWeakSubjectivityPeriod(ctx, to.epoch, cfg))
+	require.NoError(t, od.VerifyWeakSubjectivityPeriod(ctx, to.epoch+cfg.MinValidatorWithdrawabilityDelay, cfg))
+	err = od.VerifyWeakSubjectivityPeriod(ctx, to.epoch+10*cfg.MinValidatorWithdrawabilityDelay, cfg)
+	require.ErrorIs(t, err, ErrOriginOutsideWeakSubjectivityPeriod)
+}
+
+func TestOriginData_VerifyWithClient(t *testing.T) {
+	ctx := context.Background()
+	to := setupTestOrigin(t, params.MainnetConfig())
+	od, err := OriginDataFromBytes(ctx, to.sb, to.bb)
+	require.NoError(t, err)
+	slot, err := slots.EpochStart(to.epoch)
+	require.NoError(t, err)
+	rootPath := getBlockRootTpl(IdFromSlot(slot))
+
+	c := testClient(map[string][]byte{
+		rootPath: []byte(fmt.Sprintf(`{"data":{"root":"%#x"}}`, to.br)),
+	})
+	require.NoError(t, od.VerifyWithClient(ctx, c))
+
+	c = testClient(map[string][]byte{
+		rootPath: []byte(fmt.Sprintf(`{"data":{"root":"%#x"}}`, [32]byte{0x01})),
+	})
+	require.ErrorIs(t, od.VerifyWithClient(ctx, c), ErrOriginRootMismatch)
+
+	c = testClient(map[string][]byte{})
+	require.ErrorIs(t, od.VerifyWithClient(ctx, c), ErrNotOK)
+}
+
+func TestOriginData_ReplaceBlock(t *testing.T) {
+	ctx := context.Background()
+	to := setupTestOrigin(t, params.MainnetConfig())
+	od, err := OriginDataFromBytes(ctx, to.sb, to.otherBlock)
+	require.NoError(t, err)
+	require.ErrorIs(t, od.VerifyBlock(), ErrOriginBlockMismatch)
+
+	c := testClient(map[string][]byte{
+		renderGetBlockPath(IdFromRoot(to.br)): to.otherBlock,
+	})
+	require.ErrorContains(t, "returned block with root", od.ReplaceBlock(ctx, c))
+
+	c = testClient(map[string][]byte{
+		renderGetBlockPath(IdFromRoot(to.br)): to.bb,
+	})
+	require.NoError(t, od.ReplaceBlock(ctx, c))
+	require.NoError(t, od.VerifyBlock())
+	require.Equal(t, true, bytes.Equal(to.bb, od.BlockBytes()))
+}
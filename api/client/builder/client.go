@@ -28,6 +28,10 @@ const (
 	postRegisterValidatorPath  = "/eth/v1/builder/validators"
 )
 
+// RelaysMetadataKey is the gRPC metadata key with which a validator client passes the builder relays of the validators
+// of a registration request to the beacon node. Each value of the key is the URL of one relay.
+const RelaysMetadataKey = "prysm-builder-relays"
+
 var errMalformedHostname = errors.New("hostname must include port, separated by one colon, like example.com:3500")
 var errMalformedRequest = errors.New("required request data are missing")
 
@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
@@ -18,6 +21,8 @@ import (
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
 	"github.com/prysmaticlabs/prysm/time/slots"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -65,11 +70,27 @@ func (s *Service) notifyForkchoiceUpdate(ctx context.Context, arg *notifyForkcho
 	}
 
 	nextSlot := s.CurrentSlot() + 1 // Cache payload ID for next slot proposer.
-	hasAttr, attr, proposerId, err := s.getPayloadAttribute(ctx, arg.headState, nextSlot)
+	// The payload attributes of the next slot are sent to the event stream unless the head is too far behind to
+	// process its state to the next slot, which is the case during initial sync.
+	notifyAttr := nextSlot <= arg.headState.Slot()+params.BeaconConfig().SlotsPerEpoch
+	proposalState := arg.headState
+	if notifyAttr {
+		proposalState, err = transition.ProcessSlotsIfPossible(ctx, arg.headState.Copy(), nextSlot)
+		if err != nil {
+			log.WithError(err).Error("Could not process head state to next slot")
+			return nil, nil
+		}
+	}
+	hasAttr, attr, proposerId, err := s.getPayloadAttribute(ctx, proposalState, nextSlot)
 	if err != nil {
 		log.WithError(err).Error("Could not get head payload attribute")
 		return nil, nil
 	}
+	if notifyAttr {
+		if err := s.notifyPayloadAttributes(ctx, arg, proposalState, headPayload, nextSlot, attr); err != nil {
+			log.WithError(err).Error("Could not notify payload attributes")
+		}
+	}
 
 	payloadID, lastValidHash, err := s.cfg.ExecutionEngineCaller.ForkchoiceUpdated(ctx, fcs, attr)
 	if err != nil {
@@ -280,15 +301,35 @@ func (s *Service) getPayloadAttribute(ctx context.Context, st state.BeaconState,
 		return false, nil, 0, nil
 	}
 
-	// Get previous randao.
+	// Process the state to the proposal slot.
 	st = st.Copy()
 	st, err := transition.ProcessSlotsIfPossible(ctx, st, slot)
 	if err != nil {
 		return false, nil, 0, err
 	}
+	attr, err := s.payloadAttribute(ctx, st, slot, proposerID)
+	if err != nil {
+		return false, nil, 0, err
+	}
+	if common.BytesToAddress(attr.SuggestedFeeRecipient).String() == params.BeaconConfig().EthBurnAddressHex {
+		logrus.WithFields(logrus.Fields{
+			"validatorIndex": proposerID,
+			"burnAddress":    params.BeaconConfig().EthBurnAddressHex,
+		}).Warn("Fee recipient is currently using the burn address, " +
+			"you will not be rewarded transaction fees on this setting. " +
+			"Please set a different eth address as the fee recipient. " +
+			"Please refer to our documentation for instructions")
+	}
+	return true, attr, proposerID, nil
+}
+
+// payloadAttribute builds the payload attributes of the proposal of the given validator at the given slot, from a
+// state processed to that slot. The fee recipient of the validator defaults to the one of the node.
+func (s *Service) payloadAttribute(ctx context.Context, st state.BeaconState, slot types.Slot, proposerID types.ValidatorIndex) (*enginev1.PayloadAttributes, error) {
+	// Get previous randao.
 	prevRando, err := helpers.RandaoMix(st, time.CurrentEpoch(st))
 	if err != nil {
-		return false, nil, 0, nil
+		return nil, err
 	}
 
 	// Get fee recipient.
@@ -296,17 +337,8 @@ func (s *Service) getPayloadAttribute(ctx context.Context, st state.BeaconState,
 	recipient, err := s.cfg.BeaconDB.FeeRecipientByValidatorID(ctx, proposerID)
 	switch {
 	case errors.Is(err, kv.ErrNotFoundFeeRecipient):
-		if feeRecipient.String() == params.BeaconConfig().EthBurnAddressHex {
-			logrus.WithFields(logrus.Fields{
-				"validatorIndex": proposerID,
-				"burnAddress":    params.BeaconConfig().EthBurnAddressHex,
-			}).Warn("Fee recipient is currently using the burn address, " +
-				"you will not be rewarded transaction fees on this setting. " +
-				"Please set a different eth address as the fee recipient. " +
-				"Please refer to our documentation for instructions")
-		}
 	case err != nil:
-		return false, nil, 0, errors.Wrap(err, "could not get fee recipient in db")
+		return nil, errors.Wrap(err, "could not get fee recipient in db")
 	default:
 		feeRecipient = recipient
 	}
@@ -314,14 +346,56 @@ func (s *Service) getPayloadAttribute(ctx context.Context, st state.BeaconState,
 	// Get timestamp.
 	t, err := slots.ToTime(uint64(s.genesisTime.Unix()), slot)
 	if err != nil {
-		return false, nil, 0, err
+		return nil, err
 	}
-	attr := &enginev1.PayloadAttributes{
+	return &enginev1.PayloadAttributes{
 		Timestamp:             uint64(t.Unix()),
 		PrevRandao:            prevRando,
 		SuggestedFeeRecipient: feeRecipient.Bytes(),
+	}, nil
+}
+
+// notifyPayloadAttributes sends the payload attributes of the proposal at the given slot on the state feed, so that
+// external block builders can build on top of the same head. The state must be processed to the proposal slot. The
+// attributes the execution engine is asked to build a payload with are given for the proposers of this node, and are
+// computed for any other proposer.
+func (s *Service) notifyPayloadAttributes(
+	ctx context.Context,
+	arg *notifyForkchoiceUpdateArg,
+	st state.BeaconState,
+	headPayload interfaces.ExecutionData,
+	slot types.Slot,
+	attr *enginev1.PayloadAttributes,
+) error {
+	proposerID, err := helpers.BeaconProposerIndex(ctx, st)
+	if err != nil {
+		return errors.Wrap(err, "could not get proposer index")
 	}
-	return true, attr, proposerID, nil
+	if attr == nil {
+		attr, err = s.payloadAttribute(ctx, st, slot, proposerID)
+		if err != nil {
+			return err
+		}
+	}
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.PayloadAttributes,
+		Data: &ethpbv1.EventPayloadAttributes{
+			Version: version.String(st.Version()),
+			Data: &ethpbv1.EventPayloadAttributes_BasicPayloadAttributes{
+				ProposalSlot:      slot,
+				ProposerIndex:     proposerID,
+				ParentBlockRoot:   bytesutil.SafeCopyBytes(arg.headRoot[:]),
+				ParentBlockNumber: headPayload.BlockNumber(),
+				ParentBlockHash:   bytesutil.SafeCopyBytes(headPayload.BlockHash()),
+				PayloadAttributes: &ethpbv1.EventPayloadAttributes_PayloadAttributes{
+					Timestamp:             attr.Timestamp,
+					PrevRandao:            bytesutil.SafeCopyBytes(attr.PrevRandao),
+					SuggestedFeeRecipient: bytesutil.SafeCopyBytes(attr.SuggestedFeeRecipient),
+				},
+			},
+		},
+	})
+	return nil
 }
 
 // removeInvalidBlockAndState removes the invalid block and its corresponding state from the cache and DB.
@@ -7,8 +7,12 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/doubly-linked-tree"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
@@ -24,6 +28,7 @@ import (
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	v1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
@@ -47,6 +52,7 @@ func Test_NotifyForkchoiceUpdate(t *testing.T) {
 		WithStateGen(stategen.New(beaconDB)),
 		WithForkChoiceStore(fcs),
 		WithProposerIdsCache(cache.NewProposerPayloadIDsCache()),
+		WithStateNotifier(&mock.MockStateNotifier{}),
 	}
 	service, err := NewService(ctx, opts...)
 	st, _ := util.DeterministicGenesisState(t, 10)
@@ -973,6 +979,97 @@ func Test_GetPayloadAttribute(t *testing.T) {
 	require.Equal(t, suggestedAddr, common.BytesToAddress(attr.SuggestedFeeRecipient))
 }
 
+func Test_NotifyPayloadAttributes(t *testing.T) {
+	ctx := context.Background()
+	notifier := &mock.MockStateNotifier{RecordEvents: true}
+	beaconDB := testDB.SetupDB(t)
+	service, err := NewService(ctx, WithDatabase(beaconDB), WithStateGen(stategen.New(beaconDB)), WithStateNotifier(notifier))
+	require.NoError(t, err)
+	st, _ := util.DeterministicGenesisStateBellatrix(t, 64)
+	proposerID, err := helpers.BeaconProposerIndex(ctx, st)
+	require.NoError(t, err)
+	payload, err := wrapper.WrappedExecutionPayload(&v1.ExecutionPayload{
+		BlockNumber: 3,
+		BlockHash:   bytesutil.PadTo([]byte("hash"), fieldparams.RootLength),
+	})
+	require.NoError(t, err)
+	attr := &v1.PayloadAttributes{
+		Timestamp:             12,
+		PrevRandao:            bytesutil.PadTo([]byte("randao"), fieldparams.RootLength),
+		SuggestedFeeRecipient: bytesutil.PadTo([]byte("recipient"), fieldparams.FeeRecipientLength),
+	}
+	arg := &notifyForkchoiceUpdateArg{headState: st, headRoot: [32]byte{'a'}}
+
+	// Proposer of this node.
+	require.NoError(t, service.notifyPayloadAttributes(ctx, arg, st, payload, 0, attr))
+	events := notifier.ReceivedEvents()
+	require.Equal(t, 1, len(events))
+	require.Equal(t, statefeed.PayloadAttributes, int(events[0].Type))
+	eventAttr, ok := events[0].Data.(*ethpbv1.EventPayloadAttributes)
+	require.Equal(t, true, ok)
+	wanted := &ethpbv1.EventPayloadAttributes{
+		Version: "bellatrix",
+		Data: &ethpbv1.EventPayloadAttributes_BasicPayloadAttributes{
+			ProposalSlot:      0,
+			ProposerIndex:     proposerID,
+			ParentBlockRoot:   arg.headRoot[:],
+			ParentBlockNumber: 3,
+			ParentBlockHash:   payload.BlockHash(),
+			PayloadAttributes: &ethpbv1.EventPayloadAttributes_PayloadAttributes{
+				Timestamp:             12,
+				PrevRandao:            attr.PrevRandao,
+				SuggestedFeeRecipient: attr.SuggestedFeeRecipient,
+			},
+		},
+	}
+	require.DeepEqual(t, wanted, eventAttr)
+
+	// Proposer of another node.
+	suggestedAddr := common.HexToAddress("123")
+	require.NoError(t, service.cfg.BeaconDB.SaveFeeRecipientsByValidatorIDs(ctx, []types.ValidatorIndex{proposerID}, []common.Address{suggestedAddr}))
+	notifier = &mock.MockStateNotifier{RecordEvents: true}
+	service.cfg.StateNotifier = notifier
+	require.NoError(t, service.notifyPayloadAttributes(ctx, arg, st, payload, 0, nil))
+	events = notifier.ReceivedEvents()
+	require.Equal(t, 1, len(events))
+	eventAttr, ok = events[0].Data.(*ethpbv1.EventPayloadAttributes)
+	require.Equal(t, true, ok)
+	randao, err := helpers.RandaoMix(st, 0)
+	require.NoError(t, err)
+	require.Equal(t, proposerID, eventAttr.Data.ProposerIndex)
+	require.DeepEqual(t, randao, eventAttr.Data.PayloadAttributes.PrevRandao)
+	require.Equal(t, suggestedAddr, common.BytesToAddress(eventAttr.Data.PayloadAttributes.SuggestedFeeRecipient))
+}
+
+func Test_NotifyPayloadAttributes_ProposalSlotFork(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.BellatrixForkEpoch = 1
+	params.OverrideBeaconConfig(cfg)
+
+	ctx := context.Background()
+	notifier := &mock.MockStateNotifier{RecordEvents: true}
+	beaconDB := testDB.SetupDB(t)
+	service, err := NewService(ctx, WithDatabase(beaconDB), WithStateGen(stategen.New(beaconDB)), WithStateNotifier(notifier))
+	require.NoError(t, err)
+	headState, _ := util.DeterministicGenesisStateAltair(t, 64)
+	require.NoError(t, headState.SetSlot(params.BeaconConfig().SlotsPerEpoch-1))
+	slot := params.BeaconConfig().SlotsPerEpoch
+	st, err := transition.ProcessSlots(ctx, headState.Copy(), slot)
+	require.NoError(t, err)
+	payload, err := wrapper.WrappedExecutionPayload(&v1.ExecutionPayload{})
+	require.NoError(t, err)
+	arg := &notifyForkchoiceUpdateArg{headState: headState, headRoot: [32]byte{'a'}}
+
+	require.NoError(t, service.notifyPayloadAttributes(ctx, arg, st, payload, slot, nil))
+	events := notifier.ReceivedEvents()
+	require.Equal(t, 1, len(events))
+	eventAttr, ok := events[0].Data.(*ethpbv1.EventPayloadAttributes)
+	require.Equal(t, true, ok)
+	require.Equal(t, "bellatrix", eventAttr.Version)
+	require.Equal(t, slot, eventAttr.Data.ProposalSlot)
+}
+
 func Test_UpdateLastValidatedCheckpoint(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 	params.OverrideBeaconConfig(params.MainnetConfig())
@@ -27,6 +27,20 @@ var (
 			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
 		},
 	)
+	getHeaderRelayFailures = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "builder_relay_get_header_failure_count",
+			Help: "The number of get header requests to a relay which failed or returned an invalid bid",
+		},
+		[]string{"relay"},
+	)
+	submitBlindedBlockRelayFailures = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "builder_relay_submit_blinded_block_failure_count",
+			Help: "The number of blinded block submissions to a relay which failed",
+		},
+		[]string{"relay"},
+	)
 	registerValidatorLatency = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "register_validator_latency_milliseconds",
@@ -13,17 +13,22 @@ type Option func(s *Service) error
 
 // FlagOptions for builder service flag configurations.
 func FlagOptions(c *cli.Context) ([]Option, error) {
-	endpoint := c.String(flags.MevRelayEndpoint.Name)
 	opts := []Option{
-		WithBuilderEndpoints(endpoint),
+		WithBuilderEndpoints(c.String(flags.MevRelayEndpoint.Name)),
 	}
 	return opts, nil
 }
 
-// WithBuilderEndpoints sets the endpoint for the beacon chain builder service.
-func WithBuilderEndpoints(endpoint string) Option {
+// WithBuilderEndpoints sets the default relay endpoints for the beacon chain builder service. They serve the
+// validators which did not register with relays of their own.
+func WithBuilderEndpoints(endpoints ...string) Option {
 	return func(s *Service) error {
-		s.cfg.builderEndpoint = covertEndPoint(endpoint)
+		for _, endpoint := range endpoints {
+			if endpoint == "" {
+				continue
+			}
+			s.cfg.builderEndpoints = append(s.cfg.builderEndpoints, covertEndPoint(endpoint))
+		}
 		return nil
 	}
 }
@@ -1,14 +1,22 @@
 package builder
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"math/big"
+	"net/url"
+	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/api/client/builder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/network"
@@ -18,55 +26,132 @@ import (
 	"go.opencensus.io/trace"
 )
 
+// getHeaderTimeout is the maximum amount of time the service waits for relays to return a header.
+// Relays which have not answered by then are left out of the auction.
+const getHeaderTimeout = 950 * time.Millisecond
+
+// winningRelayRetention is the number of slots for which the relay that provided the winning bid
+// is remembered, so that the blinded block can be submitted back to it.
+const winningRelayRetention = types.Slot(2)
+
+var (
+	// ErrNoBuilderBid is returned when none of the configured relays returned a valid bid.
+	ErrNoBuilderBid = errors.New("no valid bid received from any builder relay")
+	// ErrNoRelayAvailable is returned when none of the configured relays could serve a request.
+	ErrNoRelayAvailable = errors.New("no builder relay available")
+	// ErrUnpinnedRelay is returned when a validator registers with a relay whose URL does not pin the builder
+	// public key of the relay.
+	ErrUnpinnedRelay = errors.New("relay URL must pin the relay public key, like https://<pubkey>@host")
+)
+
 // BlockBuilder defines the interface for interacting with the block builder
 type BlockBuilder interface {
 	SubmitBlindedBlock(ctx context.Context, block *ethpb.SignedBlindedBeaconBlockBellatrix) (*v1.ExecutionPayload, error)
 	GetHeader(ctx context.Context, slot types.Slot, parentHash [32]byte, pubKey [48]byte) (*ethpb.SignedBuilderBid, error)
-	RegisterValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error
+	RegisterValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1, relays []string) error
 	Configured() bool
 }
 
 // config defines a config struct for dependencies into the service.
 type config struct {
-	builderEndpoint network.Endpoint
-	beaconDB        db.HeadAccessDatabase
-	headFetcher     blockchain.HeadFetcher
+	builderEndpoints []network.Endpoint
+	beaconDB         db.HeadAccessDatabase
+	headFetcher      blockchain.HeadFetcher
+}
+
+// relay is a builder relay bids are requested from.
+type relay struct {
+	client *builder.Client
+	// pubKey is the builder public key pinned by the relay URL, `https://<pubkey>@host`. Bids of the relay must be
+	// signed with it. It is nil for default relays which do not pin a key.
+	pubKey []byte
+}
+
+// newRelay creates a relay for the given URL. The public key pinned in the user info of the URL is stripped from the
+// URL requests are sent to.
+func newRelay(endpoint string) (*relay, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.User == nil || u.Host == "" {
+		c, err := builder.NewClient(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		return &relay{client: c}, nil
+	}
+	pubKey, err := hexutil.Decode(u.User.Username())
+	if err != nil || len(pubKey) != fieldparams.BLSPubkeyLength {
+		return nil, fmt.Errorf("invalid relay public key %q in relay URL", u.User.Username())
+	}
+	u.User = nil
+	c, err := builder.NewClient(u.String())
+	if err != nil {
+		return nil, err
+	}
+	return &relay{client: c, pubKey: pubKey}, nil
+}
+
+// winningRelay tracks the relay which provided the highest bid for a slot.
+type winningRelay struct {
+	slot  types.Slot
+	relay *relay
 }
 
 // Service defines a service that provides a client for interacting with the beacon chain and MEV relay network.
+// Validators which registered with relays of their own, through their proposer settings, get their bids from those
+// relays. The other validators use the default relays of the node.
 type Service struct {
-	cfg    *config
-	c      *builder.Client
-	ctx    context.Context
-	cancel context.CancelFunc
+	cfg             *config
+	clients         []*relay
+	relays          map[string]*relay
+	validatorRelays map[[fieldparams.BLSPubkeyLength]byte][]*relay
+	relaysLock      sync.RWMutex
+	winners         map[[32]byte]*winningRelay
+	winnersLock     sync.RWMutex
+	ctx             context.Context
+	cancel          context.CancelFunc
 }
 
 // NewService instantiates a new service.
 func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	s := &Service{
-		ctx:    ctx,
-		cancel: cancel,
-		cfg:    &config{},
+		ctx:             ctx,
+		cancel:          cancel,
+		cfg:             &config{},
+		relays:          make(map[string]*relay),
+		validatorRelays: make(map[[fieldparams.BLSPubkeyLength]byte][]*relay),
+		winners:         make(map[[32]byte]*winningRelay),
 	}
 	for _, opt := range opts {
 		if err := opt(s); err != nil {
 			return nil, err
 		}
 	}
-	if s.cfg.builderEndpoint.Url != "" {
-		c, err := builder.NewClient(s.cfg.builderEndpoint.Url)
+	if len(s.cfg.builderEndpoints) == 0 {
+		return s, nil
+	}
+
+	var lastErr error
+	healthy := 0
+	for _, endpoint := range s.cfg.builderEndpoints {
+		r, err := newRelay(endpoint.Url)
 		if err != nil {
 			return nil, err
 		}
-		s.c = c
+		s.clients = append(s.clients, r)
 
 		// Is the builder up?
-		if err := s.c.Status(ctx); err != nil {
-			return nil, fmt.Errorf("could not connect to builder: %v", err)
+		if err := r.client.Status(ctx); err != nil {
+			lastErr = err
+			log.WithError(err).WithField("endpoint", r.client.NodeURL()).Warn("Could not connect to builder relay")
+			continue
 		}
-
-		log.WithField("endpoint", c.NodeURL()).Info("Builder has been configured")
+		healthy++
+		log.WithField("endpoint", r.client.NodeURL()).Info("Builder has been configured")
+	}
+	// Unreachable relays are tolerated as long as at least one of them is up.
+	if healthy == 0 {
+		return nil, fmt.Errorf("could not connect to builder: %v", lastErr)
 	}
 	return s, nil
 }
@@ -79,7 +164,9 @@ func (*Service) Stop() error {
 	return nil
 }
 
-// SubmitBlindedBlock submits a blinded block to the builder relay network.
+// SubmitBlindedBlock submits a blinded block to the builder relay network. The block is routed to the relay
+// which provided the winning bid for its payload header. If that relay is unknown, every relay of the proposer is
+// tried in turn until one of them returns the payload.
 func (s *Service) SubmitBlindedBlock(ctx context.Context, b *ethpb.SignedBlindedBeaconBlockBellatrix) (*v1.ExecutionPayload, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.SubmitBlindedBlock")
 	defer span.End()
@@ -88,10 +175,42 @@ func (s *Service) SubmitBlindedBlock(ctx context.Context, b *ethpb.SignedBlinded
 		submitBlindedBlockLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
 
-	return s.c.SubmitBlindedBlock(ctx, b)
+	if b == nil || b.Block == nil || b.Block.Body == nil || b.Block.Body.ExecutionPayloadHeader == nil {
+		return nil, errors.New("nil blinded block")
+	}
+	if len(s.clients) == 0 {
+		return nil, ErrNoRelayAvailable
+	}
+
+	var relays []*relay
+	if winner, ok := s.winningRelay(bytesutil.ToBytes32(b.Block.Body.ExecutionPayloadHeader.BlockHash)); ok {
+		relays = []*relay{winner}
+	} else {
+		relays = s.clients
+		if s.cfg.headFetcher != nil {
+			pubKey, err := s.cfg.headFetcher.HeadValidatorIndexToPublicKey(ctx, b.Block.ProposerIndex)
+			if err == nil {
+				relays = s.relaysFor(pubKey)
+			}
+		}
+	}
+	var lastErr error
+	for _, r := range relays {
+		payload, err := r.client.SubmitBlindedBlock(ctx, b)
+		if err != nil {
+			lastErr = err
+			submitBlindedBlockRelayFailures.WithLabelValues(r.client.NodeURL()).Inc()
+			log.WithError(err).WithField("relay", r.client.NodeURL()).Warn("Could not submit blinded block to relay")
+			continue
+		}
+		return payload, nil
+	}
+	return nil, lastErr
 }
 
 // GetHeader retrieves the header for a given slot and parent hash from the builder relay network.
+// All relays of the proposer are queried in parallel, bids with an invalid signature, or signed by another
+// builder than the one pinned by the relay URL, are discarded and the highest valid bid is returned.
 func (s *Service) GetHeader(ctx context.Context, slot types.Slot, parentHash [32]byte, pubKey [48]byte) (*ethpb.SignedBuilderBid, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.GetHeader")
 	defer span.End()
@@ -100,10 +219,61 @@ func (s *Service) GetHeader(ctx context.Context, slot types.Slot, parentHash [32
 		getHeaderLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
 
-	return s.c.GetHeader(ctx, slot, parentHash, pubKey)
+	ctx, cancel := context.WithTimeout(ctx, getHeaderTimeout)
+	defer cancel()
+
+	type relayBid struct {
+		relay *relay
+		bid   *ethpb.SignedBuilderBid
+		err   error
+	}
+	relays := s.relaysFor(pubKey)
+	bids := make(chan *relayBid, len(relays))
+	for _, r := range relays {
+		go func(r *relay) {
+			bid, err := r.client.GetHeader(ctx, slot, parentHash, pubKey)
+			if err == nil {
+				err = validateBid(bid, parentHash, r.pubKey)
+			}
+			bids <- &relayBid{relay: r, bid: bid, err: err}
+		}(r)
+	}
+
+	var best *relayBid
+	var bestValue *big.Int
+	for i := 0; i < len(relays); i++ {
+		rb := <-bids
+		if rb.err != nil {
+			getHeaderRelayFailures.WithLabelValues(rb.relay.client.NodeURL()).Inc()
+			log.WithError(rb.err).WithField("relay", rb.relay.client.NodeURL()).Warn("Could not get header from relay")
+			continue
+		}
+		v := bidValue(rb.bid)
+		log.WithFields(log.Fields{
+			"relay": rb.relay.client.NodeURL(),
+			"slot":  slot,
+			"value": v.String(),
+		}).Debug("Received bid from relay")
+		if best == nil || v.Cmp(bestValue) > 0 {
+			best = rb
+			bestValue = v
+		}
+	}
+	if best == nil {
+		return nil, ErrNoBuilderBid
+	}
+
+	s.setWinningRelay(slot, bytesutil.ToBytes32(best.bid.Message.Header.BlockHash), best.relay)
+	log.WithFields(log.Fields{
+		"relay": best.relay.client.NodeURL(),
+		"slot":  slot,
+		"value": bestValue.String(),
+	}).Debug("Selected winning bid")
+	return best.bid, nil
 }
 
 // Status retrieves the status of the builder relay network.
+// The network is considered healthy as long as one of the default relays is.
 func (s *Service) Status() error {
 	ctx, span := trace.StartSpan(context.Background(), "builder.Status")
 	defer span.End()
@@ -113,16 +283,25 @@ func (s *Service) Status() error {
 	}()
 
 	// Return early if builder isn't initialized in service.
-	if s.c == nil {
+	if len(s.clients) == 0 {
 		return nil
 	}
 
-	return s.c.Status(ctx)
+	var lastErr error
+	for _, r := range s.clients {
+		if err := r.client.Status(ctx); err != nil {
+			lastErr = err
+			continue
+		}
+		return nil
+	}
+	return lastErr
 }
 
-// RegisterValidator registers a validator with the builder relay network.
-// It also saves the registration object to the DB.
-func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error {
+// RegisterValidator registers validators with the given relays, which are the relays of their proposer settings,
+// or with the default relays of the node if none are given. The relays are then used for the proposals of the
+// validators. It also saves the registration object to the DB.
+func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1, relayURLs []string) error {
 	ctx, span := trace.StartSpan(ctx, "builder.RegisterValidator")
 	defer span.End()
 	start := time.Now()
@@ -146,8 +325,35 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 		msgs = append(msgs, r.Message)
 		valid = append(valid, r)
 	}
-	if err := s.c.RegisterValidator(ctx, valid); err != nil {
-		return errors.Wrap(err, "could not register validator(s)")
+
+	relays, err := s.setValidatorRelays(valid, relayURLs)
+	if err != nil {
+		return errors.Wrap(err, "could not set validator relays")
+	}
+	var wg sync.WaitGroup
+	errs := make([]error, len(relays))
+	for i, r := range relays {
+		wg.Add(1)
+		go func(i int, c *builder.Client) {
+			defer wg.Done()
+			if err := c.RegisterValidator(ctx, valid); err != nil {
+				log.WithError(err).WithField("relay", c.NodeURL()).Warn("Could not register validator(s) with relay")
+				errs[i] = err
+			}
+		}(i, r.client)
+	}
+	wg.Wait()
+	registered := false
+	var lastErr error
+	for _, err := range errs {
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		registered = true
+	}
+	if !registered && lastErr != nil {
+		return errors.Wrap(lastErr, "could not register validator(s)")
 	}
 
 	return s.cfg.beaconDB.SaveRegistrationsByValidatorIDs(ctx, idxs, msgs)
@@ -155,5 +361,96 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 
 // Configured returns true if the user has input a builder URL.
 func (s *Service) Configured() bool {
-	return s.cfg.builderEndpoint.Url != ""
+	return len(s.cfg.builderEndpoints) > 0
+}
+
+// setValidatorRelays records the relays of the given URLs as the relays of the validators of the registrations and
+// returns them. Validators registering without relays are reset to the default relays of the node.
+func (s *Service) setValidatorRelays(reg []*ethpb.SignedValidatorRegistrationV1, relayURLs []string) ([]*relay, error) {
+	s.relaysLock.Lock()
+	defer s.relaysLock.Unlock()
+	if len(relayURLs) == 0 {
+		for _, r := range reg {
+			delete(s.validatorRelays, bytesutil.ToBytes48(r.Message.Pubkey))
+		}
+		return s.clients, nil
+	}
+	relays := make([]*relay, 0, len(relayURLs))
+	for _, u := range relayURLs {
+		r, ok := s.relays[u]
+		if !ok {
+			var err error
+			r, err = newRelay(u)
+			if err != nil {
+				return nil, err
+			}
+			// Relays of validators are not trusted by the operator of the node, their bids must be signed by the
+			// builder key pinned by their URL.
+			if r.pubKey == nil {
+				return nil, errors.Wrapf(ErrUnpinnedRelay, "relay %s", u)
+			}
+			s.relays[u] = r
+		}
+		relays = append(relays, r)
+	}
+	for _, r := range reg {
+		s.validatorRelays[bytesutil.ToBytes48(r.Message.Pubkey)] = relays
+	}
+	return relays, nil
+}
+
+// relaysFor returns the relays of the validator with the given public key, which are the default relays of the node
+// unless the validator registered with relays of its own.
+func (s *Service) relaysFor(pubKey [fieldparams.BLSPubkeyLength]byte) []*relay {
+	s.relaysLock.RLock()
+	defer s.relaysLock.RUnlock()
+	if relays, ok := s.validatorRelays[pubKey]; ok {
+		return relays
+	}
+	return s.clients
+}
+
+// winningRelay returns the relay which provided the winning bid for the payload with the given block hash.
+func (s *Service) winningRelay(blockHash [32]byte) (*relay, bool) {
+	s.winnersLock.RLock()
+	defer s.winnersLock.RUnlock()
+	w, ok := s.winners[blockHash]
+	if !ok {
+		return nil, false
+	}
+	return w.relay, true
+}
+
+// setWinningRelay records the relay which provided the winning bid for the payload with the given block hash,
+// and prunes winners older than `winningRelayRetention` slots.
+func (s *Service) setWinningRelay(slot types.Slot, blockHash [32]byte, r *relay) {
+	s.winnersLock.Lock()
+	defer s.winnersLock.Unlock()
+	for h, w := range s.winners {
+		if w.slot+winningRelayRetention < slot {
+			delete(s.winners, h)
+		}
+	}
+	s.winners[blockHash] = &winningRelay{slot: slot, relay: r}
+}
+
+// validateBid checks that a bid is well formed, builds on the requested parent and is signed by the builder. If the
+// relay pins a builder public key, the bid must be signed with it.
+func validateBid(bid *ethpb.SignedBuilderBid, parentHash [32]byte, relayPubKey []byte) error {
+	if bid == nil || bid.Message == nil || bid.Message.Header == nil {
+		return errors.New("nil builder bid")
+	}
+	if bytesutil.ToBytes32(bid.Message.Header.ParentHash) != parentHash {
+		return fmt.Errorf("bid parent hash %#x does not match requested parent hash %#x",
+			bid.Message.Header.ParentHash, parentHash)
+	}
+	if relayPubKey != nil && !bytes.Equal(bid.Message.Pubkey, relayPubKey) {
+		return fmt.Errorf("bid public key %#x does not match relay public key %#x", bid.Message.Pubkey, relayPubKey)
+	}
+	return signing.VerifyBuilderBidSignature(bid)
+}
+
+// bidValue returns the value of a bid in wei. The value is a little-endian encoded uint256.
+func bidValue(bid *ethpb.SignedBuilderBid) *big.Int {
+	return bytesutil.LittleEndianBytesToBigInt(bid.Message.Value)
 }
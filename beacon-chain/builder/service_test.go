@@ -0,0 +1,205 @@
+package builder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	builderapi "github.com/prysmaticlabs/prysm/api/client/builder"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+type mockRelay struct {
+	*httptest.Server
+	pubKey        []byte
+	submissions   int32
+	registrations int32
+}
+
+// pinnedURL returns the URL of the relay pinning the given builder public key.
+func (r *mockRelay) pinnedURL(pubKey []byte) string {
+	return strings.Replace(r.URL, "http://", fmt.Sprintf("http://%#x@", pubKey), 1)
+}
+
+// newMockRelay starts a relay which bids `value` wei on top of `parentHash`. The bid signature
+// is corrupted if `badSignature` is set.
+func newMockRelay(t *testing.T, parentHash [32]byte, blockHash [32]byte, value int64, badSignature bool) *mockRelay {
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	header := &v1.ExecutionPayloadHeader{
+		ParentHash:       parentHash[:],
+		FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
+		StateRoot:        make([]byte, fieldparams.RootLength),
+		ReceiptsRoot:     make([]byte, fieldparams.RootLength),
+		LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
+		PrevRandao:       make([]byte, fieldparams.RootLength),
+		BaseFeePerGas:    make([]byte, fieldparams.RootLength),
+		BlockHash:        blockHash[:],
+		TransactionsRoot: make([]byte, fieldparams.RootLength),
+	}
+	bid := &ethpb.BuilderBid{
+		Header: header,
+		Value:  builderapi.Uint256{Int: big.NewInt(value)}.SSZBytes(),
+		Pubkey: sk.PublicKey().Marshal(),
+	}
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil, nil)
+	require.NoError(t, err)
+	sr, err := signing.ComputeSigningRoot(bid, d)
+	require.NoError(t, err)
+	sig := sk.Sign(sr[:]).Marshal()
+	if badSignature {
+		sig = sk.Sign([]byte("bad")).Marshal()
+	}
+
+	resp := &builderapi.ExecHeaderResponse{Version: "bellatrix"}
+	resp.Data.Message = &builderapi.BuilderBid{
+		Header: &builderapi.ExecutionPayloadHeader{ExecutionPayloadHeader: header},
+		Value:  builderapi.Uint256{Int: big.NewInt(value)},
+		Pubkey: bid.Pubkey,
+	}
+	resp.Data.Signature = sig
+
+	r := &mockRelay{pubKey: bid.Pubkey}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch {
+		case strings.HasPrefix(req.URL.Path, "/eth/v1/builder/header"):
+			require.NoError(t, json.NewEncoder(w).Encode(resp))
+		case req.URL.Path == "/eth/v1/builder/blinded_blocks":
+			atomic.AddInt32(&r.submissions, 1)
+			_, err := w.Write([]byte(`{"version":"bellatrix","data":{"base_fee_per_gas":"1"}}`))
+			require.NoError(t, err)
+		case req.URL.Path == "/eth/v1/builder/validators":
+			atomic.AddInt32(&r.registrations, 1)
+		}
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func TestService_GetHeader_HighestValidBidWins(t *testing.T) {
+	ctx := context.Background()
+	parentHash := bytesutil.ToBytes32([]byte("parent"))
+	low := newMockRelay(t, parentHash, bytesutil.ToBytes32([]byte("low")), 1, false)
+	high := newMockRelay(t, parentHash, bytesutil.ToBytes32([]byte("high")), 2, false)
+	forged := newMockRelay(t, parentHash, bytesutil.ToBytes32([]byte("forged")), 3, true)
+
+	s, err := NewService(ctx, WithBuilderEndpoints(low.URL, high.URL, forged.URL))
+	require.NoError(t, err)
+	require.Equal(t, true, s.Configured())
+
+	bid, err := s.GetHeader(ctx, 1, parentHash, [48]byte{})
+	require.NoError(t, err)
+	require.DeepEqual(t, bytesutil.PadTo([]byte("high"), 32), bid.Message.Header.BlockHash)
+
+	sb := util.NewBlindedBeaconBlockBellatrix()
+	sb.Block.Body.ExecutionPayloadHeader = bid.Message.Header
+	_, err = s.SubmitBlindedBlock(ctx, sb)
+	require.NoError(t, err)
+	require.Equal(t, int32(0), atomic.LoadInt32(&low.submissions))
+	require.Equal(t, int32(1), atomic.LoadInt32(&high.submissions))
+	require.Equal(t, int32(0), atomic.LoadInt32(&forged.submissions))
+}
+
+func TestService_GetHeader_NoValidBid(t *testing.T) {
+	ctx := context.Background()
+	parentHash := bytesutil.ToBytes32([]byte("parent"))
+	forged := newMockRelay(t, parentHash, bytesutil.ToBytes32([]byte("forged")), 3, true)
+	otherParent := newMockRelay(t, bytesutil.ToBytes32([]byte("other")), bytesutil.ToBytes32([]byte("b")), 4, false)
+
+	s, err := NewService(ctx, WithBuilderEndpoints(forged.URL, otherParent.URL))
+	require.NoError(t, err)
+	_, err = s.GetHeader(ctx, 1, parentHash, [48]byte{})
+	require.ErrorIs(t, err, ErrNoBuilderBid)
+}
+
+func TestService_SubmitBlindedBlock_UnknownWinner(t *testing.T) {
+	ctx := context.Background()
+	parentHash := bytesutil.ToBytes32([]byte("parent"))
+	r := newMockRelay(t, parentHash, bytesutil.ToBytes32([]byte("hash")), 1, false)
+
+	s, err := NewService(ctx, WithBuilderEndpoints(r.URL))
+	require.NoError(t, err)
+	_, err = s.SubmitBlindedBlock(ctx, util.NewBlindedBeaconBlockBellatrix())
+	require.NoError(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&r.submissions))
+}
+
+func TestService_GetHeader_ValidatorRelays(t *testing.T) {
+	ctx := context.Background()
+	parentHash := bytesutil.ToBytes32([]byte("parent"))
+	defaultRelay := newMockRelay(t, parentHash, bytesutil.ToBytes32([]byte("default")), 5, false)
+	own := newMockRelay(t, parentHash, bytesutil.ToBytes32([]byte("own")), 1, false)
+	// The impostor signs its bids with a valid signature of its own key, not with the key pinned by its URL.
+	impostor := newMockRelay(t, parentHash, bytesutil.ToBytes32([]byte("impostor")), 4, false)
+
+	s, err := NewService(ctx, WithBuilderEndpoints(defaultRelay.URL), WithDatabase(dbtest.SetupDB(t)))
+	require.NoError(t, err)
+	s.cfg.headFetcher = &mock.ChainService{}
+	pubKey := bytesutil.ToBytes48([]byte("validator"))
+	reg := &ethpb.SignedValidatorRegistrationV1{
+		Message: &ethpb.ValidatorRegistrationV1{
+			FeeRecipient: make([]byte, fieldparams.FeeRecipientLength),
+			Pubkey:       pubKey[:],
+		},
+		Signature: make([]byte, fieldparams.BLSSignatureLength),
+	}
+
+	err = s.RegisterValidator(ctx, []*ethpb.SignedValidatorRegistrationV1{reg}, []string{own.URL})
+	require.ErrorIs(t, err, ErrUnpinnedRelay)
+
+	relays := []string{own.pinnedURL(own.pubKey), impostor.pinnedURL(own.pubKey)}
+	require.NoError(t, s.RegisterValidator(ctx, []*ethpb.SignedValidatorRegistrationV1{reg}, relays))
+	require.Equal(t, int32(0), atomic.LoadInt32(&defaultRelay.registrations))
+	require.Equal(t, int32(1), atomic.LoadInt32(&own.registrations))
+	require.Equal(t, int32(1), atomic.LoadInt32(&impostor.registrations))
+
+	// The default relay bids higher, but only the relays of the validator are asked, and the bid of the impostor is
+	// not signed by the pinned key.
+	bid, err := s.GetHeader(ctx, 1, parentHash, pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, bytesutil.PadTo([]byte("own"), 32), bid.Message.Header.BlockHash)
+
+	// Validators without relays of their own use the default relays.
+	bid, err = s.GetHeader(ctx, 1, parentHash, [48]byte{})
+	require.NoError(t, err)
+	require.DeepEqual(t, bytesutil.PadTo([]byte("default"), 32), bid.Message.Header.BlockHash)
+
+	// Registering without relays resets the validator to the default relays.
+	require.NoError(t, s.RegisterValidator(ctx, []*ethpb.SignedValidatorRegistrationV1{reg}, nil))
+	require.Equal(t, int32(1), atomic.LoadInt32(&defaultRelay.registrations))
+	bid, err = s.GetHeader(ctx, 1, parentHash, pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, bytesutil.PadTo([]byte("default"), 32), bid.Message.Header.BlockHash)
+}
+
+func TestService_DefaultRelay_PinnedKey(t *testing.T) {
+	ctx := context.Background()
+	parentHash := bytesutil.ToBytes32([]byte("parent"))
+	r := newMockRelay(t, parentHash, bytesutil.ToBytes32([]byte("hash")), 1, false)
+	other := newMockRelay(t, parentHash, bytesutil.ToBytes32([]byte("other")), 1, false)
+
+	s, err := NewService(ctx, WithBuilderEndpoints(r.pinnedURL(other.pubKey)))
+	require.NoError(t, err)
+	require.Equal(t, r.URL, s.clients[0].client.NodeURL())
+	_, err = s.GetHeader(ctx, 1, parentHash, [48]byte{})
+	require.ErrorIs(t, err, ErrNoBuilderBid)
+
+	_, err = NewService(ctx, WithBuilderEndpoints(strings.Replace(r.URL, "http://", "http://0x01@", 1)))
+	require.ErrorContains(t, "invalid relay public key", err)
+}
@@ -16,6 +16,8 @@ type MockBuilderService struct {
 	Bid                   *ethpb.SignedBuilderBid
 	ErrGetHeader          error
 	ErrRegisterValidator  error
+	Registrations         []*ethpb.SignedValidatorRegistrationV1
+	Relays                [][]string
 }
 
 // Configured for mocking.
@@ -34,6 +36,11 @@ func (s *MockBuilderService) GetHeader(context.Context, types.Slot, [32]byte, [4
 }
 
 // RegisterValidator for mocking.
-func (s *MockBuilderService) RegisterValidator(context.Context, []*ethpb.SignedValidatorRegistrationV1) error {
-	return s.ErrRegisterValidator
+func (s *MockBuilderService) RegisterValidator(_ context.Context, regs []*ethpb.SignedValidatorRegistrationV1, relays []string) error {
+	if s.ErrRegisterValidator != nil {
+		return s.ErrRegisterValidator
+	}
+	s.Registrations = append(s.Registrations, regs...)
+	s.Relays = append(s.Relays, relays)
+	return nil
 }
@@ -251,13 +251,45 @@ func ProcessRewardsAndPenaltiesPrecompute(
 	return beaconState, nil
 }
 
+// AttestationDelta is the attestation reward and penalty of a single validator, broken down by duty.
+type AttestationDelta struct {
+	SourceReward      uint64
+	SourcePenalty     uint64
+	TargetReward      uint64
+	TargetPenalty     uint64
+	HeadReward        uint64
+	InactivityPenalty uint64
+}
+
+// Reward returns the sum of all the rewards of the delta.
+func (d *AttestationDelta) Reward() uint64 {
+	return d.SourceReward + d.TargetReward + d.HeadReward
+}
+
+// Penalty returns the sum of all the penalties of the delta.
+func (d *AttestationDelta) Penalty() uint64 {
+	return d.SourcePenalty + d.TargetPenalty + d.InactivityPenalty
+}
+
 // AttestationsDelta computes and returns the rewards and penalties differences for individual validators based on the
 // voting records.
 func AttestationsDelta(beaconState state.BeaconState, bal *precompute.Balance, vals []*precompute.Validator) (rewards, penalties []uint64, err error) {
-	numOfVals := beaconState.NumValidators()
-	rewards = make([]uint64, numOfVals)
-	penalties = make([]uint64, numOfVals)
+	deltas, err := AttestationsDeltaBreakdown(beaconState, bal, vals)
+	if err != nil {
+		return nil, nil, err
+	}
+	rewards = make([]uint64, len(deltas))
+	penalties = make([]uint64, len(deltas))
+	for i, d := range deltas {
+		rewards[i] = d.Reward()
+		penalties[i] = d.Penalty()
+	}
+	return rewards, penalties, nil
+}
 
+// AttestationsDeltaBreakdown computes the same differences as AttestationsDelta, keeping the
+// source, target, head and inactivity components of each validator apart.
+func AttestationsDeltaBreakdown(beaconState state.BeaconState, bal *precompute.Balance, vals []*precompute.Validator) ([]*AttestationDelta, error) {
 	cfg := params.BeaconConfig()
 	prevEpoch := time.PrevEpoch(beaconState)
 	finalizedEpoch := beaconState.FinalizedCheckpointEpoch()
@@ -270,29 +302,31 @@ func AttestationsDelta(beaconState state.BeaconState, bal *precompute.Balance, v
 	bias := cfg.InactivityScoreBias
 	inactivityPenaltyQuotient, err := beaconState.InactivityPenaltyQuotient()
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	inactivityDenominator := bias * inactivityPenaltyQuotient
 
+	deltas := make([]*AttestationDelta, len(vals))
 	for i, v := range vals {
-		rewards[i], penalties[i], err = attestationDelta(bal, v, baseRewardMultiplier, inactivityDenominator, leak)
+		deltas[i], err = attestationDelta(bal, v, baseRewardMultiplier, inactivityDenominator, leak)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 	}
 
-	return rewards, penalties, nil
+	return deltas, nil
 }
 
 func attestationDelta(
 	bal *precompute.Balance,
 	val *precompute.Validator,
 	baseRewardMultiplier, inactivityDenominator uint64,
-	inactivityLeak bool) (reward, penalty uint64, err error) {
+	inactivityLeak bool) (*AttestationDelta, error) {
+	delta := &AttestationDelta{}
 	eligible := val.IsActivePrevEpoch || (val.IsSlashed && !val.IsWithdrawableCurrentEpoch)
 	// Per spec `ActiveCurrentEpoch` can't be 0 to process attestation delta.
 	if !eligible || bal.ActiveCurrentEpoch == 0 {
-		return 0, 0, nil
+		return delta, nil
 	}
 
 	cfg := params.BeaconConfig()
@@ -305,32 +339,31 @@ func attestationDelta(
 	srcWeight := cfg.TimelySourceWeight
 	tgtWeight := cfg.TimelyTargetWeight
 	headWeight := cfg.TimelyHeadWeight
-	reward, penalty = uint64(0), uint64(0)
 	// Process source reward / penalty
 	if val.IsPrevEpochSourceAttester && !val.IsSlashed {
 		if !inactivityLeak {
 			n := baseReward * srcWeight * (bal.PrevEpochAttested / increment)
-			reward += n / (activeIncrement * weightDenominator)
+			delta.SourceReward = n / (activeIncrement * weightDenominator)
 		}
 	} else {
-		penalty += baseReward * srcWeight / weightDenominator
+		delta.SourcePenalty = baseReward * srcWeight / weightDenominator
 	}
 
 	// Process target reward / penalty
 	if val.IsPrevEpochTargetAttester && !val.IsSlashed {
 		if !inactivityLeak {
 			n := baseReward * tgtWeight * (bal.PrevEpochTargetAttested / increment)
-			reward += n / (activeIncrement * weightDenominator)
+			delta.TargetReward = n / (activeIncrement * weightDenominator)
 		}
 	} else {
-		penalty += baseReward * tgtWeight / weightDenominator
+		delta.TargetPenalty = baseReward * tgtWeight / weightDenominator
 	}
 
 	// Process head reward / penalty
 	if val.IsPrevEpochHeadAttester && !val.IsSlashed {
 		if !inactivityLeak {
 			n := baseReward * headWeight * (bal.PrevEpochHeadAttested / increment)
-			reward += n / (activeIncrement * weightDenominator)
+			delta.HeadReward = n / (activeIncrement * weightDenominator)
 		}
 	}
 
@@ -339,10 +372,10 @@ func attestationDelta(
 	if !val.IsPrevEpochTargetAttester || val.IsSlashed {
 		n, err := math.Mul64(effectiveBalance, val.InactivityScore)
 		if err != nil {
-			return 0, 0, err
+			return nil, err
 		}
-		penalty += n / inactivityDenominator
+		delta.InactivityPenalty = n / inactivityDenominator
 	}
 
-	return reward, penalty, nil
+	return delta, nil
 }
@@ -269,6 +269,30 @@ func TestAttestationsDeltaBellatrix(t *testing.T) {
 	require.DeepEqual(t, want, penalties)
 }
 
+func TestAttestationsDeltaBreakdown(t *testing.T) {
+	s, err := testState()
+	require.NoError(t, err)
+	validators, balance, err := InitializePrecomputeValidators(context.Background(), s)
+	require.NoError(t, err)
+	validators, balance, err = ProcessEpochParticipation(context.Background(), s, balance, validators)
+	require.NoError(t, err)
+	deltas, err := AttestationsDeltaBreakdown(s, balance, validators)
+	require.NoError(t, err)
+	rewards, penalties, err := AttestationsDelta(s, balance, validators)
+	require.NoError(t, err)
+
+	require.Equal(t, len(rewards), len(deltas))
+	for i, d := range deltas {
+		require.Equal(t, rewards[i], d.Reward())
+		require.Equal(t, penalties[i], d.Penalty())
+	}
+
+	// The last validator attested to source, target and head on time.
+	require.DeepEqual(t, &AttestationDelta{SourceReward: 939146, TargetReward: 1162752, HeadReward: 313048}, deltas[3])
+	// The first validator missed source and target.
+	require.DeepEqual(t, &AttestationDelta{SourcePenalty: 1252195, TargetPenalty: 2325505}, deltas[0])
+}
+
 func TestProcessRewardsAndPenaltiesPrecompute_Ok(t *testing.T) {
 	s, err := testState()
 	require.NoError(t, err)
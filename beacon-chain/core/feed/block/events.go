@@ -7,6 +7,8 @@ import "github.com/prysmaticlabs/prysm/consensus-types/interfaces"
 const (
 	// ReceivedBlock is sent after a block has been received by the beacon node via p2p or RPC.
 	ReceivedBlock = iota + 1
+	// ReceivedGossipBlock is sent after a block received via p2p gossip passed validation, before it is imported.
+	ReceivedGossipBlock
 )
 
 // ReceivedBlockData is the data sent with ReceivedBlock events.
@@ -14,3 +16,9 @@ type ReceivedBlockData struct {
 	SignedBlock  interfaces.SignedBeaconBlock
 	IsOptimistic bool
 }
+
+// ReceivedGossipBlockData is the data sent with ReceivedGossipBlock events.
+type ReceivedGossipBlockData struct {
+	SignedBlock interfaces.SignedBeaconBlock
+	BlockRoot   [32]byte
+}
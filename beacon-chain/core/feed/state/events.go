@@ -26,6 +26,12 @@ const (
 	FinalizedCheckpoint
 	// NewHead of the chain event.
 	NewHead
+	// PayloadAttributes is sent with the payload attributes of the proposal of the next slot on every fork choice update.
+	PayloadAttributes
+	// LightClientFinalityUpdate is sent when a light client finality update with a newer finalized header is available.
+	LightClientFinalityUpdate
+	// LightClientOptimisticUpdate is sent when a light client optimistic update with a newer attested header is available.
+	LightClientOptimisticUpdate
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
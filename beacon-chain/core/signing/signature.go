@@ -6,7 +6,10 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 )
 
-var ErrNilRegistration = errors.New("nil signed registration")
+var (
+	ErrNilRegistration = errors.New("nil signed registration")
+	ErrNilBuilderBid   = errors.New("nil signed builder bid")
+)
 
 // VerifyRegistrationSignature verifies the signature of a validator's registration.
 func VerifyRegistrationSignature(
@@ -32,3 +35,25 @@ func VerifyRegistrationSignature(
 	}
 	return nil
 }
+
+// VerifyBuilderBidSignature verifies the signature of a builder bid against the builder's public key.
+func VerifyBuilderBidSignature(bid *ethpb.SignedBuilderBid) error {
+	if bid == nil || bid.Message == nil {
+		return ErrNilBuilderBid
+	}
+
+	// Per spec, builder bids are signed over the application builder domain
+	// with the genesis fork version and a zero genesis validators root.
+	d, err := ComputeDomain(
+		params.BeaconConfig().DomainApplicationBuilder,
+		nil, /* fork version */
+		nil /* genesis val root */)
+	if err != nil {
+		return err
+	}
+
+	if err := VerifySigningRoot(bid.Message, bid.Message.Pubkey, bid.Signature, d); err != nil {
+		return ErrSigFailedToVerify
+	}
+	return nil
+}
@@ -54,9 +54,15 @@ type ReadOnlyDatabase interface {
 	// Fee reicipients operations.
 	FeeRecipientByValidatorID(ctx context.Context, id types.ValidatorIndex) (common.Address, error)
 	RegistrationByValidatorID(ctx context.Context, id types.ValidatorIndex) (*ethpb.ValidatorRegistrationV1, error)
+	// Light client operations.
+	LightClientUpdates(ctx context.Context, startPeriod, endPeriod uint64) ([]*ethpb.LightClientUpdate, error)
 	// origin checkpoint sync support
 	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
 	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
+	PrunedHistorySlot(ctx context.Context) (types.Slot, error)
+	// Archive mode related methods.
+	ArchivedState(ctx context.Context, slot types.Slot) (state.BeaconState, error)
+	HighestArchivedSlot(ctx context.Context) (types.Slot, bool, error)
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -65,6 +71,8 @@ type NoHeadAccessDatabase interface {
 
 	// Block related methods.
 	DeleteBlock(ctx context.Context, root [32]byte) error
+	PruneHistory(ctx context.Context, beforeSlot types.Slot, limit int) (types.Slot, error)
+	PruneValidatorEntries(ctx context.Context) (int, error)
 	SaveBlock(ctx context.Context, block interfaces.SignedBeaconBlock) error
 	SaveBlocks(ctx context.Context, blocks []interfaces.SignedBeaconBlock) error
 	SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error
@@ -88,6 +96,11 @@ type NoHeadAccessDatabase interface {
 	// Fee reicipients operations.
 	SaveFeeRecipientsByValidatorIDs(ctx context.Context, ids []types.ValidatorIndex, addrs []common.Address) error
 	SaveRegistrationsByValidatorIDs(ctx context.Context, ids []types.ValidatorIndex, regs []*ethpb.ValidatorRegistrationV1) error
+	// Light client operations.
+	SaveLightClientUpdate(ctx context.Context, period uint64, update *ethpb.LightClientUpdate) error
+	// Archive mode operations.
+	SaveArchivedSnapshot(ctx context.Context, st state.ReadOnlyBeaconState) error
+	SaveArchivedDiff(ctx context.Context, prev, st state.ReadOnlyBeaconState) error
 
 	CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint types.Slot) error
 }
@@ -108,6 +121,7 @@ type HeadAccessDatabase interface {
 	// initialization method needed for origin checkpoint sync
 	SaveOrigin(ctx context.Context, serState, serBlock []byte) error
 	SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	SaveFinalizedBlocks(ctx context.Context, blocks []interfaces.SignedBeaconBlock, childRoot [32]byte) error
 }
 
 // SlasherDatabase interface for persisting data related to detecting slashable offenses on Ethereum.
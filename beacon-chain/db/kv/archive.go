@@ -0,0 +1,196 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	v2 "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	v3 "github.com/prysmaticlabs/prysm/beacon-chain/state/v3"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+var errArchiveGap = errors.New("archived state does not follow the highest archived state")
+
+// SaveArchivedSnapshot saves the full state of an archive node for the slot of the state. Archived states are keyed by
+// slot, and must be saved in increasing slot order, starting with a snapshot.
+func (s *Store) SaveArchivedSnapshot(ctx context.Context, st state.ReadOnlyBeaconState) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveArchivedSnapshot")
+	defer span.End()
+
+	enc, err := marshalState(ctx, st)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if highest, ok := highestArchivedSlot(tx); ok && st.Slot() <= highest {
+			return errors.Wrapf(errArchiveGap, "highest archived slot=%d, snapshot slot=%d", highest, st.Slot())
+		}
+		return tx.Bucket(archivedSnapshotsBucket).Put(bytesutil.SlotToBytesBigEndian(st.Slot()), enc)
+	})
+}
+
+// SaveArchivedDiff saves the difference between the given state and prev, which must be the highest archived state,
+// for the slot of the state. Reconstructing an archived state applies the diffs saved since the last snapshot, which
+// bounds the work needed to the number of slots between two snapshots.
+func (s *Store) SaveArchivedDiff(ctx context.Context, prev, st state.ReadOnlyBeaconState) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveArchivedDiff")
+	defer span.End()
+
+	prevPb, ok := prev.InnerStateUnsafe().(proto.Message)
+	if !ok {
+		return errors.New("non valid inner state")
+	}
+	pb, ok := st.InnerStateUnsafe().(proto.Message)
+	if !ok {
+		return errors.New("non valid inner state")
+	}
+	enc, err := diffStates(prevPb, pb)
+	if err != nil {
+		return errors.Wrapf(err, "could not diff state at slot %d", st.Slot())
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		highest, ok := highestArchivedSlot(tx)
+		if !ok || highest != prev.Slot() || st.Slot() <= highest {
+			return errors.Wrapf(errArchiveGap, "highest archived slot=%d, diff base slot=%d", highest, prev.Slot())
+		}
+		return tx.Bucket(archivedDiffsBucket).Put(bytesutil.SlotToBytesBigEndian(st.Slot()), enc)
+	})
+}
+
+// HighestArchivedSlot returns the slot of the highest archived state, and false if no state was archived.
+func (s *Store) HighestArchivedSlot(ctx context.Context) (types.Slot, bool, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.HighestArchivedSlot")
+	defer span.End()
+
+	var slot types.Slot
+	var ok bool
+	err := s.db.View(func(tx *bolt.Tx) error {
+		slot, ok = highestArchivedSlot(tx)
+		return nil
+	})
+	return slot, ok, err
+}
+
+// ArchivedState reconstructs the archived state at the given slot, from the highest snapshot at or below the slot and
+// the diffs saved after it. ErrNotFoundState is returned when no state was archived for the slot.
+func (s *Store) ArchivedState(ctx context.Context, slot types.Slot) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ArchivedState")
+	defer span.End()
+
+	var pb proto.Message
+	err := s.db.View(func(tx *bolt.Tx) error {
+		key := bytesutil.SlotToBytesBigEndian(slot)
+		c := tx.Bucket(archivedSnapshotsBucket).Cursor()
+		k, enc := c.Seek(key)
+		if k == nil {
+			k, enc = c.Last()
+		} else if bytesutil.BytesToSlotBigEndian(k) > slot {
+			k, enc = c.Prev()
+		}
+		if k == nil {
+			return nil
+		}
+		snapshotSlot := bytesutil.BytesToSlotBigEndian(k)
+		base, err := unmarshalArchivedState(enc)
+		if err != nil {
+			return errors.Wrapf(err, "could not unmarshal archived snapshot at slot %d", snapshotSlot)
+		}
+		if snapshotSlot == slot {
+			pb = base
+			return nil
+		}
+		// Apply the diffs saved after the snapshot, up to the requested slot.
+		dc := tx.Bucket(archivedDiffsBucket).Cursor()
+		for dk, diff := dc.Seek(bytesutil.SlotToBytesBigEndian(snapshotSlot + 1)); dk != nil; dk, diff = dc.Next() {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			diffSlot := bytesutil.BytesToSlotBigEndian(dk)
+			if diffSlot > slot {
+				break
+			}
+			if err := applyStateDiff(base, diff); err != nil {
+				return errors.Wrapf(err, "could not apply archived diff at slot %d", diffSlot)
+			}
+			if diffSlot == slot {
+				pb = base
+				return nil
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if pb == nil {
+		return nil, errors.Wrapf(ErrNotFoundState, "no archived state at slot %d", slot)
+	}
+	return initializeArchivedState(pb)
+}
+
+// highestArchivedSlot returns the highest slot of the archive buckets.
+func highestArchivedSlot(tx *bolt.Tx) (types.Slot, bool) {
+	var highest types.Slot
+	var ok bool
+	for _, bkt := range [][]byte{archivedSnapshotsBucket, archivedDiffsBucket} {
+		k, _ := tx.Bucket(bkt).Cursor().Last()
+		if k == nil {
+			continue
+		}
+		if sl := bytesutil.BytesToSlotBigEndian(k); !ok || sl > highest {
+			highest = sl
+			ok = true
+		}
+	}
+	return highest, ok
+}
+
+// unmarshalArchivedState decodes a state encoded by marshalState into its protobuf type. Unlike unmarshalState, it
+// keeps the validators of the encoded state, as archived states are not split from their validator entries.
+func unmarshalArchivedState(enc []byte) (proto.Message, error) {
+	enc, err := snappy.Decode(nil, enc)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case hasBellatrixKey(enc):
+		protoState := &ethpb.BeaconStateBellatrix{}
+		if err := protoState.UnmarshalSSZ(enc[len(bellatrixKey):]); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding for bellatrix")
+		}
+		return protoState, nil
+	case hasAltairKey(enc):
+		protoState := &ethpb.BeaconStateAltair{}
+		if err := protoState.UnmarshalSSZ(enc[len(altairKey):]); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding for altair")
+		}
+		return protoState, nil
+	default:
+		protoState := &ethpb.BeaconState{}
+		if err := protoState.UnmarshalSSZ(enc); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding")
+		}
+		return protoState, nil
+	}
+}
+
+func initializeArchivedState(pb proto.Message) (state.BeaconState, error) {
+	switch protoState := pb.(type) {
+	case *ethpb.BeaconStateBellatrix:
+		return v3.InitializeFromProtoUnsafe(protoState)
+	case *ethpb.BeaconStateAltair:
+		return v2.InitializeFromProtoUnsafe(protoState)
+	case *ethpb.BeaconState:
+		return v1.InitializeFromProtoUnsafe(protoState)
+	default:
+		return nil, errors.Errorf("unsupported archived state type %T", pb)
+	}
+}
@@ -0,0 +1,345 @@
+package kv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// A state diff is a sequence of field changes between two protobuf beacon states of the same fork. Each change starts
+// with the field number and the operation, followed by the operation payload:
+// - diffOpReplace replaces the whole field, the payload is the encoding of a state with only that field set.
+// - diffOpList changes the length of a repeated field and sets the listed elements.
+// - diffOpBytes changes the length of a bytes field, such as the epoch participation flags, and sets the listed bytes.
+// Fields which did not change are not part of the diff. The lists of validators, balances, participation flags and
+// inactivity scores, as well as the block roots, state roots and randao mixes vectors, change in a few places from one
+// slot to the next, so a diff is much smaller than the full state.
+const (
+	diffOpReplace byte = iota
+	diffOpList
+	diffOpBytes
+)
+
+// diffBytesMinLength is the length from which bytes fields are diffed byte by byte rather than replaced.
+const diffBytesMinLength = 256
+
+var errInvalidStateDiff = errors.New("invalid state diff")
+
+// diffStates returns the encoded diff which turns prev into next. Both states must be of the same protobuf type.
+func diffStates(prev, next proto.Message) ([]byte, error) {
+	pm, nm := prev.ProtoReflect(), next.ProtoReflect()
+	if pm.Descriptor().FullName() != nm.Descriptor().FullName() {
+		return nil, errors.Errorf("cannot diff %s state against %s state", pm.Descriptor().FullName(), nm.Descriptor().FullName())
+	}
+	buf := make([]byte, 0, 1024)
+	fields := nm.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		var err error
+		switch {
+		case fd.IsList() && listDiffable(fd):
+			buf, err = appendListDiff(buf, fd, pm.Get(fd).List(), nm)
+		case fd.Kind() == protoreflect.BytesKind && len(nm.Get(fd).Bytes()) >= diffBytesMinLength:
+			buf, err = appendBytesDiff(buf, fd, pm.Get(fd).Bytes(), nm)
+		case !fieldEqual(fd, pm.Get(fd), nm.Get(fd)):
+			buf, err = appendReplace(buf, fd, nm)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not diff field %s", fd.Name())
+		}
+	}
+	return snappy.Encode(nil, buf), nil
+}
+
+// applyStateDiff applies the encoded diff to the given state, in place.
+func applyStateDiff(st proto.Message, enc []byte) error {
+	buf, err := snappy.Decode(nil, enc)
+	if err != nil {
+		return err
+	}
+	m := st.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	r := bytes.NewReader(buf)
+	for r.Len() > 0 {
+		num, err := binary.ReadUvarint(r)
+		if err != nil {
+			return errors.Wrap(errInvalidStateDiff, "could not read field number")
+		}
+		fd := fields.ByNumber(protoreflect.FieldNumber(num))
+		if fd == nil {
+			return errors.Wrapf(errInvalidStateDiff, "unknown field number %d", num)
+		}
+		op, err := r.ReadByte()
+		if err != nil {
+			return errors.Wrap(errInvalidStateDiff, "could not read operation")
+		}
+		switch op {
+		case diffOpReplace:
+			err = applyReplace(r, m, fd)
+		case diffOpList:
+			err = applyListDiff(r, m, fd)
+		case diffOpBytes:
+			err = applyBytesDiff(r, m, fd)
+		default:
+			err = errors.Wrapf(errInvalidStateDiff, "unknown operation %d", op)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "could not apply diff of field %s", fd.Name())
+		}
+	}
+	return nil
+}
+
+func listDiffable(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Kind() {
+	case protoreflect.Uint64Kind, protoreflect.BytesKind, protoreflect.MessageKind:
+		return true
+	default:
+		return false
+	}
+}
+
+func fieldEqual(fd protoreflect.FieldDescriptor, a, b protoreflect.Value) bool {
+	if fd.IsList() {
+		la, lb := a.List(), b.List()
+		if la.Len() != lb.Len() {
+			return false
+		}
+		for i := 0; i < la.Len(); i++ {
+			if !valueEqual(fd, la.Get(i), lb.Get(i)) {
+				return false
+			}
+		}
+		return true
+	}
+	return valueEqual(fd, a, b)
+}
+
+func valueEqual(fd protoreflect.FieldDescriptor, a, b protoreflect.Value) bool {
+	switch fd.Kind() {
+	case protoreflect.MessageKind:
+		return proto.Equal(a.Message().Interface(), b.Message().Interface())
+	case protoreflect.BytesKind:
+		return bytes.Equal(a.Bytes(), b.Bytes())
+	default:
+		return a.Interface() == b.Interface()
+	}
+}
+
+func appendUvarint(buf []byte, x uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], x)
+	return append(buf, tmp[:n]...)
+}
+
+func appendHeader(buf []byte, fd protoreflect.FieldDescriptor, op byte) []byte {
+	buf = appendUvarint(buf, uint64(fd.Number()))
+	return append(buf, op)
+}
+
+func appendReplace(buf []byte, fd protoreflect.FieldDescriptor, m protoreflect.Message) ([]byte, error) {
+	field := m.New()
+	// unset fields are read-only and cannot be set on another message, they are encoded as an empty state.
+	if m.Has(fd) {
+		field.Set(fd, m.Get(fd))
+	}
+	enc, err := proto.Marshal(field.Interface())
+	if err != nil {
+		return nil, err
+	}
+	buf = appendHeader(buf, fd, diffOpReplace)
+	buf = appendUvarint(buf, uint64(len(enc)))
+	return append(buf, enc...), nil
+}
+
+func appendListDiff(buf []byte, fd protoreflect.FieldDescriptor, prev protoreflect.List, m protoreflect.Message) ([]byte, error) {
+	next := m.Get(fd).List()
+	changed := make([]int, 0)
+	for i := 0; i < next.Len(); i++ {
+		if i >= prev.Len() || !valueEqual(fd, prev.Get(i), next.Get(i)) {
+			changed = append(changed, i)
+		}
+	}
+	if len(changed) == 0 && prev.Len() == next.Len() {
+		return buf, nil
+	}
+	// Replacing the whole list is smaller when most of it changed, as happens to balances at epoch boundaries.
+	if len(changed) > next.Len()/2 {
+		return appendReplace(buf, fd, m)
+	}
+	buf = appendHeader(buf, fd, diffOpList)
+	buf = appendUvarint(buf, uint64(next.Len()))
+	buf = appendUvarint(buf, uint64(len(changed)))
+	for _, i := range changed {
+		buf = appendUvarint(buf, uint64(i))
+		v := next.Get(i)
+		switch fd.Kind() {
+		case protoreflect.Uint64Kind:
+			buf = appendUvarint(buf, v.Uint())
+		case protoreflect.BytesKind:
+			buf = appendUvarint(buf, uint64(len(v.Bytes())))
+			buf = append(buf, v.Bytes()...)
+		case protoreflect.MessageKind:
+			enc, err := proto.Marshal(v.Message().Interface())
+			if err != nil {
+				return nil, err
+			}
+			buf = appendUvarint(buf, uint64(len(enc)))
+			buf = append(buf, enc...)
+		}
+	}
+	return buf, nil
+}
+
+func appendBytesDiff(buf []byte, fd protoreflect.FieldDescriptor, prev []byte, m protoreflect.Message) ([]byte, error) {
+	next := m.Get(fd).Bytes()
+	changed := make([]int, 0)
+	for i := range next {
+		if i >= len(prev) || prev[i] != next[i] {
+			changed = append(changed, i)
+		}
+	}
+	if len(changed) == 0 && len(prev) == len(next) {
+		return buf, nil
+	}
+	// An index and a value take at least two bytes, replacing the field is smaller when many bytes changed.
+	if len(changed) > len(next)/2 {
+		return appendReplace(buf, fd, m)
+	}
+	buf = appendHeader(buf, fd, diffOpBytes)
+	buf = appendUvarint(buf, uint64(len(next)))
+	buf = appendUvarint(buf, uint64(len(changed)))
+	for _, i := range changed {
+		buf = appendUvarint(buf, uint64(i))
+		buf = append(buf, next[i])
+	}
+	return buf, nil
+}
+
+func readBytes(r *bytes.Reader) ([]byte, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(r.Len()) {
+		return nil, errors.Wrap(errInvalidStateDiff, "length exceeds diff size")
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func applyReplace(r *bytes.Reader, m protoreflect.Message, fd protoreflect.FieldDescriptor) error {
+	enc, err := readBytes(r)
+	if err != nil {
+		return err
+	}
+	field := m.New()
+	if err := proto.Unmarshal(enc, field.Interface()); err != nil {
+		return err
+	}
+	if !field.Has(fd) {
+		m.Clear(fd)
+		return nil
+	}
+	m.Set(fd, field.Get(fd))
+	return nil
+}
+
+func applyListDiff(r *bytes.Reader, m protoreflect.Message, fd protoreflect.FieldDescriptor) error {
+	if !fd.IsList() || !listDiffable(fd) {
+		return errors.Wrap(errInvalidStateDiff, "list diff of a field which is not a list")
+	}
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+	if n > length {
+		return errors.Wrap(errInvalidStateDiff, "more changes than list elements")
+	}
+	l := m.Mutable(fd).List()
+	if uint64(l.Len()) > length {
+		l.Truncate(int(length))
+	}
+	for uint64(l.Len()) < length {
+		l.Append(l.NewElement())
+	}
+	for j := uint64(0); j < n; j++ {
+		i, err := binary.ReadUvarint(r)
+		if err != nil {
+			return err
+		}
+		if i >= length {
+			return errors.Wrapf(errInvalidStateDiff, "index %d out of range", i)
+		}
+		switch fd.Kind() {
+		case protoreflect.Uint64Kind:
+			v, err := binary.ReadUvarint(r)
+			if err != nil {
+				return err
+			}
+			l.Set(int(i), protoreflect.ValueOfUint64(v))
+		case protoreflect.BytesKind:
+			b, err := readBytes(r)
+			if err != nil {
+				return err
+			}
+			l.Set(int(i), protoreflect.ValueOfBytes(b))
+		case protoreflect.MessageKind:
+			enc, err := readBytes(r)
+			if err != nil {
+				return err
+			}
+			v := l.NewElement()
+			if err := proto.Unmarshal(enc, v.Message().Interface()); err != nil {
+				return err
+			}
+			l.Set(int(i), v)
+		}
+	}
+	return nil
+}
+
+func applyBytesDiff(r *bytes.Reader, m protoreflect.Message, fd protoreflect.FieldDescriptor) error {
+	if fd.IsList() || fd.Kind() != protoreflect.BytesKind {
+		return errors.Wrap(errInvalidStateDiff, "bytes diff of a field which is not bytes")
+	}
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+	if n > length {
+		return errors.Wrap(errInvalidStateDiff, "more changes than bytes")
+	}
+	b := make([]byte, length)
+	copy(b, m.Get(fd).Bytes())
+	for j := uint64(0); j < n; j++ {
+		i, err := binary.ReadUvarint(r)
+		if err != nil {
+			return err
+		}
+		if i >= length {
+			return errors.Wrapf(errInvalidStateDiff, "index %d out of range", i)
+		}
+		if b[i], err = r.ReadByte(); err != nil {
+			return err
+		}
+	}
+	m.Set(fd, protoreflect.ValueOfBytes(b))
+	return nil
+}
@@ -0,0 +1,116 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestStore_ArchivedState_SnapshotsAndDiffs(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	_, ok, err := db.HighestArchivedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetValidators(validators(8)))
+	require.NoError(t, st.SetBalances(make([]uint64, 8)))
+	require.NoError(t, db.SaveArchivedSnapshot(ctx, st))
+
+	archived := []state.BeaconState{st}
+	prev := st
+	for i := 1; i <= 6; i++ {
+		next := prev.Copy()
+		require.NoError(t, next.SetSlot(types.Slot(i)))
+		require.NoError(t, next.UpdateBalancesAtIndex(types.ValidatorIndex(i), uint64(i)*1000))
+		require.NoError(t, next.UpdateBlockRootAtIndex(uint64(i), [32]byte{byte(i)}))
+		if i == 3 {
+			require.NoError(t, next.AppendValidator(validators(1)[0]))
+			require.NoError(t, next.AppendBalance(32))
+		}
+		if i == 4 {
+			require.NoError(t, db.SaveArchivedSnapshot(ctx, next))
+		} else {
+			require.NoError(t, db.SaveArchivedDiff(ctx, prev, next))
+		}
+		archived = append(archived, next)
+		prev = next
+	}
+
+	highest, ok, err := db.HighestArchivedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, types.Slot(6), highest)
+
+	for _, want := range archived {
+		got, err := db.ArchivedState(ctx, want.Slot())
+		require.NoError(t, err)
+		require.DeepSSZEqual(t, want.InnerStateUnsafe(), got.InnerStateUnsafe(), "archived state at slot %d does not match", want.Slot())
+	}
+
+	_, err = db.ArchivedState(ctx, 7)
+	require.ErrorIs(t, err, ErrNotFoundState)
+}
+
+func TestStore_ArchivedState_Altair(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	st, err := util.NewBeaconStateAltair()
+	require.NoError(t, err)
+	require.NoError(t, st.SetCurrentParticipationBits(make([]byte, 512)))
+	require.NoError(t, db.SaveArchivedSnapshot(ctx, st))
+
+	next := st.Copy()
+	require.NoError(t, next.SetSlot(1))
+	bits := make([]byte, 512)
+	bits[300] = 7
+	require.NoError(t, next.SetCurrentParticipationBits(bits))
+	require.NoError(t, db.SaveArchivedDiff(ctx, st, next))
+
+	got, err := db.ArchivedState(ctx, 1)
+	require.NoError(t, err)
+	gotPb, ok := got.InnerStateUnsafe().(*ethpb.BeaconStateAltair)
+	require.Equal(t, true, ok)
+	require.DeepSSZEqual(t, next.InnerStateUnsafe(), gotPb)
+}
+
+func TestStore_SaveArchivedDiff_Gap(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	next := st.Copy()
+	require.NoError(t, next.SetSlot(1))
+	require.ErrorIs(t, db.SaveArchivedDiff(ctx, st, next), errArchiveGap)
+
+	require.NoError(t, db.SaveArchivedSnapshot(ctx, st))
+	require.NoError(t, db.SaveArchivedDiff(ctx, st, next))
+	require.ErrorIs(t, db.SaveArchivedDiff(ctx, st, next), errArchiveGap)
+	require.ErrorIs(t, db.SaveArchivedSnapshot(ctx, next), errArchiveGap)
+}
+
+func TestStore_ArchivedState_BeforeFirstSnapshot(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(10))
+	require.NoError(t, db.SaveArchivedSnapshot(ctx, st))
+
+	_, err = db.ArchivedState(ctx, 9)
+	require.ErrorIs(t, err, ErrNotFoundState)
+	_, err = db.ArchivedState(ctx, 11)
+	require.ErrorIs(t, err, ErrNotFoundState)
+}
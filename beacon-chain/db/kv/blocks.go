@@ -279,49 +279,70 @@ func (s *Store) SaveBlocks(ctx context.Context, blocks []interfaces.SignedBeacon
 
 	// Performing marshaling, hashing, and indexing outside the bolt transaction
 	// to minimize the time we hold the DB lock.
-	blockRoots := make([][]byte, len(blocks))
-	encodedBlocks := make([][]byte, len(blocks))
-	indicesForBlocks := make([]map[string][]byte, len(blocks))
+	prepared, err := prepareBlocks(ctx, blocks)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return s.putBlocks(ctx, tx, blocks, prepared)
+	})
+}
+
+// preparedBlocks holds the roots, encodings and indices of blocks about to be saved.
+type preparedBlocks struct {
+	roots   [][]byte
+	encoded [][]byte
+	indices []map[string][]byte
+}
+
+func prepareBlocks(ctx context.Context, blocks []interfaces.SignedBeaconBlock) (*preparedBlocks, error) {
+	prepared := &preparedBlocks{
+		roots:   make([][]byte, len(blocks)),
+		encoded: make([][]byte, len(blocks)),
+		indices: make([]map[string][]byte, len(blocks)),
+	}
 	for i, blk := range blocks {
 		blockRoot, err := blk.Block().HashTreeRoot()
 		if err != nil {
-			return err
+			return nil, err
 		}
 		enc, err := marshalBlock(ctx, blk)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		blockRoots[i] = blockRoot[:]
-		encodedBlocks[i] = enc
-		indicesByBucket := createBlockIndicesFromBlock(ctx, blk.Block())
-		indicesForBlocks[i] = indicesByBucket
+		prepared.roots[i] = blockRoot[:]
+		prepared.encoded[i] = enc
+		prepared.indices[i] = createBlockIndicesFromBlock(ctx, blk.Block())
 	}
-	return s.db.Update(func(tx *bolt.Tx) error {
-		bkt := tx.Bucket(blocksBucket)
-		for i, blk := range blocks {
-			if existingBlock := bkt.Get(blockRoots[i]); existingBlock != nil {
-				continue
-			}
-			if err := updateValueForIndices(ctx, indicesForBlocks[i], blockRoots[i], tx); err != nil {
-				return errors.Wrap(err, "could not update DB indices")
-			}
-			if features.Get().EnableOnlyBlindedBeaconBlocks {
-				blindedBlock, err := blk.ToBlinded()
-				if err != nil {
-					if !errors.Is(err, wrapper.ErrUnsupportedVersion) {
-						return err
-					}
-				} else {
-					blk = blindedBlock
+	return prepared, nil
+}
+
+// putBlocks saves the prepared blocks which are not in the database yet, within the given bolt transaction.
+func (s *Store) putBlocks(ctx context.Context, tx *bolt.Tx, blocks []interfaces.SignedBeaconBlock, prepared *preparedBlocks) error {
+	bkt := tx.Bucket(blocksBucket)
+	for i, blk := range blocks {
+		if existingBlock := bkt.Get(prepared.roots[i]); existingBlock != nil {
+			continue
+		}
+		if err := updateValueForIndices(ctx, prepared.indices[i], prepared.roots[i], tx); err != nil {
+			return errors.Wrap(err, "could not update DB indices")
+		}
+		if features.Get().EnableOnlyBlindedBeaconBlocks {
+			blindedBlock, err := blk.ToBlinded()
+			if err != nil {
+				if !errors.Is(err, wrapper.ErrUnsupportedVersion) {
+					return err
 				}
-			}
-			s.blockCache.Set(string(blockRoots[i]), blk, int64(len(encodedBlocks[i])))
-			if err := bkt.Put(blockRoots[i], encodedBlocks[i]); err != nil {
-				return err
+			} else {
+				blk = blindedBlock
 			}
 		}
-		return nil
-	})
+		s.blockCache.Set(string(prepared.roots[i]), blk, int64(len(prepared.encoded[i])))
+		if err := bkt.Put(prepared.roots[i], prepared.encoded[i]); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // SaveHeadBlockRoot to the db.
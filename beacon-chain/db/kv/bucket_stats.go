@@ -0,0 +1,41 @@
+package kv
+
+import (
+	"context"
+
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// BucketStats describes the space used by a top level bucket of the database.
+type BucketStats struct {
+	Name string
+	// Keys is the number of keys in the bucket, including the keys of its nested buckets.
+	Keys int
+	// Size is the number of bytes allocated to the pages of the bucket.
+	Size int64
+	// InUse is the number of bytes used by the keys and values of the bucket, within the allocated pages.
+	InUse int64
+}
+
+// AllBucketStats returns the statistics of every top level bucket of the database, ordered by bucket name. It walks
+// every page of the database, which takes a while for large databases.
+func (s *Store) AllBucketStats(ctx context.Context) ([]*BucketStats, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.AllBucketStats")
+	defer span.End()
+
+	stats := make([]*BucketStats, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			st := b.Stats()
+			stats = append(stats, &BucketStats{
+				Name:  string(name),
+				Keys:  st.KeyN,
+				Size:  int64(st.BranchAlloc + st.LeafAlloc),
+				InUse: int64(st.BranchInuse + st.LeafInuse),
+			})
+			return nil
+		})
+	})
+	return stats, err
+}
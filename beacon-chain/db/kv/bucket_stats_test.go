@@ -0,0 +1,27 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStore_AllBucketStats(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	require.NoError(t, db.SaveBlocks(ctx, makeBlocks(t, 0, 16, [32]byte{})))
+
+	stats, err := db.AllBucketStats(ctx)
+	require.NoError(t, err)
+	var blocks *BucketStats
+	for _, st := range stats {
+		if st.Name == string(blocksBucket) {
+			blocks = st
+		}
+	}
+	require.NotNil(t, blocks)
+	assert.Equal(t, 16, blocks.Keys)
+	assert.Equal(t, true, blocks.Size >= blocks.InUse)
+}
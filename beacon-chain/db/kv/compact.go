@@ -0,0 +1,190 @@
+package kv
+
+import (
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/io/file"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// compactTxMaxSize is the amount of data copied in a single write transaction during compaction.
+const compactTxMaxSize = 64 * 1024 * 1024
+
+// Compact rewrites the database file of the given directory so that it only holds the live data, reclaiming the pages
+// which bolt keeps allocated after deletions. The data is copied into a new file next to the database file, which
+// then replaces it. The database must not be in use. The sizes of the database file before and after compaction are
+// returned.
+func Compact(ctx context.Context, dirPath string) (before, after int64, err error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Compact")
+	defer span.End()
+
+	datafile := KVStoreDatafilePath(dirPath)
+	if !file.FileExists(datafile) {
+		return 0, 0, errors.Errorf("no database file at %s", datafile)
+	}
+	info, err := os.Stat(datafile)
+	if err != nil {
+		return 0, 0, err
+	}
+	before = info.Size()
+
+	src, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
+		ReadOnly: true,
+		Timeout:  params.BeaconIoConfig().BoltTimeout,
+	})
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return 0, 0, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return 0, 0, err
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			log.WithError(err).Error("Failed to close database")
+		}
+	}()
+
+	compacted := datafile + ".compact"
+	if err := os.RemoveAll(compacted); err != nil {
+		return 0, 0, err
+	}
+	dst, err := bolt.Open(compacted, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
+		NoSync:       true,
+		Timeout:      params.BeaconIoConfig().BoltTimeout,
+		FreelistType: bolt.FreelistMapType,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	// The compacted file is closed and removed on every error path, so that a failed compaction leaves the database
+	// directory as it was.
+	dstClosed := false
+	defer func() {
+		if err == nil {
+			return
+		}
+		if !dstClosed {
+			if closeErr := dst.Close(); closeErr != nil {
+				log.WithError(closeErr).Error("Failed to close compacted database")
+			}
+		}
+		if rmErr := os.Remove(compacted); rmErr != nil && !os.IsNotExist(rmErr) {
+			log.WithError(rmErr).Error("Failed to remove compacted database")
+		}
+	}()
+	dst.AllocSize = boltAllocSize
+	if err := copyDatabase(ctx, src, dst); err != nil {
+		return 0, 0, errors.Wrap(err, "could not copy database")
+	}
+	if err := dst.Sync(); err != nil {
+		return 0, 0, err
+	}
+	dstClosed = true
+	if err := dst.Close(); err != nil {
+		return 0, 0, err
+	}
+	if err := os.Rename(compacted, datafile); err != nil {
+		return 0, 0, errors.Wrap(err, "could not replace database file")
+	}
+
+	info, err = os.Stat(datafile)
+	if err != nil {
+		return 0, 0, err
+	}
+	return before, info.Size(), nil
+}
+
+// copyDatabase copies every bucket of src into dst, committing the writes whenever compactTxMaxSize bytes were
+// copied, to bound the memory used by a single write transaction.
+func copyDatabase(ctx context.Context, src, dst *bolt.DB) error {
+	tx, err := dst.Begin(true)
+	if err != nil {
+		return err
+	}
+	c := &compactor{dst: dst, tx: tx}
+	defer func() {
+		if c.tx != nil {
+			if err := c.tx.Rollback(); err != nil {
+				log.WithError(err).Error("Failed to roll back compaction transaction")
+			}
+		}
+	}()
+	if err := src.View(func(srcTx *bolt.Tx) error {
+		return srcTx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			return c.copyBucket(ctx, [][]byte{name}, b)
+		})
+	}); err != nil {
+		return err
+	}
+	err = c.tx.Commit()
+	c.tx = nil
+	return err
+}
+
+type compactor struct {
+	dst  *bolt.DB
+	tx   *bolt.Tx
+	size int
+}
+
+// copyBucket copies the keys of the source bucket b, and its nested buckets, to the bucket at the given path.
+func (c *compactor) copyBucket(ctx context.Context, path [][]byte, b *bolt.Bucket) error {
+	dstBkt, err := c.bucket(path)
+	if err != nil {
+		return err
+	}
+	if err := dstBkt.SetSequence(b.Sequence()); err != nil {
+		return err
+	}
+	return b.ForEach(func(k, v []byte) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if v == nil {
+			nested := append(path[:len(path):len(path)], k)
+			return c.copyBucket(ctx, nested, b.Bucket(k))
+		}
+		return c.put(path, k, v)
+	})
+}
+
+func (c *compactor) put(path [][]byte, k, v []byte) error {
+	if c.size+len(k)+len(v) > compactTxMaxSize {
+		if err := c.tx.Commit(); err != nil {
+			c.tx = nil
+			return err
+		}
+		tx, err := c.dst.Begin(true)
+		if err != nil {
+			c.tx = nil
+			return err
+		}
+		c.tx = tx
+		c.size = 0
+	}
+	bkt, err := c.bucket(path)
+	if err != nil {
+		return err
+	}
+	c.size += len(k) + len(v)
+	return bkt.Put(k, v)
+}
+
+// bucket returns the bucket at the given path in the current write transaction, creating it if necessary.
+func (c *compactor) bucket(path [][]byte) (*bolt.Bucket, error) {
+	bkt, err := c.tx.CreateBucketIfNotExists(path[0])
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range path[1:] {
+		bkt, err = bkt.CreateBucketIfNotExists(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return bkt, nil
+}
@@ -0,0 +1,73 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/io/file"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestCompact(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewKVStore(ctx, dir, &Config{})
+	require.NoError(t, err)
+
+	blks := makeBlocks(t, 0, 512, [32]byte{})
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	roots := make([][32]byte, len(blks))
+	for i, b := range blks {
+		roots[i], err = b.Block().HashTreeRoot()
+		require.NoError(t, err)
+	}
+	// Deleting most of the blocks leaves pages allocated in the database file.
+	require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+		for _, r := range roots[:500] {
+			if err := tx.Bucket(blocksBucket).Delete(r[:]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	require.NoError(t, db.Close())
+
+	before, after, err := Compact(ctx, dir)
+	require.NoError(t, err)
+	assert.Equal(t, true, after < before, "database was not compacted, before=%d after=%d", before, after)
+
+	db, err = NewKVStore(ctx, dir, &Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	for i, r := range roots {
+		assert.Equal(t, i >= 500, db.HasBlock(ctx, r))
+	}
+	found, _, err := db.BlockRootsBySlot(ctx, blks[510].Block().Slot())
+	require.NoError(t, err)
+	assert.Equal(t, true, found)
+}
+
+func TestCompact_NoDatabase(t *testing.T) {
+	_, _, err := Compact(context.Background(), t.TempDir())
+	require.ErrorContains(t, "no database file", err)
+}
+
+func TestCompact_RemovesCompactedFileOnError(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewKVStore(ctx, dir, &Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlocks(ctx, makeBlocks(t, 0, 8, [32]byte{})))
+	require.NoError(t, db.Close())
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, _, err = Compact(canceled, dir)
+	require.ErrorContains(t, "could not copy database", err)
+	assert.Equal(t, false, file.FileExists(KVStoreDatafilePath(dir)+".compact"))
+	assert.Equal(t, true, file.FileExists(KVStoreDatafilePath(dir)))
+}
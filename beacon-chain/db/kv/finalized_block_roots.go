@@ -106,8 +106,8 @@ func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, chec
 		}
 
 		// breaking here allows the initial checkpoint root to be correctly inserted,
-		// but stops the loop from trying to search for its parent.
-		if bytes.Equal(root, initCheckpointRoot) {
+		// but stops the loop from trying to search for its parent, unless the parent was backfilled.
+		if bytes.Equal(root, initCheckpointRoot) && tx.Bucket(blocksBucket).Get(block.ParentRoot()) == nil {
 			break
 		}
 
@@ -161,6 +161,51 @@ func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, chec
 	return bkt.Put(previousFinalizedCheckpointKey, enc)
 }
 
+// SaveFinalizedBlocks saves blocks older than the origin checkpoint, such as the blocks backfilled after checkpoint
+// sync, and adds them to the finalized block roots index in the same transaction, so that a saved block is never
+// missing from the index. The blocks must be sorted by slot and form a chain, the last block being the parent of the
+// block with the given child root.
+func (s *Store) SaveFinalizedBlocks(ctx context.Context, blocks []interfaces.SignedBeaconBlock, childRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveFinalizedBlocks")
+	defer span.End()
+
+	for _, blk := range blocks {
+		if err := wrapper.BeaconBlockIsNil(blk); err != nil {
+			return err
+		}
+	}
+	prepared, err := prepareBlocks(ctx, blocks)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := s.putBlocks(ctx, tx, blocks, prepared); err != nil {
+			return err
+		}
+		bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+		for i, blk := range blocks {
+			child := childRoot[:]
+			if i+1 < len(blocks) {
+				child = prepared.roots[i+1]
+			}
+			container := &ethpb.FinalizedBlockRootContainer{
+				ParentRoot: blk.Block().ParentRoot(),
+				ChildRoot:  child,
+			}
+			enc, err := encode(ctx, container)
+			if err != nil {
+				tracing.AnnotateError(span, err)
+				return err
+			}
+			if err := bkt.Put(prepared.roots[i], enc); err != nil {
+				tracing.AnnotateError(span, err)
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 // IsFinalizedBlock returns true if the block root is present in the finalized block root index.
 // A beacon block root contained exists in this index if it is considered finalized and canonical.
 // Note: beacon blocks from the latest finalized epoch return true, whether or not they are
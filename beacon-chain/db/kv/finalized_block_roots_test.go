@@ -191,6 +191,50 @@ func TestStore_IsFinalizedChildBlock(t *testing.T) {
 	})
 }
 
+func TestStore_SaveFinalizedBlocks(t *testing.T) {
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	db := setupDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+	blks := makeBlocks(t, 0, slotsPerEpoch*4, genesisBlockRoot)
+	origin := blks[slotsPerEpoch*2]
+	originRoot, err := origin.Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	// Initialize the database from the origin block, as done by checkpoint sync.
+	require.NoError(t, db.SaveBlocks(ctx, blks[slotsPerEpoch*2:]))
+	require.NoError(t, db.SaveOriginCheckpointBlockRoot(ctx, originRoot))
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, originRoot))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: originRoot[:]}))
+
+	// Backfill the blocks below the origin block.
+	backfilled := blks[:slotsPerEpoch*2]
+	require.NoError(t, db.SaveFinalizedBlocks(ctx, backfilled, originRoot))
+	for i := range backfilled {
+		root, err := backfilled[i].Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, db.HasBlock(ctx, root), "Block at index %d was not saved", i)
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, root), "Block at index %d was not considered finalized in the index", i)
+	}
+	child, err := db.FinalizedChildBlock(ctx, bytesutil.ToBytes32(sszRootOrDie(t, backfilled[len(backfilled)-1])))
+	require.NoError(t, err)
+	assert.DeepEqual(t, originRoot[:], sszRootOrDie(t, child))
+
+	// Backfilled blocks stay in the index when the finalized checkpoint is updated.
+	root, err := blks[slotsPerEpoch*3].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, root))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 3, Root: root[:]}))
+	for i := uint64(0); i < slotsPerEpoch*3; i++ {
+		root, err := blks[i].Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, root), "Block at index %d was not considered finalized in the index", i)
+	}
+}
+
 func sszRootOrDie(t *testing.T, block interfaces.SignedBeaconBlock) []byte {
 	root, err := block.Block().HashTreeRoot()
 	require.NoError(t, err)
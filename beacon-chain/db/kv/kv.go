@@ -84,6 +84,7 @@ type Store struct {
 	validatorEntryCache *ristretto.Cache
 	stateSummaryCache   *stateSummaryCache
 	ctx                 context.Context
+	readOnly            bool
 }
 
 // KVStoreDatafilePath is the canonical construction of a full
@@ -163,6 +164,8 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			powchainBucket,
 			stateSummaryBucket,
 			stateValidatorsBucket,
+			archivedSnapshotsBucket,
+			archivedDiffsBucket,
 			// Indices buckets.
 			attestationHeadBlockRootBucket,
 			attestationSourceRootIndicesBucket,
@@ -181,6 +184,7 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 
 			feeRecipientBucket,
 			registrationBucket,
+			lightClientUpdatesBucket,
 		)
 	}); err != nil {
 		return nil, err
@@ -194,6 +198,36 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 	return kv, nil
 }
 
+// NewReadOnlyKVStore opens the existing boltDB key-value store at the directory path specified in read-only mode, to
+// inspect a database offline without modifying it. Unlike NewKVStore, no buckets are created, so the store must only
+// be used for methods which do not rely on the schema buckets being present, such as AllBucketStats and
+// PendingMigrations.
+func NewReadOnlyKVStore(ctx context.Context, dirPath string) (*Store, error) {
+	datafile := KVStoreDatafilePath(dirPath)
+	log.Infof("Opening Bolt DB at %s in read-only mode", datafile)
+	boltDB, err := bolt.Open(
+		datafile,
+		params.BeaconIoConfig().ReadWritePermissions,
+		&bolt.Options{
+			Timeout:  1 * time.Second,
+			ReadOnly: true,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return nil, err
+	}
+	return &Store{
+		db:                boltDB,
+		databasePath:      dirPath,
+		stateSummaryCache: newStateSummaryCache(),
+		ctx:               ctx,
+		readOnly:          true,
+	}, nil
+}
+
 // ClearDB removes the previously stored database in the data directory.
 func (s *Store) ClearDB() error {
 	if _, err := os.Stat(s.databasePath); os.IsNotExist(err) {
@@ -208,6 +242,9 @@ func (s *Store) ClearDB() error {
 
 // Close closes the underlying BoltDB database.
 func (s *Store) Close() error {
+	if s.readOnly {
+		return s.db.Close()
+	}
 	prometheus.Unregister(createBoltCollector(s.db))
 
 	// Before DB closes, we should dump the cached state summary objects to DB.
@@ -0,0 +1,56 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveLightClientUpdate saves the best light client update of the given sync committee period, replacing the
+// previously saved update of the period.
+func (s *Store) SaveLightClientUpdate(ctx context.Context, period uint64, update *ethpb.LightClientUpdate) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveLightClientUpdate")
+	defer span.End()
+
+	if update == nil {
+		return errors.New("cannot save nil light client update")
+	}
+	enc, err := encode(ctx, update)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(lightClientUpdatesBucket)
+		return bkt.Put(bytesutil.Uint64ToBytesBigEndian(period), enc)
+	})
+}
+
+// LightClientUpdates returns the saved light client updates of the sync committee periods between startPeriod and
+// endPeriod, inclusive, ordered by period. Periods without a saved update are skipped.
+func (s *Store) LightClientUpdates(ctx context.Context, startPeriod, endPeriod uint64) ([]*ethpb.LightClientUpdate, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LightClientUpdates")
+	defer span.End()
+
+	if startPeriod > endPeriod {
+		return nil, errors.Errorf("start period %d is after end period %d", startPeriod, endPeriod)
+	}
+	updates := make([]*ethpb.LightClientUpdate, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(lightClientUpdatesBucket).Cursor()
+		end := bytesutil.Uint64ToBytesBigEndian(endPeriod)
+		for k, v := c.Seek(bytesutil.Uint64ToBytesBigEndian(startPeriod)); k != nil && bytes.Compare(k, end) <= 0; k, v = c.Next() {
+			update := &ethpb.LightClientUpdate{}
+			if err := decode(ctx, v, update); err != nil {
+				return err
+			}
+			updates = append(updates, update)
+		}
+		return nil
+	})
+	return updates, err
+}
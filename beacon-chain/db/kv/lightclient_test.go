@@ -0,0 +1,50 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestStore_LightClientUpdates(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	require.ErrorContains(t, "cannot save nil light client update", db.SaveLightClientUpdate(ctx, 1, nil))
+
+	update := func(slot types.Slot) *ethpb.LightClientUpdate {
+		return &ethpb.LightClientUpdate{
+			AttestedHeader: util.HydrateBeaconHeader(&ethpb.BeaconBlockHeader{Slot: slot}),
+			SignatureSlot:  slot + 1,
+		}
+	}
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 1, update(10)))
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 3, update(30)))
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 4, update(40)))
+	// The update of a period is replaced by the latest one saved.
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 3, update(31)))
+
+	updates, err := db.LightClientUpdates(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(updates))
+	assert.Equal(t, types.Slot(10), updates[0].AttestedHeader.Slot)
+	assert.Equal(t, types.Slot(31), updates[1].AttestedHeader.Slot)
+	assert.Equal(t, types.Slot(40), updates[2].AttestedHeader.Slot)
+
+	updates, err = db.LightClientUpdates(ctx, 2, 3)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(updates))
+	assert.Equal(t, types.Slot(31), updates[0].AttestedHeader.Slot)
+
+	updates, err = db.LightClientUpdates(ctx, 5, 10)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(updates))
+
+	_, err = db.LightClientUpdates(ctx, 4, 3)
+	require.ErrorContains(t, "start period 4 is after end period 3", err)
+}
@@ -1,28 +1,78 @@
 package kv
 
 import (
+	"bytes"
 	"context"
 
+	"github.com/prysmaticlabs/prysm/config/features"
 	bolt "go.etcd.io/bbolt"
 )
 
 var migrationCompleted = []byte("done")
 
-type migration func(context.Context, *bolt.DB) error
+// migration is a database migration, run at startup by RunMigrations.
+type migration struct {
+	name string
+	// key marks the migration as completed in the migrations bucket.
+	key []byte
+	// required reports whether the migration applies to the database, given the current feature flags.
+	required func(tx *bolt.Tx) bool
+	run      func(context.Context, *bolt.DB) error
+}
 
 var migrations = []migration{
-	migrateArchivedIndex,
-	migrateBlockSlotIndex,
-	migrateStateValidators,
-	migrateBlindedBeaconBlocksEnabled,
+	{
+		name: "archived-index",
+		key:  migrationArchivedIndex0Key,
+		// the migration only applies to databases which still have the deprecated bucket.
+		required: func(tx *bolt.Tx) bool { return tx.Bucket(archivedRootBucket) != nil },
+		run:      migrateArchivedIndex,
+	},
+	{
+		name:     "block-slot-index",
+		key:      migrationBlockSlotIndex0Key,
+		required: func(tx *bolt.Tx) bool { return true },
+		run:      migrateBlockSlotIndex,
+	},
+	{
+		name:     "state-validators",
+		key:      migrationStateValidatorsKey,
+		required: func(tx *bolt.Tx) bool { return features.Get().EnableHistoricalSpaceRepresentation },
+		run:      migrateStateValidators,
+	},
+	{
+		name:     "blinded-beacon-blocks",
+		key:      migrationBlindedBeaconBlocksKey,
+		required: func(tx *bolt.Tx) bool { return features.Get().EnableOnlyBlindedBeaconBlocks },
+		run:      migrateBlindedBeaconBlocksEnabled,
+	},
 }
 
 // RunMigrations defined in the migrations array.
 func (s *Store) RunMigrations(ctx context.Context) error {
 	for _, m := range migrations {
-		if err := m(ctx, s.db); err != nil {
+		if err := m.run(ctx, s.db); err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// PendingMigrations returns the names of the migrations which RunMigrations would apply to the database, given the
+// current feature flags.
+func (s *Store) PendingMigrations(_ context.Context) ([]string, error) {
+	pending := make([]string, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		mb := tx.Bucket(migrationsBucket)
+		for _, m := range migrations {
+			// the migrations bucket is missing from databases opened read-only before it was ever created.
+			completed := mb != nil && bytes.Equal(mb.Get(m.key), migrationCompleted)
+			if !m.required(tx) || completed {
+				continue
+			}
+			pending = append(pending, m.name)
+		}
+		return nil
+	})
+	return pending, err
+}
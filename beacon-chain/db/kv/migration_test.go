@@ -0,0 +1,38 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/config/features"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStore_PendingMigrations(t *testing.T) {
+	resetFn := features.InitWithReset(&features.Flags{
+		EnableHistoricalSpaceRepresentation: true,
+	})
+	defer resetFn()
+	ctx := context.Background()
+	db := setupDB(t)
+
+	pending, err := db.PendingMigrations(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{"block-slot-index", "state-validators"}, pending)
+
+	require.NoError(t, db.RunMigrations(ctx))
+	pending, err = db.PendingMigrations(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(pending))
+
+	// The archived index migration only applies to databases with the deprecated bucket.
+	require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucket(archivedRootBucket)
+		return err
+	}))
+	pending, err = db.PendingMigrations(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{"archived-index"}, pending)
+}
@@ -0,0 +1,299 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// PrunedHistorySlot returns the slot below which blocks and states were deleted by PruneHistory, or zero if the
+// history was never pruned.
+func (s *Store) PrunedHistorySlot(ctx context.Context) (types.Slot, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PrunedHistorySlot")
+	defer span.End()
+
+	var slot types.Slot
+	err := s.db.View(func(tx *bolt.Tx) error {
+		slot = bytesutil.BytesToSlotBigEndian(tx.Bucket(blocksBucket).Get(prunedHistorySlotKey))
+		return nil
+	})
+	return slot, err
+}
+
+// PruneHistory deletes the blocks with a slot lower than the given slot, along with their states, state summaries
+// and index entries. The genesis and origin checkpoint blocks and states are kept, and nothing is pruned from the
+// finalized checkpoint epoch onward. The given slot is lowered to the slot of the highest finalized block with a
+// saved state, so that the states of the slots above the pruned history can still be replayed from a saved state.
+// At most limit slots are pruned per call, to keep the write transaction short. The slot below which the history is
+// pruned after the call is saved and returned, the history is fully pruned up to the given slot once the returned
+// slot reaches it.
+func (s *Store) PruneHistory(ctx context.Context, beforeSlot types.Slot, limit int) (types.Slot, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneHistory")
+	defer span.End()
+
+	if limit <= 0 {
+		return 0, errors.New("prune limit must be positive")
+	}
+	var prunedSlot types.Slot
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		blocks := tx.Bucket(blocksBucket)
+		prunedSlot = bytesutil.BytesToSlotBigEndian(blocks.Get(prunedHistorySlotKey))
+		keep, finalizedEpoch, err := s.unprunableRoots(ctx, tx)
+		if err != nil {
+			return err
+		}
+		finalizedSlot, err := slots.EpochStart(finalizedEpoch)
+		if err != nil {
+			return err
+		}
+		if beforeSlot > finalizedSlot {
+			beforeSlot = finalizedSlot
+		}
+		if beforeSlot <= prunedSlot {
+			return nil
+		}
+		beforeSlot, err = replayableBoundary(tx, keep, prunedSlot, beforeSlot)
+		if err != nil {
+			return err
+		}
+		if beforeSlot <= prunedSlot {
+			return nil
+		}
+
+		// Collect the slots to prune before deleting anything, as the slot index is modified while pruning.
+		slotKeys := make([][]byte, 0, limit)
+		rootLists := make([][]byte, 0, limit)
+		c := tx.Bucket(blockSlotIndicesBucket).Cursor()
+		next := beforeSlot
+		for k, v := c.Seek(bytesutil.SlotToBytesBigEndian(prunedSlot)); k != nil; k, v = c.Next() {
+			slot := bytesutil.BytesToSlotBigEndian(k)
+			if slot >= beforeSlot {
+				break
+			}
+			if len(slotKeys) == limit {
+				next = slot
+				break
+			}
+			slotKeys = append(slotKeys, bytesutil.SafeCopyBytes(k))
+			rootLists = append(rootLists, bytesutil.SafeCopyBytes(v))
+		}
+
+		for i, k := range slotKeys {
+			slot := bytesutil.BytesToSlotBigEndian(k)
+			roots, err := splitRoots(rootLists[i])
+			if err != nil {
+				return errors.Wrapf(err, "could not read block roots of slot %d", slot)
+			}
+			kept := make([]byte, 0)
+			for _, root := range roots {
+				if _, ok := keep[root]; ok {
+					kept = append(kept, root[:]...)
+					continue
+				}
+				if err := s.pruneBlock(ctx, tx, root, slot); err != nil {
+					return errors.Wrapf(err, "could not prune block %#x at slot %d", root, slot)
+				}
+			}
+			idx := tx.Bucket(blockSlotIndicesBucket)
+			if len(kept) == 0 {
+				if err := idx.Delete(k); err != nil {
+					return err
+				}
+			} else if !bytes.Equal(kept, rootLists[i]) {
+				if err := idx.Put(k, kept); err != nil {
+					return err
+				}
+			}
+		}
+		prunedSlot = next
+		return blocks.Put(prunedHistorySlotKey, bytesutil.SlotToBytesBigEndian(prunedSlot))
+	})
+	return prunedSlot, err
+}
+
+// replayableBoundary returns the slot of the highest finalized block with a saved state which is not higher than
+// beforeSlot, or prunedSlot if there is none above it. Replaying the state of any slot above the returned slot
+// walks back the ancestors of the block of the slot until it reaches a saved state, which is at the latest the
+// state of the block at the returned slot.
+func replayableBoundary(
+	tx *bolt.Tx, keep map[[32]byte]struct{}, prunedSlot, beforeSlot types.Slot,
+) (types.Slot, error) {
+	states := tx.Bucket(stateBucket)
+	finalized := tx.Bucket(finalizedBlockRootsIndexBucket)
+	c := tx.Bucket(blockSlotIndicesBucket).Cursor()
+	k, v := c.Seek(bytesutil.SlotToBytesBigEndian(beforeSlot))
+	if k == nil || bytesutil.BytesToSlotBigEndian(k) > beforeSlot {
+		k, v = c.Prev()
+	}
+	for ; k != nil; k, v = c.Prev() {
+		slot := bytesutil.BytesToSlotBigEndian(k)
+		if slot <= prunedSlot {
+			break
+		}
+		roots, err := splitRoots(v)
+		if err != nil {
+			return 0, errors.Wrapf(err, "could not read block roots of slot %d", slot)
+		}
+		for _, root := range roots {
+			if states.Get(root[:]) == nil {
+				continue
+			}
+			if _, ok := keep[root]; ok || finalized.Get(root[:]) != nil {
+				return slot, nil
+			}
+		}
+	}
+	return prunedSlot, nil
+}
+
+// pruneBlock deletes the block of the given root and slot, along with its state, state summary and index entries,
+// except for its entry in the slot index which is updated by the caller.
+func (s *Store) pruneBlock(ctx context.Context, tx *bolt.Tx, root [32]byte, slot types.Slot) error {
+	if tx.Bucket(stateBucket).Get(root[:]) != nil {
+		if err := s.deleteState(ctx, tx, root, slot); err != nil {
+			return err
+		}
+	}
+	s.stateSummaryCache.delete(root)
+	if err := tx.Bucket(stateSummaryBucket).Delete(root[:]); err != nil {
+		return err
+	}
+	if err := tx.Bucket(finalizedBlockRootsIndexBucket).Delete(root[:]); err != nil {
+		return err
+	}
+	if err := tx.Bucket(blockParentRootIndicesBucket).Delete(root[:]); err != nil {
+		return err
+	}
+	s.blockCache.Del(string(root[:]))
+	return tx.Bucket(blocksBucket).Delete(root[:])
+}
+
+// unprunableRoots returns the roots of the blocks which are never pruned, which are the genesis, origin checkpoint,
+// justified and finalized blocks, along with the finalized checkpoint epoch.
+func (s *Store) unprunableRoots(ctx context.Context, tx *bolt.Tx) (map[[32]byte]struct{}, types.Epoch, error) {
+	keep := make(map[[32]byte]struct{})
+	blocks := tx.Bucket(blocksBucket)
+	for _, key := range [][]byte{genesisBlockRootKey, originCheckpointBlockRootKey} {
+		if r := blocks.Get(key); len(r) == 32 {
+			keep[bytesutil.ToBytes32(r)] = struct{}{}
+		}
+	}
+	cpBkt := tx.Bucket(checkpointBucket)
+	var finalizedEpoch types.Epoch
+	for _, key := range [][]byte{justifiedCheckpointKey, finalizedCheckpointKey} {
+		enc := cpBkt.Get(key)
+		if enc == nil {
+			continue
+		}
+		cp := &ethpb.Checkpoint{}
+		if err := decode(ctx, enc, cp); err != nil {
+			return nil, 0, err
+		}
+		keep[bytesutil.ToBytes32(cp.Root)] = struct{}{}
+		if bytes.Equal(key, finalizedCheckpointKey) {
+			finalizedEpoch = cp.Epoch
+		}
+	}
+	delete(keep, params.BeaconConfig().ZeroHash)
+	return keep, finalizedEpoch, nil
+}
+
+// validatorEntriesSweepBatch is the maximum number of validator entries inspected in a single write transaction by
+// PruneValidatorEntries.
+const validatorEntriesSweepBatch = 10000
+
+// PruneValidatorEntries deletes the validator entries which are no longer referenced by any state, once the states
+// referencing them were deleted by PruneHistory. Validator entries are shared by all the states containing the same
+// validator, so they are not deleted along with the states. The entries referenced by the saved states are collected
+// first, then the unreferenced ones are deleted in batches, each of them in its own write transaction. The entries
+// referenced by the states saved in the meantime are collected again before each batch. It returns the number of
+// deleted entries.
+func (s *Store) PruneValidatorEntries(ctx context.Context) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneValidatorEntries")
+	defer span.End()
+
+	ok, err := s.isStateValidatorMigrationOver()
+	if err != nil || !ok {
+		return 0, err
+	}
+	referenced := make(map[[32]byte]struct{})
+	marked := make(map[[32]byte]struct{})
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		return markValidatorEntries(tx, marked, referenced)
+	}); err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	var next []byte
+	for done := false; !done; {
+		if err := ctx.Err(); err != nil {
+			return deleted, err
+		}
+		err := s.db.Update(func(tx *bolt.Tx) error {
+			if err := markValidatorEntries(tx, marked, referenced); err != nil {
+				return err
+			}
+			c := tx.Bucket(stateValidatorsBucket).Cursor()
+			k, _ := c.First()
+			if next != nil {
+				k, _ = c.Seek(next)
+			}
+			var unreferenced [][]byte
+			for i := 0; k != nil && i < validatorEntriesSweepBatch; k, _ = c.Next() {
+				if _, ok := referenced[bytesutil.ToBytes32(k)]; !ok {
+					unreferenced = append(unreferenced, bytesutil.SafeCopyBytes(k))
+				}
+				i++
+			}
+			next = bytesutil.SafeCopyBytes(k)
+			done = k == nil
+			bkt := tx.Bucket(stateValidatorsBucket)
+			for _, key := range unreferenced {
+				if err := bkt.Delete(key); err != nil {
+					return err
+				}
+				s.validatorEntryCache.Del(key)
+				validatorEntryCacheDelete.Inc()
+			}
+			deleted += len(unreferenced)
+			return nil
+		})
+		if err != nil {
+			return deleted, err
+		}
+	}
+	return deleted, nil
+}
+
+// markValidatorEntries adds the validator entries referenced by the saved states which are not in marked yet to
+// referenced, and adds these states to marked.
+func markValidatorEntries(tx *bolt.Tx, marked, referenced map[[32]byte]struct{}) error {
+	return tx.Bucket(blockRootValidatorHashesBucket).ForEach(func(k, v []byte) error {
+		root := bytesutil.ToBytes32(k)
+		if _, ok := marked[root]; ok {
+			return nil
+		}
+		keys, err := snappy.Decode(nil, v)
+		if err != nil {
+			return errors.Wrapf(err, "could not uncompress validator keys of state %#x", k)
+		}
+		if len(keys)%hashLength != 0 {
+			return errors.Errorf("invalid validator keys length of state %#x: %d", k, len(keys))
+		}
+		for i := 0; i < len(keys); i += hashLength {
+			referenced[bytesutil.ToBytes32(keys[i:i+hashLength])] = struct{}{}
+		}
+		marked[root] = struct{}{}
+		return nil
+	})
+}
@@ -0,0 +1,175 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestStore_PruneHistory(t *testing.T) {
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	db := setupDB(t)
+	ctx := context.Background()
+
+	genesis, err := wrapper.WrappedSignedBeaconBlock(util.NewBeaconBlock())
+	require.NoError(t, err)
+	genesisRoot, err := genesis.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, genesisRoot))
+
+	blks := makeBlocks(t, 0, slotsPerEpoch*3, genesisRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	roots := make([][32]byte, len(blks))
+	for i, b := range blks {
+		roots[i], err = b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: b.Block().Slot(), Root: roots[i][:]}))
+	}
+	// Blocks are at slots 1 to 96, the finalized block is the block at slot 64.
+	prunedState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, prunedState.SetSlot(8))
+	require.NoError(t, db.SaveState(ctx, prunedState, roots[7]))
+	finalizedState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, finalizedState.SetSlot(64))
+	require.NoError(t, db.SaveState(ctx, finalizedState, roots[63]))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: roots[63][:]}))
+
+	_, err = db.PruneHistory(ctx, 100, 0)
+	require.ErrorContains(t, "prune limit must be positive", err)
+
+	// Pruning is capped to the finalized checkpoint epoch, and proceeds by batches of at most 16 slots.
+	var prunedSlot types.Slot
+	calls := 0
+	for prunedSlot < 64 {
+		prunedSlot, err = db.PruneHistory(ctx, 100, 16)
+		require.NoError(t, err)
+		calls++
+	}
+	assert.Equal(t, types.Slot(64), prunedSlot)
+	assert.Equal(t, 4, calls)
+	saved, err := db.PrunedHistorySlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(64), saved)
+
+	for i := 0; i < 63; i++ {
+		assert.Equal(t, false, db.HasBlock(ctx, roots[i]), "block at slot %d was not pruned", i+1)
+		assert.Equal(t, false, db.HasStateSummary(ctx, roots[i]), "state summary at slot %d was not pruned", i+1)
+	}
+	assert.Equal(t, false, db.HasState(ctx, roots[7]))
+	found, _, err := db.BlockRootsBySlot(ctx, 8)
+	require.NoError(t, err)
+	assert.Equal(t, false, found)
+	for i := 63; i < len(roots); i++ {
+		assert.Equal(t, true, db.HasBlock(ctx, roots[i]), "block at slot %d was pruned", i+1)
+	}
+	assert.Equal(t, true, db.HasState(ctx, roots[63]))
+	assert.Equal(t, true, db.HasBlock(ctx, genesisRoot))
+	assert.Equal(t, true, db.HasState(ctx, genesisRoot))
+
+	// Pruning again up to the same slot is a no-op.
+	prunedSlot, err = db.PruneHistory(ctx, 64, 16)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(64), prunedSlot)
+}
+
+func TestStore_PruneHistory_StopsAtSavedState(t *testing.T) {
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	db := setupDB(t)
+	ctx := context.Background()
+
+	genesis, err := wrapper.WrappedSignedBeaconBlock(util.NewBeaconBlock())
+	require.NoError(t, err)
+	genesisRoot, err := genesis.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, genesisRoot))
+
+	blks := makeBlocks(t, 0, slotsPerEpoch*3, genesisRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	roots := make([][32]byte, len(blks))
+	for i, b := range blks {
+		roots[i], err = b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: b.Block().Slot(), Root: roots[i][:]}))
+	}
+	// Blocks are at slots 1 to 96, an archived state is saved at slot 40 and the finalized block is at slot 64.
+	archivedState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, archivedState.SetSlot(40))
+	require.NoError(t, db.SaveState(ctx, archivedState, roots[39]))
+	finalizedState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, finalizedState.SetSlot(64))
+	require.NoError(t, db.SaveState(ctx, finalizedState, roots[63]))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: roots[63][:]}))
+
+	// The history is pruned up to the block with the highest saved state below slot 50, so that the blocks above
+	// it can be replayed from its state.
+	prunedSlot, err := db.PruneHistory(ctx, 50, 64)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(40), prunedSlot)
+	assert.Equal(t, false, db.HasBlock(ctx, roots[38]))
+	assert.Equal(t, true, db.HasBlock(ctx, roots[39]))
+	assert.Equal(t, true, db.HasState(ctx, roots[39]))
+	prunedSlot, err = db.PruneHistory(ctx, 50, 64)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(40), prunedSlot)
+}
+
+func TestStore_PruneValidatorEntries(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{
+		EnableHistoricalSpaceRepresentation: true,
+	})
+	defer resetCfg()
+	db := setupDB(t)
+	ctx := context.Background()
+
+	validator := func(i byte) *ethpb.Validator {
+		return &ethpb.Validator{
+			PublicKey:             bytesutil.PadTo([]byte{i}, fieldparams.BLSPubkeyLength),
+			WithdrawalCredentials: make([]byte, 32),
+		}
+	}
+	saveState := func(root [32]byte, slot types.Slot, vals ...*ethpb.Validator) {
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(slot))
+		require.NoError(t, st.SetValidators(vals))
+		require.NoError(t, db.SaveState(ctx, st, root))
+		require.NoError(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: slot, Root: root[:]}))
+	}
+	prunedRoot, keptRoot := [32]byte{'a'}, [32]byte{'b'}
+	saveState(prunedRoot, 1, validator(1), validator(2))
+	saveState(keptRoot, 2, validator(2), validator(3))
+	require.NoError(t, db.DeleteState(ctx, prunedRoot))
+
+	// Only the entry of the validator which is not in the remaining state is deleted.
+	deleted, err := db.PruneValidatorEntries(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+	kept, err := db.State(ctx, keptRoot)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*ethpb.Validator{validator(2), validator(3)}, kept.Validators())
+	deleted, err = db.PruneValidatorEntries(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, deleted)
+}
@@ -0,0 +1,53 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// RebuildBlockIndices recreates the block slot and parent root indices from the blocks saved in the database, which
+// repairs indices left inconsistent by an interrupted write or an older client. The indices are cleared and rebuilt
+// in a single write transaction, so that an interrupted rebuild leaves the previous indices in place. The number of
+// indexed blocks is returned.
+func (s *Store) RebuildBlockIndices(ctx context.Context) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.RebuildBlockIndices")
+	defer span.End()
+
+	count := 0
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{blockSlotIndicesBucket, blockParentRootIndicesBucket} {
+			if err := tx.DeleteBucket(name); err != nil && !errors.Is(err, bolt.ErrBucketNotFound) {
+				return errors.Wrap(err, "could not clear block indices")
+			}
+			if _, err := tx.CreateBucket(name); err != nil {
+				return errors.Wrap(err, "could not clear block indices")
+			}
+		}
+		return tx.Bucket(blocksBucket).ForEach(func(k, v []byte) error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// the blocks bucket also holds metadata such as the genesis block root, under keys which are not roots.
+			if len(k) != hashLength {
+				return nil
+			}
+			blk, err := unmarshalBlock(ctx, v)
+			if err != nil {
+				return errors.Wrapf(err, "could not unmarshal block %#x", k)
+			}
+			indicesByBucket := createBlockIndicesFromBlock(ctx, blk.Block())
+			if err := updateValueForIndices(ctx, indicesByBucket, k, tx); err != nil {
+				return errors.Wrapf(err, "could not index block %#x", k)
+			}
+			count++
+			return nil
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
@@ -0,0 +1,51 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStore_RebuildBlockIndices(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	blks := makeBlocks(t, 0, 16, [32]byte{})
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, [32]byte{'a'}))
+
+	// Corrupt the indices.
+	require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(blockSlotIndicesBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucket(blockSlotIndicesBucket)
+		return err
+	}))
+	found, _, err := db.BlockRootsBySlot(ctx, blks[3].Block().Slot())
+	require.NoError(t, err)
+	assert.Equal(t, false, found)
+
+	n, err := db.RebuildBlockIndices(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, len(blks), n)
+	for _, b := range blks {
+		root, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		found, roots, err := db.BlockRootsBySlot(ctx, b.Block().Slot())
+		require.NoError(t, err)
+		assert.Equal(t, true, found)
+		assert.DeepEqual(t, [][32]byte{root}, roots)
+	}
+
+	// An interrupted rebuild leaves the indices untouched.
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = db.RebuildBlockIndices(cancelled)
+	require.ErrorIs(t, err, context.Canceled)
+	found, _, err = db.BlockRootsBySlot(ctx, blks[3].Block().Slot())
+	require.NoError(t, err)
+	assert.Equal(t, true, found)
+}
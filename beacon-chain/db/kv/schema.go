@@ -7,19 +7,22 @@ package kv
 // it easy to scan for keys that have a certain shard number as a prefix and return those
 // corresponding attestations.
 var (
-	attestationsBucket      = []byte("attestations")
-	blocksBucket            = []byte("blocks")
-	stateBucket             = []byte("state")
-	stateSummaryBucket      = []byte("state-summary")
-	proposerSlashingsBucket = []byte("proposer-slashings")
-	attesterSlashingsBucket = []byte("attester-slashings")
-	voluntaryExitsBucket    = []byte("voluntary-exits")
-	chainMetadataBucket     = []byte("chain-metadata")
-	checkpointBucket        = []byte("check-point")
-	powchainBucket          = []byte("powchain")
-	stateValidatorsBucket   = []byte("state-validators")
-	feeRecipientBucket      = []byte("fee-recipient")
-	registrationBucket      = []byte("registration")
+	attestationsBucket       = []byte("attestations")
+	blocksBucket             = []byte("blocks")
+	stateBucket              = []byte("state")
+	stateSummaryBucket       = []byte("state-summary")
+	proposerSlashingsBucket  = []byte("proposer-slashings")
+	attesterSlashingsBucket  = []byte("attester-slashings")
+	voluntaryExitsBucket     = []byte("voluntary-exits")
+	chainMetadataBucket      = []byte("chain-metadata")
+	checkpointBucket         = []byte("check-point")
+	powchainBucket           = []byte("powchain")
+	stateValidatorsBucket    = []byte("state-validators")
+	feeRecipientBucket       = []byte("fee-recipient")
+	registrationBucket       = []byte("registration")
+	lightClientUpdatesBucket = []byte("light-client-updates")
+	archivedSnapshotsBucket  = []byte("archived-snapshots")
+	archivedDiffsBucket      = []byte("archived-diffs")
 
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	slotsHasObjectBucket = []byte("slots-has-objects")
@@ -56,6 +59,8 @@ var (
 	originCheckpointBlockRootKey = []byte("origin-checkpoint-block-root")
 	// block root tracking the progress of backfill, or pointing at genesis if backfill has not been initiated
 	backfillBlockRootKey = []byte("backfill-block-root")
+	// slot below which blocks and states were deleted by history pruning
+	prunedHistorySlotKey = []byte("pruned-history-slot")
 
 	// Deprecated: This index key was migrated in PR 6461. Do not use, except for migrations.
 	lastArchivedIndexKey = []byte("last-archived")
@@ -24,6 +24,8 @@ func (s *Store) SaveOrigin(ctx context.Context, serState, serBlock []byte) error
 		}
 		return errors.Wrap(err, "genesis block root query error: checkpoint sync must verify genesis to proceed")
 	}
+	// The backfill service, which only runs with --enable-backfill, starts from the origin block when it finds the
+	// genesis root as the backfill starting point, and moves it down as history is backfilled.
 	err = s.SaveBackfillBlockRoot(ctx, genesisRoot)
 	if err != nil {
 		return errors.Wrap(err, "unable to save genesis root as initial backfill starting point for checkpoint sync")
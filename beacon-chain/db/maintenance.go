@@ -0,0 +1,155 @@
+package db
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/io/file"
+	"github.com/urfave/cli/v2"
+)
+
+// Compact a beacon chain database offline, so that the database file only holds the live data.
+func Compact(cliCtx *cli.Context) error {
+	dbDir := path.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	log.WithField("path", kv.KVStoreDatafilePath(dbDir)).Info("Compacting database, this may take a while")
+	before, after, err := kv.Compact(cliCtx.Context, dbDir)
+	if err != nil {
+		return err
+	}
+	log.WithField("sizeBefore", before).WithField("sizeAfter", after).Info("Compaction completed successfully")
+	return nil
+}
+
+// BucketStats prints the number of keys and the size of every bucket of a beacon chain database.
+func BucketStats(cliCtx *cli.Context) error {
+	store, err := openReadOnlyStore(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer closeOfflineStore(store)
+
+	stats, err := store.AllBucketStats(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not compute bucket statistics")
+	}
+	w := tabwriter.NewWriter(cliCtx.App.Writer, 0, 0, 2, ' ', tabwriter.AlignRight)
+	if _, err := fmt.Fprintln(w, "BUCKET\tKEYS\tALLOCATED\tIN USE\t"); err != nil {
+		return err
+	}
+	var total int64
+	for _, st := range stats {
+		total += st.Size
+		if _, err := fmt.Fprintf(w, "%s\t%d\t%d\t%d\t\n", st.Name, st.Keys, st.Size, st.InUse); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	info, err := os.Stat(kv.KVStoreDatafilePath(store.DatabasePath()))
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(cliCtx.App.Writer, "\nBuckets allocated %d bytes of the %d bytes database file\n", total, info.Size())
+	return err
+}
+
+// Migrate runs the pending migrations of a beacon chain database, without starting the beacon node.
+func Migrate(cliCtx *cli.Context) error {
+	store, err := openOfflineStore(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer closeOfflineStore(store)
+
+	pending, err := store.PendingMigrations(cliCtx.Context)
+	if err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		log.Info("No pending migrations")
+		return nil
+	}
+	log.WithField("migrations", strings.Join(pending, ",")).Info("Running pending migrations")
+	if err := store.RunMigrations(cliCtx.Context); err != nil {
+		return errors.Wrap(err, "could not run migrations")
+	}
+	log.Info("Migrations completed successfully")
+	return nil
+}
+
+// VerifyMigrations returns an error if a beacon chain database has pending migrations.
+func VerifyMigrations(cliCtx *cli.Context) error {
+	store, err := openReadOnlyStore(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer closeOfflineStore(store)
+
+	pending, err := store.PendingMigrations(cliCtx.Context)
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("database has pending migrations: %s", strings.Join(pending, ","))
+	}
+	log.Info("No pending migrations")
+	return nil
+}
+
+// RebuildIndices recreates the block indices of a beacon chain database from the saved blocks.
+func RebuildIndices(cliCtx *cli.Context) error {
+	store, err := openOfflineStore(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer closeOfflineStore(store)
+
+	log.Info("Rebuilding block indices, this may take a while")
+	n, err := store.RebuildBlockIndices(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not rebuild block indices")
+	}
+	log.WithField("blocks", n).Info("Block indices rebuilt successfully")
+	return nil
+}
+
+// openOfflineStore opens the database of the data directory, which must exist and must not be in use by a running
+// beacon node.
+func openOfflineStore(cliCtx *cli.Context) (*kv.Store, error) {
+	dbDir, err := offlineDatabaseDir(cliCtx)
+	if err != nil {
+		return nil, err
+	}
+	return kv.NewKVStore(cliCtx.Context, dbDir, &kv.Config{})
+}
+
+// openReadOnlyStore opens the database of the data directory in read-only mode, so that inspecting it leaves the
+// database file untouched.
+func openReadOnlyStore(cliCtx *cli.Context) (*kv.Store, error) {
+	dbDir, err := offlineDatabaseDir(cliCtx)
+	if err != nil {
+		return nil, err
+	}
+	return kv.NewReadOnlyKVStore(cliCtx.Context, dbDir)
+}
+
+func offlineDatabaseDir(cliCtx *cli.Context) (string, error) {
+	dbDir := path.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	if !file.FileExists(kv.KVStoreDatafilePath(dbDir)) {
+		return "", fmt.Errorf("no database file at %s", kv.KVStoreDatafilePath(dbDir))
+	}
+	return dbDir, nil
+}
+
+func closeOfflineStore(store *kv.Store) {
+	if err := store.Close(); err != nil {
+		log.WithError(err).Error("Failed to close database")
+	}
+}
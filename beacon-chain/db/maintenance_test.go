@@ -0,0 +1,148 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/urfave/cli/v2"
+	bolt "go.etcd.io/bbolt"
+)
+
+func setupOfflineDB(t *testing.T) (string, [32]byte) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	store, err := kv.NewKVStore(ctx, path.Join(dataDir, kv.BeaconNodeDbDirName), &kv.Config{})
+	require.NoError(t, err)
+	// The beacon node runs the migrations when it opens the database.
+	require.NoError(t, store.RunMigrations(ctx))
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = 10
+	wsb, err := wrapper.WrappedSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	require.NoError(t, store.SaveBlock(ctx, wsb))
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, store.Close())
+	return dataDir, root
+}
+
+func offlineCliContext(t *testing.T, dataDir string, out *bytes.Buffer) *cli.Context {
+	app := cli.App{Writer: out}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, "", "")
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, dataDir))
+	return cli.NewContext(&app, set, nil)
+}
+
+// setupLegacySlotIndex rewrites the block slot index of the database in the format used before the block-slot-index
+// migration, and marks the migration as pending.
+func setupLegacySlotIndex(t *testing.T, dataDir string, slot uint64, root [32]byte) {
+	datafile := kv.KVStoreDatafilePath(path.Join(dataDir, kv.BeaconNodeDbDirName))
+	boltDB, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, nil)
+	require.NoError(t, err)
+	require.NoError(t, boltDB.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket([]byte("migrations")).Delete([]byte("block_slot_index_0")); err != nil {
+			return err
+		}
+		if err := tx.DeleteBucket([]byte("block-slot-indices")); err != nil {
+			return err
+		}
+		bkt, err := tx.CreateBucket([]byte("block-slot-indices"))
+		if err != nil {
+			return err
+		}
+		return bkt.Put([]byte(strconv.FormatUint(slot, 10)), root[:])
+	}))
+	require.NoError(t, boltDB.Close())
+}
+
+func TestMigrate(t *testing.T) {
+	logHook := logTest.NewGlobal()
+	dataDir, root := setupOfflineDB(t)
+	cliCtx := offlineCliContext(t, dataDir, &bytes.Buffer{})
+	require.NoError(t, VerifyMigrations(cliCtx))
+	setupLegacySlotIndex(t, dataDir, 10, root)
+
+	require.ErrorContains(t, "database has pending migrations: block-slot-index", VerifyMigrations(cliCtx))
+	require.NoError(t, Migrate(cliCtx))
+	assert.LogsContain(t, logHook, "Migrations completed successfully")
+	require.NoError(t, VerifyMigrations(cliCtx))
+	assert.LogsContain(t, logHook, "No pending migrations")
+
+	store, err := kv.NewKVStore(context.Background(), path.Join(dataDir, kv.BeaconNodeDbDirName), &kv.Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, store.Close())
+	}()
+	found, roots, err := store.BlockRootsBySlot(context.Background(), 10)
+	require.NoError(t, err)
+	assert.Equal(t, true, found)
+	assert.DeepEqual(t, [][32]byte{root}, roots)
+}
+
+func TestRebuildIndices(t *testing.T) {
+	logHook := logTest.NewGlobal()
+	dataDir, root := setupOfflineDB(t)
+	cliCtx := offlineCliContext(t, dataDir, &bytes.Buffer{})
+
+	require.NoError(t, RebuildIndices(cliCtx))
+	assert.LogsContain(t, logHook, "Block indices rebuilt successfully")
+
+	store, err := kv.NewKVStore(context.Background(), path.Join(dataDir, kv.BeaconNodeDbDirName), &kv.Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, store.Close())
+	}()
+	found, roots, err := store.BlockRootsBySlot(context.Background(), 10)
+	require.NoError(t, err)
+	assert.Equal(t, true, found)
+	assert.DeepEqual(t, [][32]byte{root}, roots)
+}
+
+func TestCompactAndBucketStats(t *testing.T) {
+	logHook := logTest.NewGlobal()
+	dataDir, _ := setupOfflineDB(t)
+	out := &bytes.Buffer{}
+	cliCtx := offlineCliContext(t, dataDir, out)
+
+	require.NoError(t, Compact(cliCtx))
+	assert.LogsContain(t, logHook, "Compaction completed successfully")
+
+	require.NoError(t, BucketStats(cliCtx))
+	assert.Equal(t, true, strings.Contains(out.String(), "block-slot-indices"))
+	assert.Equal(t, true, strings.Contains(out.String(), "bytes database file"))
+}
+
+func TestReadOnlyCommands_LeaveDatabaseUntouched(t *testing.T) {
+	dataDir, _ := setupOfflineDB(t)
+	cliCtx := offlineCliContext(t, dataDir, &bytes.Buffer{})
+	datafile := kv.KVStoreDatafilePath(path.Join(dataDir, kv.BeaconNodeDbDirName))
+	before, err := os.ReadFile(datafile)
+	require.NoError(t, err)
+
+	require.NoError(t, BucketStats(cliCtx))
+	require.NoError(t, VerifyMigrations(cliCtx))
+	after, err := os.ReadFile(datafile)
+	require.NoError(t, err)
+	assert.Equal(t, true, bytes.Equal(before, after), "Expected the database file to be unchanged")
+}
+
+func TestOfflineCommands_NoDatabase(t *testing.T) {
+	cliCtx := offlineCliContext(t, t.TempDir(), &bytes.Buffer{})
+	require.ErrorContains(t, "no database file", Migrate(cliCtx))
+	require.ErrorContains(t, "no database file", Compact(cliCtx))
+}
@@ -0,0 +1,17 @@
+package pruner
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	prunedHistorySlot = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "pruned_history_slot",
+		Help: "Slot below which blocks and states were pruned from the database.",
+	})
+	pruneBatchesCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "prune_history_batches_count",
+		Help: "Count the number of history pruning batches written to the database.",
+	})
+)
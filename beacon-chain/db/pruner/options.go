@@ -0,0 +1,50 @@
+package pruner
+
+import (
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+)
+
+type Option func(s *Service) error
+
+// WithDatabase sets the database the history is pruned from.
+func WithDatabase(db PruneDB) Option {
+	return func(s *Service) error {
+		s.cfg.db = db
+		return nil
+	}
+}
+
+// WithBackfillStatus sets the backfill status, which is told about the slots that were pruned.
+func WithBackfillStatus(st *backfill.Status) Option {
+	return func(s *Service) error {
+		s.cfg.status = st
+		return nil
+	}
+}
+
+// WithRetentionEpochs sets the number of epochs of history kept below the finalized checkpoint.
+func WithRetentionEpochs(e types.Epoch) Option {
+	return func(s *Service) error {
+		s.cfg.retentionEpochs = e
+		return nil
+	}
+}
+
+// WithBatchSize sets the maximum number of slots pruned in a single database transaction.
+func WithBatchSize(n int) Option {
+	return func(s *Service) error {
+		s.cfg.batchSize = n
+		return nil
+	}
+}
+
+// WithInterval sets the amount of time between two pruning rounds.
+func WithInterval(d time.Duration) Option {
+	return func(s *Service) error {
+		s.cfg.interval = d
+		return nil
+	}
+}
@@ -0,0 +1,159 @@
+package pruner
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultBatchSize is the default maximum number of slots pruned in a single database transaction.
+	DefaultBatchSize = 64
+	// DefaultInterval is the default amount of time between two pruning rounds.
+	DefaultInterval = time.Minute
+)
+
+var errMissingRequired = errors.New("pruner service is missing a required dependency")
+
+var _ runtime.Service = (*Service)(nil)
+
+// PruneDB is the subset of the database used by the pruner service.
+type PruneDB interface {
+	FinalizedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, error)
+	PrunedHistorySlot(ctx context.Context) (types.Slot, error)
+	PruneHistory(ctx context.Context, beforeSlot types.Slot, limit int) (types.Slot, error)
+	PruneValidatorEntries(ctx context.Context) (int, error)
+}
+
+// config defines a config struct for dependencies into the service.
+type config struct {
+	db              PruneDB
+	status          *backfill.Status
+	retentionEpochs types.Epoch
+	batchSize       int
+	interval        time.Duration
+}
+
+// Service deletes the finalized blocks and states which are older than the retention window from the database, so
+// that non-archival nodes do not need an ever-growing disk. The history is pruned in small batches, each of them in
+// its own database transaction, so that pruning a large history does not block the other database writers.
+type Service struct {
+	cfg    *config
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewService initializes the pruner service.
+func NewService(ctx context.Context, opts ...Option) (*Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg: &config{
+			batchSize: DefaultBatchSize,
+			interval:  DefaultInterval,
+		},
+	}
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	if s.cfg.db == nil || s.cfg.status == nil {
+		cancel()
+		return nil, errMissingRequired
+	}
+	if s.cfg.batchSize <= 0 {
+		cancel()
+		return nil, errors.New("prune batch size must be positive")
+	}
+	return s, nil
+}
+
+// Start prunes the history periodically until the service is stopped.
+func (s *Service) Start() {
+	log.WithField("retentionEpochs", s.cfg.retentionEpochs).Info("Pruning history older than the retention window")
+	ticker := time.NewTicker(s.cfg.interval)
+	defer ticker.Stop()
+	for {
+		if err := s.prune(s.ctx); err != nil && !errors.Is(err, context.Canceled) {
+			log.WithError(err).Error("Could not prune history")
+		}
+		select {
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting pruner")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop the pruner service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the pruner service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// prune deletes the history below the retention window, one batch at a time, and then the validator entries which
+// were only referenced by the deleted states.
+func (s *Service) prune(ctx context.Context) error {
+	cp, err := s.cfg.db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	if cp.Epoch <= s.cfg.retentionEpochs {
+		return nil
+	}
+	target, err := slots.EpochStart(cp.Epoch - s.cfg.retentionEpochs)
+	if err != nil {
+		return err
+	}
+	pruned, err := s.cfg.db.PrunedHistorySlot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get pruned history slot")
+	}
+	start := pruned
+	for pruned < target {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		next, err := s.cfg.db.PruneHistory(ctx, target, s.cfg.batchSize)
+		if err != nil {
+			return err
+		}
+		pruneBatchesCount.Inc()
+		if next <= pruned {
+			// Nothing below the finalized checkpoint is left to prune.
+			break
+		}
+		pruned = next
+		s.cfg.status.MarkPruned(pruned)
+		prunedHistorySlot.Set(float64(pruned))
+	}
+	if pruned <= start {
+		return nil
+	}
+	validatorEntries, err := s.cfg.db.PruneValidatorEntries(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not prune validator entries")
+	}
+	log.WithFields(logrus.Fields{
+		"fromSlot":         start,
+		"toSlot":           pruned,
+		"validatorEntries": validatorEntries,
+	}).Info("Pruned history")
+	return nil
+}
@@ -0,0 +1,187 @@
+package pruner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	mockstategen "github.com/prysmaticlabs/prysm/beacon-chain/state/stategen/mock"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+type mockPruneDB struct {
+	finalized *ethpb.Checkpoint
+	pruned    types.Slot
+	// lastSlot is the slot of the highest block below the finalized checkpoint.
+	lastSlot types.Slot
+	calls    int
+	// sweeps is the number of times the unreferenced validator entries were pruned.
+	sweeps int
+	err    error
+}
+
+func (db *mockPruneDB) FinalizedCheckpoint(_ context.Context) (*ethpb.Checkpoint, error) {
+	return db.finalized, nil
+}
+
+func (db *mockPruneDB) PrunedHistorySlot(_ context.Context) (types.Slot, error) {
+	return db.pruned, nil
+}
+
+func (db *mockPruneDB) PruneHistory(_ context.Context, beforeSlot types.Slot, limit int) (types.Slot, error) {
+	db.calls++
+	if db.err != nil {
+		return 0, db.err
+	}
+	if beforeSlot <= db.pruned {
+		return db.pruned, nil
+	}
+	db.pruned += types.Slot(limit)
+	if db.pruned > beforeSlot {
+		db.pruned = beforeSlot
+	}
+	return db.pruned, nil
+}
+
+func (db *mockPruneDB) PruneValidatorEntries(_ context.Context) (int, error) {
+	db.sweeps++
+	return 0, nil
+}
+
+func TestNewService(t *testing.T) {
+	ctx := context.Background()
+	_, err := NewService(ctx, WithDatabase(&mockPruneDB{}))
+	require.ErrorIs(t, err, errMissingRequired)
+	_, err = NewService(ctx, WithDatabase(&mockPruneDB{}), WithBackfillStatus(backfill.NewStatus(nil)), WithBatchSize(0))
+	require.ErrorContains(t, "prune batch size must be positive", err)
+	s, err := NewService(ctx, WithDatabase(&mockPruneDB{}), WithBackfillStatus(backfill.NewStatus(nil)))
+	require.NoError(t, err)
+	assert.Equal(t, DefaultBatchSize, s.cfg.batchSize)
+	assert.Equal(t, DefaultInterval, s.cfg.interval)
+}
+
+func TestService_prune(t *testing.T) {
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	t.Run("within retention window", func(t *testing.T) {
+		db := &mockPruneDB{finalized: &ethpb.Checkpoint{Epoch: 10}}
+		status := backfill.NewStatus(nil)
+		s, err := NewService(ctx, WithDatabase(db), WithBackfillStatus(status), WithRetentionEpochs(10))
+		require.NoError(t, err)
+		require.NoError(t, s.prune(ctx))
+		assert.Equal(t, 0, db.calls)
+		assert.Equal(t, types.Slot(0), status.PrunedSlot())
+	})
+	t.Run("prunes in batches", func(t *testing.T) {
+		db := &mockPruneDB{finalized: &ethpb.Checkpoint{Epoch: 12}}
+		status := backfill.NewStatus(nil)
+		s, err := NewService(ctx, WithDatabase(db), WithBackfillStatus(status), WithRetentionEpochs(10), WithBatchSize(int(slotsPerEpoch)))
+		require.NoError(t, err)
+		require.NoError(t, s.prune(ctx))
+		assert.Equal(t, 2, db.calls)
+		assert.Equal(t, 2*slotsPerEpoch, status.PrunedSlot())
+		assert.Equal(t, 1, db.sweeps)
+
+		// Nothing is left to prune until the finalized checkpoint advances.
+		require.NoError(t, s.prune(ctx))
+		assert.Equal(t, 2, db.calls)
+		assert.Equal(t, 1, db.sweeps)
+		db.finalized = &ethpb.Checkpoint{Epoch: 13}
+		require.NoError(t, s.prune(ctx))
+		assert.Equal(t, 3, db.calls)
+		assert.Equal(t, 3*slotsPerEpoch, status.PrunedSlot())
+		assert.Equal(t, 2, db.sweeps)
+	})
+	t.Run("database error", func(t *testing.T) {
+		derp := errors.New("derp")
+		db := &mockPruneDB{finalized: &ethpb.Checkpoint{Epoch: 12}, err: derp}
+		status := backfill.NewStatus(nil)
+		s, err := NewService(ctx, WithDatabase(db), WithBackfillStatus(status), WithRetentionEpochs(10))
+		require.NoError(t, err)
+		require.ErrorIs(t, s.prune(ctx), derp)
+		assert.Equal(t, types.Slot(0), status.PrunedSlot())
+	})
+}
+
+func TestService_prune_KeepsHistoryReplayable(t *testing.T) {
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	beaconDB := testDB.SetupDB(t)
+
+	st, keys := util.DeterministicGenesisState(t, 64)
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis, err := wrapper.WrappedSignedBeaconBlock(blocks.NewGenesisBlock(stateRoot[:]))
+	require.NoError(t, err)
+	genesisRoot, err := genesis.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesis))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, beaconDB.SaveState(ctx, st, genesisRoot))
+	require.NoError(t, beaconDB.SaveStateSummary(ctx, &ethpb.StateSummary{Root: genesisRoot[:]}))
+
+	// The states of the blocks at an archive point and at the finalized checkpoint are saved, and the retention
+	// window starts in between, at the start of epoch 2.
+	archived, finalized := slotsPerEpoch+8, 3*slotsPerEpoch
+	roots := make(map[types.Slot][32]byte)
+	stateRoots := make(map[types.Slot][32]byte)
+	bState := st.Copy()
+	for slot := types.Slot(1); slot <= finalized; slot++ {
+		b, err := util.GenerateFullBlock(bState, keys, util.DefaultBlockGenConfig(), slot)
+		require.NoError(t, err)
+		wsb, err := wrapper.WrappedSignedBeaconBlock(b)
+		require.NoError(t, err)
+		bState, err = transition.ExecuteStateTransition(ctx, bState, wsb)
+		require.NoError(t, err)
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveBlock(ctx, wsb))
+		require.NoError(t, beaconDB.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: slot, Root: root[:]}))
+		if slot == archived || slot == finalized {
+			require.NoError(t, beaconDB.SaveState(ctx, bState.Copy(), root))
+		}
+		roots[slot] = root
+		stateRoots[slot] = bytesutil.ToBytes32(b.Block.StateRoot)
+	}
+	finalizedRoot := roots[finalized]
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{
+		Epoch: types.Epoch(finalized / slotsPerEpoch),
+		Root:  finalizedRoot[:],
+	}))
+
+	status := backfill.NewStatus(nil)
+	s, err := NewService(ctx, WithDatabase(beaconDB), WithBackfillStatus(status), WithRetentionEpochs(1))
+	require.NoError(t, err)
+	require.NoError(t, s.prune(ctx))
+
+	// The history is only pruned up to the archive point, as the slots above it could not be replayed otherwise.
+	assert.Equal(t, archived, status.PrunedSlot())
+	assert.Equal(t, false, beaconDB.HasBlock(ctx, roots[archived-1]))
+	assert.Equal(t, true, beaconDB.HasState(ctx, roots[archived]))
+	ch := stategen.NewCanonicalHistory(
+		beaconDB,
+		&mockstategen.MockCanonicalChecker{Is: true},
+		&mockstategen.MockCurrentSlotter{Slot: finalized},
+	)
+	for _, slot := range []types.Slot{archived + 1, 2 * slotsPerEpoch} {
+		require.Equal(t, true, status.SlotCovered(slot))
+		replayed, err := ch.ReplayerForSlot(slot).ReplayBlocks(ctx)
+		require.NoError(t, err)
+		replayedRoot, err := replayed.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, stateRoots[slot], replayedRoot, "unexpected state replayed at slot %d", slot)
+	}
+}
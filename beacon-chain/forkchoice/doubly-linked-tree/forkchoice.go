@@ -374,6 +374,36 @@ func (f *ForkChoice) ProposerBoost() [fieldparams.RootLength]byte {
 	return f.store.proposerBoost()
 }
 
+// HighestReceivedBlockSlot returns the highest slot of a block received by fork choice.
+func (f *ForkChoice) HighestReceivedBlockSlot() types.Slot {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+	return f.store.highestReceivedSlot
+}
+
+// ReceivedBlocksLastEpoch returns the number of slots of the last epoch, counting back from the
+// current wall clock slot (exclusive), for which fork choice has received a block.
+func (f *ForkChoice) ReceivedBlocksLastEpoch() (uint64, error) {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+	currentSlot := slots.CurrentSlot(f.store.genesisTime)
+	lowerBound := types.Slot(0)
+	if currentSlot > params.BeaconConfig().SlotsPerEpoch {
+		var err error
+		lowerBound, err = currentSlot.SafeSub(uint64(params.BeaconConfig().SlotsPerEpoch))
+		if err != nil {
+			return 0, err
+		}
+	}
+	received := make(map[types.Slot]bool)
+	for _, node := range f.store.nodeByRoot {
+		if node.slot >= lowerBound && node.slot < currentSlot {
+			received[node.slot] = true
+		}
+	}
+	return uint64(len(received)), nil
+}
+
 // SetOptimisticToValid sets the node with the given root as a fully validated node
 func (f *ForkChoice) SetOptimisticToValid(ctx context.Context, root [fieldparams.RootLength]byte) error {
 	f.store.nodesLock.Lock()
@@ -140,6 +140,9 @@ func (s *Store) insert(ctx context.Context,
 
 	s.nodeByPayload[payloadHash] = n
 	s.nodeByRoot[root] = n
+	if slot > s.highestReceivedSlot {
+		s.highestReceivedSlot = slot
+	}
 	if parent == nil {
 		if s.treeRootNode == nil {
 			s.treeRootNode = n
@@ -3,6 +3,7 @@ package doublylinkedtree
 import (
 	"context"
 	"testing"
+	"time"
 
 	forkchoicetypes "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/config/params"
@@ -354,3 +355,24 @@ func TestStore_HasParent(t *testing.T) {
 	require.Equal(t, true, f.HasParent(indexToHash(3)))
 	require.Equal(t, false, f.HasParent(indexToHash(4)))
 }
+
+func TestStore_ReceivedBlocks(t *testing.T) {
+	f := setup(0, 0)
+	ctx := context.Background()
+	require.Equal(t, types.Slot(0), f.HighestReceivedBlockSlot())
+
+	// The current slot is 40, so the last epoch spans slots [8, 40).
+	f.SetGenesisTime(uint64(time.Now().Unix()) - 40*params.BeaconConfig().SecondsPerSlot)
+	parent := params.BeaconConfig().ZeroHash
+	for i, slot := range []types.Slot{5, 10, 20, 39} {
+		root := indexToHash(uint64(i + 1))
+		st, blkRoot, err := prepareForkchoiceState(ctx, slot, root, parent, params.BeaconConfig().ZeroHash, 0, 0)
+		require.NoError(t, err)
+		require.NoError(t, f.InsertNode(ctx, st, blkRoot))
+		parent = root
+	}
+	require.Equal(t, types.Slot(39), f.HighestReceivedBlockSlot())
+	count, err := f.ReceivedBlocksLastEpoch()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+}
@@ -34,6 +34,7 @@ type Store struct {
 	nodeByPayload                 map[[fieldparams.RootLength]byte]*Node // nodes indexed by payload Hash
 	slashedIndices                map[types.ValidatorIndex]bool          // the list of equivocating validator indices
 	originRoot                    [fieldparams.RootLength]byte           // The genesis block root
+	highestReceivedSlot           types.Slot                             // The highest slot of a block received by fork choice.
 	nodesLock                     sync.RWMutex
 	proposerBoostLock             sync.RWMutex
 	checkpointsLock               sync.RWMutex
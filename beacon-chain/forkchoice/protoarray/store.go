@@ -119,6 +119,36 @@ func (f *ForkChoice) ProposerBoost() [fieldparams.RootLength]byte {
 	return f.store.proposerBoost()
 }
 
+// HighestReceivedBlockSlot returns the highest slot of a block received by fork choice.
+func (f *ForkChoice) HighestReceivedBlockSlot() types.Slot {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+	return f.store.highestReceivedSlot
+}
+
+// ReceivedBlocksLastEpoch returns the number of slots of the last epoch, counting back from the
+// current wall clock slot (exclusive), for which fork choice has received a block.
+func (f *ForkChoice) ReceivedBlocksLastEpoch() (uint64, error) {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+	currentSlot := slots.CurrentSlot(f.store.genesisTime)
+	lowerBound := types.Slot(0)
+	if currentSlot > params.BeaconConfig().SlotsPerEpoch {
+		var err error
+		lowerBound, err = currentSlot.SafeSub(uint64(params.BeaconConfig().SlotsPerEpoch))
+		if err != nil {
+			return 0, err
+		}
+	}
+	received := make(map[types.Slot]bool)
+	for _, node := range f.store.nodes {
+		if node.slot >= lowerBound && node.slot < currentSlot {
+			received[node.slot] = true
+		}
+	}
+	return uint64(len(received)), nil
+}
+
 // InsertNode processes a new block by inserting it to the fork choice store.
 func (f *ForkChoice) InsertNode(ctx context.Context, state state.BeaconState, root [32]byte) error {
 	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.InsertNode")
@@ -505,6 +535,9 @@ func (s *Store) insert(ctx context.Context,
 	s.nodesIndices[root] = index
 	s.payloadIndices[payloadHash] = index
 	s.nodes = append(s.nodes, n)
+	if slot > s.highestReceivedSlot {
+		s.highestReceivedSlot = slot
+	}
 
 	// Apply proposer boost
 	timeNow := uint64(time.Now().Unix())
@@ -1273,3 +1273,24 @@ func TestForkChoice_UpdateCheckpoints(t *testing.T) {
 		})
 	}
 }
+
+func TestStore_ReceivedBlocks(t *testing.T) {
+	f := setup(0, 0)
+	ctx := context.Background()
+	require.Equal(t, types.Slot(0), f.HighestReceivedBlockSlot())
+
+	// The current slot is 40, so the last epoch spans slots [8, 40).
+	f.SetGenesisTime(uint64(time.Now().Unix()) - 40*params.BeaconConfig().SecondsPerSlot)
+	parent := params.BeaconConfig().ZeroHash
+	for i, slot := range []types.Slot{5, 10, 20, 39} {
+		root := indexToHash(uint64(i + 1))
+		st, blkRoot, err := prepareForkchoiceState(ctx, slot, root, parent, params.BeaconConfig().ZeroHash, 0, 0)
+		require.NoError(t, err)
+		require.NoError(t, f.InsertNode(ctx, st, blkRoot))
+		parent = root
+	}
+	require.Equal(t, types.Slot(39), f.HighestReceivedBlockSlot())
+	count, err := f.ReceivedBlocksLastEpoch()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+}
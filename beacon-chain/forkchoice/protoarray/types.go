@@ -35,6 +35,7 @@ type Store struct {
 	slashedIndices                map[types.ValidatorIndex]bool           // The list of equivocating validators
 	originRoot                    [fieldparams.RootLength]byte            // The genesis block root
 	lastHeadRoot                  [fieldparams.RootLength]byte            // The last cached head block root
+	highestReceivedSlot           types.Slot                              // The highest slot of a block received by fork choice.
 	nodesLock                     sync.RWMutex
 	proposerBoostLock             sync.RWMutex
 	checkpointsLock               sync.RWMutex
@@ -27,7 +27,12 @@ func DefaultConfig(enableDebugRPCEndpoints bool, httpModules string) MuxConfig {
 			ethpbalpha.RegisterHealthHandler,
 		}
 		if enableDebugRPCEndpoints {
-			v1AlphaRegistrations = append(v1AlphaRegistrations, ethpbalpha.RegisterDebugHandler)
+			v1AlphaRegistrations = append(
+				v1AlphaRegistrations,
+				ethpbalpha.RegisterDebugHandler,
+				ethpbalpha.RegisterPeerScoresHandler,
+				ethpbalpha.RegisterPeerAccessHandler,
+			)
 
 		}
 		v1AlphaMux := gwruntime.NewServeMux(
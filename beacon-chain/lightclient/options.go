@@ -0,0 +1,50 @@
+package lightclient
+
+import (
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+)
+
+type Option func(s *Service) error
+
+// WithDatabase sets the database used to read blocks and to store the best light client update of each period.
+func WithDatabase(db db.NoHeadAccessDatabase) Option {
+	return func(s *Service) error {
+		s.cfg.db = db
+		return nil
+	}
+}
+
+// WithStateGen sets the state manager used to retrieve the post states of the attested blocks.
+func WithStateGen(sg stategen.StateManager) Option {
+	return func(s *Service) error {
+		s.cfg.stateGen = sg
+		return nil
+	}
+}
+
+// WithStateNotifier sets the notifier of the processed blocks.
+func WithStateNotifier(sn statefeed.Notifier) Option {
+	return func(s *Service) error {
+		s.cfg.stateNotifier = sn
+		return nil
+	}
+}
+
+// WithBroadcaster sets the broadcaster used to gossip the light client finality and optimistic updates.
+func WithBroadcaster(b p2p.Broadcaster) Option {
+	return func(s *Service) error {
+		s.cfg.broadcaster = b
+		return nil
+	}
+}
+
+// WithInitialSync sets the initial sync checker, no light client update is computed while the node is syncing.
+func WithInitialSync(checker InitialSyncChecker) Option {
+	return func(s *Service) error {
+		s.cfg.initialSync = checker
+		return nil
+	}
+}
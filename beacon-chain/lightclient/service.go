@@ -0,0 +1,328 @@
+// Package lightclient implements the light client server of the beacon node. It computes light client updates from
+// the sync aggregates of the processed blocks, keeps the best update of every sync committee period in the database,
+// and publishes the latest finality and optimistic updates to the event stream and the network.
+package lightclient
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/async/event"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// ErrNotFound is returned when the requested light client data is not available.
+	ErrNotFound = errors.New("light client data not found")
+
+	errMissingRequired = errors.New("light client service is missing a required dependency")
+)
+
+var (
+	_ runtime.Service = (*Service)(nil)
+	_ UpdatesFetcher  = (*Service)(nil)
+)
+
+// UpdatesFetcher returns the latest light client finality and optimistic updates.
+type UpdatesFetcher interface {
+	FinalityUpdate() (*ethpb.LightClientFinalityUpdate, error)
+	OptimisticUpdate() (*ethpb.LightClientOptimisticUpdate, error)
+}
+
+// InitialSyncChecker reports whether the node is still performing initial sync.
+type InitialSyncChecker interface {
+	Syncing() bool
+}
+
+// config defines a config struct for dependencies into the service.
+type config struct {
+	db            db.NoHeadAccessDatabase
+	stateGen      stategen.StateManager
+	stateNotifier statefeed.Notifier
+	broadcaster   p2p.Broadcaster
+	initialSync   InitialSyncChecker
+}
+
+// Service computes a light client update for every processed block carrying a sync aggregate. The best update of
+// each sync committee period is saved to the database, and the updates bringing a newer finalized or attested header
+// are kept in memory, sent on the state feed and broadcast to the network.
+type Service struct {
+	cfg    *config
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	lock             sync.RWMutex
+	finalityUpdate   *ethpb.LightClientFinalityUpdate
+	optimisticUpdate *ethpb.LightClientOptimisticUpdate
+}
+
+// NewService initializes the light client service.
+func NewService(ctx context.Context, opts ...Option) (*Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    &config{},
+	}
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	if s.cfg.db == nil || s.cfg.stateGen == nil || s.cfg.stateNotifier == nil {
+		cancel()
+		return nil, errMissingRequired
+	}
+	return s, nil
+}
+
+// Start listens to the processed blocks to compute light client updates.
+func (s *Service) Start() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.stateNotifier.StateFeed().Subscribe(stateChannel)
+	go s.run(stateChannel, stateSub)
+}
+
+// Stop the light client service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the light client service.
+func (s *Service) Status() error {
+	return nil
+}
+
+func (s *Service) run(stateChannel chan *feed.Event, stateSub event.Subscription) {
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case e := <-stateChannel:
+			if e.Type != statefeed.BlockProcessed {
+				continue
+			}
+			data, ok := e.Data.(*statefeed.BlockProcessedData)
+			if !ok {
+				log.Error("Event feed data is not of type *statefeed.BlockProcessedData")
+				continue
+			}
+			// Blocks of initial sync are processed in batches, and their parent states are generally not available
+			// anymore. Light clients are only interested in the updates of the head of the chain anyway.
+			if !data.Verified || (s.cfg.initialSync != nil && s.cfg.initialSync.Syncing()) {
+				continue
+			}
+			if err := s.processBlock(s.ctx, data.SignedBlock); err != nil {
+				log.WithError(err).WithField("slot", data.Slot).Debug("Could not compute light client update")
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			return
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state notifier")
+			return
+		}
+	}
+}
+
+// processBlock computes the light client update of the sync aggregate of the given block.
+func (s *Service) processBlock(ctx context.Context, blk interfaces.SignedBeaconBlock) error {
+	if err := wrapper.BeaconBlockIsNil(blk); err != nil {
+		return err
+	}
+	if blk.Version() == version.Phase0 {
+		return nil
+	}
+	parentRoot := bytesutil.ToBytes32(blk.Block().ParentRoot())
+	attestedBlock, err := s.cfg.db.Block(ctx, parentRoot)
+	if err != nil {
+		return errors.Wrapf(err, "could not get attested block %#x", parentRoot)
+	}
+	attestedState, err := s.cfg.stateGen.StateByRoot(ctx, parentRoot)
+	if err != nil {
+		return errors.Wrapf(err, "could not get attested state %#x", parentRoot)
+	}
+	if attestedState.Version() == version.Phase0 {
+		return nil
+	}
+	finalizedBlock, err := s.finalizedBlock(ctx, bytesutil.ToBytes32(attestedState.FinalizedCheckpoint().Root))
+	if err != nil {
+		return err
+	}
+	update, err := NewLightClientUpdate(ctx, blk, attestedState, attestedBlock, finalizedBlock)
+	if err != nil {
+		if errors.Is(err, errNotEnoughParticipants) {
+			return nil
+		}
+		return err
+	}
+	if err := s.saveBestUpdate(ctx, update); err != nil {
+		return err
+	}
+
+	finalityUpdate, optimisticUpdate := s.setLatestUpdates(update)
+	genesisTime := attestedState.GenesisTime()
+	if finalityUpdate != nil {
+		go s.publish(genesisTime, update.SignatureSlot, statefeed.LightClientFinalityUpdate, finalityUpdate)
+	}
+	if optimisticUpdate != nil {
+		go s.publish(genesisTime, update.SignatureSlot, statefeed.LightClientOptimisticUpdate, optimisticUpdate)
+	}
+	return nil
+}
+
+// finalizedBlock returns the block of the given finalized root, or nil if it is not available, which is the case of
+// the blocks finalized before the origin of a node initialized via checkpoint sync.
+func (s *Service) finalizedBlock(ctx context.Context, root [32]byte) (interfaces.SignedBeaconBlock, error) {
+	if root == params.BeaconConfig().ZeroHash {
+		blk, err := s.cfg.db.GenesisBlock(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get genesis block")
+		}
+		return blk, nil
+	}
+	blk, err := s.cfg.db.Block(ctx, root)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get finalized block %#x", root)
+	}
+	return blk, nil
+}
+
+// saveBestUpdate saves the update as the update of the sync committee period of its attested header, if it is better
+// than the saved one.
+func (s *Service) saveBestUpdate(ctx context.Context, update *ethpb.LightClientUpdate) error {
+	period := Period(update.AttestedHeader.Slot)
+	saved, err := s.cfg.db.LightClientUpdates(ctx, period, period)
+	if err != nil {
+		return errors.Wrapf(err, "could not get light client update of period %d", period)
+	}
+	if len(saved) > 0 && !IsBetterUpdate(update, saved[0]) {
+		return nil
+	}
+	if err := s.cfg.db.SaveLightClientUpdate(ctx, period, update); err != nil {
+		return errors.Wrapf(err, "could not save light client update of period %d", period)
+	}
+	log.WithFields(logrus.Fields{
+		"period":        period,
+		"attestedSlot":  update.AttestedHeader.Slot,
+		"signatureSlot": update.SignatureSlot,
+		"participants":  update.SyncAggregate.SyncCommitteeBits.Count(),
+	}).Debug("Saved best light client update of period")
+	return nil
+}
+
+// setLatestUpdates keeps the finality and optimistic updates of the given update if they bring a newer finalized or
+// attested header. The updates which replaced the latest ones are returned.
+func (s *Service) setLatestUpdates(update *ethpb.LightClientUpdate) (*ethpb.LightClientFinalityUpdate, *ethpb.LightClientOptimisticUpdate) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var finalityUpdate *ethpb.LightClientFinalityUpdate
+	if isFinalityUpdate(update) && (s.finalityUpdate == nil || update.FinalizedHeader.Slot > s.finalityUpdate.FinalizedHeader.Slot) {
+		finalityUpdate = FinalityUpdate(update)
+		s.finalityUpdate = finalityUpdate
+	}
+	var optimisticUpdate *ethpb.LightClientOptimisticUpdate
+	if s.optimisticUpdate == nil || update.AttestedHeader.Slot > s.optimisticUpdate.AttestedHeader.Slot {
+		optimisticUpdate = OptimisticUpdate(update)
+		s.optimisticUpdate = optimisticUpdate
+	}
+	return finalityUpdate, optimisticUpdate
+}
+
+// publish sends the update on the state feed and to the network once the block of the signature slot had time to
+// propagate, as peers ignore the updates received before.
+func (s *Service) publish(genesisTime uint64, signatureSlot types.Slot, eventType feed.EventType, msg proto.Message) {
+	wait := time.Until(PropagationTime(genesisTime, signatureSlot))
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-s.ctx.Done():
+			return
+		}
+	}
+	s.cfg.stateNotifier.StateFeed().Send(&feed.Event{
+		Type: eventType,
+		Data: msg,
+	})
+	if s.cfg.broadcaster == nil {
+		return
+	}
+	if err := s.cfg.broadcaster.Broadcast(s.ctx, msg); err != nil {
+		log.WithError(err).Debug("Could not broadcast light client update")
+	}
+}
+
+// PropagationTime returns the time after which a light client update signed in the given slot can be gossiped, which
+// is one third of the slot after its start.
+func PropagationTime(genesisTime uint64, signatureSlot types.Slot) time.Time {
+	offset := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second / time.Duration(params.BeaconConfig().IntervalsPerSlot)
+	return slots.StartTime(genesisTime, signatureSlot).Add(offset)
+}
+
+// FinalityUpdate returns the latest light client finality update.
+func (s *Service) FinalityUpdate() (*ethpb.LightClientFinalityUpdate, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.finalityUpdate == nil {
+		return nil, ErrNotFound
+	}
+	return s.finalityUpdate, nil
+}
+
+// OptimisticUpdate returns the latest light client optimistic update.
+func (s *Service) OptimisticUpdate() (*ethpb.LightClientOptimisticUpdate, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.optimisticUpdate == nil {
+		return nil, ErrNotFound
+	}
+	return s.optimisticUpdate, nil
+}
+
+// Updates returns the best light client updates of the count sync committee periods starting at the given period.
+// Periods for which no update is known are skipped.
+func (s *Service) Updates(ctx context.Context, startPeriod, count uint64) ([]*ethpb.LightClientUpdate, error) {
+	if count == 0 {
+		return []*ethpb.LightClientUpdate{}, nil
+	}
+	return s.cfg.db.LightClientUpdates(ctx, startPeriod, startPeriod+count-1)
+}
+
+// Bootstrap returns the light client bootstrap of the block with the given root.
+func (s *Service) Bootstrap(ctx context.Context, blockRoot [32]byte) (*ethpb.LightClientBootstrap, error) {
+	blk, err := s.cfg.db.Block(ctx, blockRoot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get block %#x", blockRoot)
+	}
+	if blk == nil || blk.IsNil() {
+		return nil, ErrNotFound
+	}
+	if blk.Version() == version.Phase0 {
+		return nil, errors.Wrapf(ErrNotFound, "block %#x is from before the altair fork", blockRoot)
+	}
+	st, err := s.cfg.stateGen.StateByRoot(ctx, blockRoot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get state of block %#x", blockRoot)
+	}
+	return NewLightClientBootstrap(ctx, st, blk)
+}
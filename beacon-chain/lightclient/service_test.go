@@ -0,0 +1,123 @@
+package lightclient
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func setupService(t *testing.T) *Service {
+	beaconDB := dbtest.SetupDB(t)
+	s, err := NewService(
+		context.Background(),
+		WithDatabase(beaconDB),
+		WithStateGen(stategen.New(beaconDB)),
+		WithStateNotifier(&mock.MockStateNotifier{}),
+	)
+	require.NoError(t, err)
+	return s
+}
+
+func TestNewService_MissingRequired(t *testing.T) {
+	_, err := NewService(context.Background(), WithDatabase(dbtest.SetupDB(t)))
+	require.ErrorIs(t, err, errMissingRequired)
+}
+
+func TestService_ProcessBlock(t *testing.T) {
+	ctx := context.Background()
+	s := setupService(t)
+
+	_, err := s.FinalityUpdate()
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = s.OptimisticUpdate()
+	require.ErrorIs(t, err, ErrNotFound)
+
+	genesis, err := wrapper.WrappedSignedBeaconBlock(util.NewBeaconBlock())
+	require.NoError(t, err)
+	require.NoError(t, s.cfg.db.SaveBlock(ctx, genesis))
+	genesisRoot, err := genesis.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, s.cfg.db.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	attestedBlock, attestedState := attestedBlockAndState(t, 10)
+	attestedRoot, err := attestedBlock.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, s.cfg.db.SaveBlock(ctx, attestedBlock))
+	require.NoError(t, s.cfg.db.SaveState(ctx, attestedState, attestedRoot))
+
+	require.NoError(t, s.processBlock(ctx, signatureBlock(t, 11, attestedBlock, 400)))
+	finalityUpdate, err := s.FinalityUpdate()
+	require.NoError(t, err)
+	assert.Equal(t, attestedBlock.Block().Slot(), finalityUpdate.AttestedHeader.Slot)
+	optimisticUpdate, err := s.OptimisticUpdate()
+	require.NoError(t, err)
+	assert.Equal(t, attestedBlock.Block().Slot(), optimisticUpdate.AttestedHeader.Slot)
+	updates, err := s.Updates(ctx, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(updates))
+	assert.Equal(t, uint64(400), updates[0].SyncAggregate.SyncCommitteeBits.Count())
+
+	// An update with more participants replaces the best update of the period, but not the latest updates which do
+	// not bring a newer header.
+	require.NoError(t, s.processBlock(ctx, signatureBlock(t, 12, attestedBlock, 500)))
+	updates, err = s.Updates(ctx, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(updates))
+	assert.Equal(t, uint64(500), updates[0].SyncAggregate.SyncCommitteeBits.Count())
+	optimisticUpdate, err = s.OptimisticUpdate()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(400), optimisticUpdate.SyncAggregate.SyncCommitteeBits.Count())
+
+	// An update with fewer participants does not replace the best update of the period.
+	require.NoError(t, s.processBlock(ctx, signatureBlock(t, 13, attestedBlock, 450)))
+	updates, err = s.Updates(ctx, 0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(500), updates[0].SyncAggregate.SyncCommitteeBits.Count())
+}
+
+func TestService_Publish(t *testing.T) {
+	s := setupService(t)
+	notifier := &mock.MockStateNotifier{RecordEvents: true}
+	s.cfg.stateNotifier = notifier
+
+	update := &ethpb.LightClientOptimisticUpdate{SignatureSlot: 1}
+	s.publish(0, update.SignatureSlot, statefeed.LightClientOptimisticUpdate, update)
+	events := notifier.ReceivedEvents()
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, statefeed.LightClientOptimisticUpdate, int(events[0].Type))
+	assert.DeepEqual(t, update, events[0].Data)
+}
+
+func TestService_Bootstrap(t *testing.T) {
+	ctx := context.Background()
+	s := setupService(t)
+
+	blk, st := attestedBlockAndState(t, 10)
+	root, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	_, err = s.Bootstrap(ctx, root)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, s.cfg.db.SaveBlock(ctx, blk))
+	require.NoError(t, s.cfg.db.SaveState(ctx, st, root))
+	bootstrap, err := s.Bootstrap(ctx, root)
+	require.NoError(t, err)
+	assert.Equal(t, blk.Block().Slot(), bootstrap.Header.Slot)
+
+	phase0, err := wrapper.WrappedSignedBeaconBlock(util.NewBeaconBlock())
+	require.NoError(t, err)
+	require.NoError(t, s.cfg.db.SaveBlock(ctx, phase0))
+	phase0Root, err := phase0.Block().HashTreeRoot()
+	require.NoError(t, err)
+	_, err = s.Bootstrap(ctx, phase0Root)
+	require.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,342 @@
+package lightclient
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+const (
+	// syncCommitteeBranchDepth is floorlog2(CURRENT_SYNC_COMMITTEE_INDEX), which is also floorlog2(NEXT_SYNC_COMMITTEE_INDEX).
+	syncCommitteeBranchDepth = 5
+	// finalityBranchDepth is floorlog2(FINALIZED_ROOT_INDEX).
+	finalityBranchDepth = 6
+)
+
+var (
+	errNotEnoughParticipants = errors.New("sync aggregate does not have enough participants")
+	errPreAltairState        = errors.New("light client data is only available from the altair fork")
+	errUnlinkedAttestedBlock = errors.New("attested block is not the parent of the signature block")
+)
+
+// NewLightClientBootstrap creates the light client bootstrap of the given block, using the post state of the block.
+//
+// Spec code:
+// def create_light_client_bootstrap(state: BeaconState) -> LightClientBootstrap:
+//    assert compute_epoch_at_slot(state.slot) >= ALTAIR_FORK_EPOCH
+//    assert state.slot == state.latest_block_header.slot
+//
+//    return LightClientBootstrap(
+//        header=BeaconBlockHeader(
+//            slot=state.latest_block_header.slot,
+//            proposer_index=state.latest_block_header.proposer_index,
+//            parent_root=state.latest_block_header.parent_root,
+//            state_root=hash_tree_root(state),
+//            body_root=state.latest_block_header.body_root,
+//        ),
+//        current_sync_committee=state.current_sync_committee,
+//        current_sync_committee_branch=compute_merkle_proof_for_state(state, CURRENT_SYNC_COMMITTEE_INDEX)
+//    )
+func NewLightClientBootstrap(ctx context.Context, st state.BeaconState, blk interfaces.SignedBeaconBlock) (*ethpb.LightClientBootstrap, error) {
+	header, err := blockHeader(ctx, st, blk)
+	if err != nil {
+		return nil, err
+	}
+	committee, err := st.CurrentSyncCommittee()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get current sync committee")
+	}
+	branch, err := st.CurrentSyncCommitteeProof(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute current sync committee proof")
+	}
+	return &ethpb.LightClientBootstrap{
+		Header:                     header,
+		CurrentSyncCommittee:       committee,
+		CurrentSyncCommitteeBranch: branch,
+	}, nil
+}
+
+// NewLightClientUpdate creates the light client update carried by the sync aggregate of the given signature block.
+// The attested block is the parent of the signature block, and the attested state its post state. The finalized
+// block is the block of the finalized checkpoint of the attested state, it may be nil when it is not available.
+//
+// Spec code:
+// def create_light_client_update(state: BeaconState,
+//                                block: SignedBeaconBlock,
+//                                attested_state: BeaconState,
+//                                finalized_block: Optional[SignedBeaconBlock]) -> LightClientUpdate:
+//    assert compute_epoch_at_slot(attested_state.slot) >= ALTAIR_FORK_EPOCH
+//    assert sum(block.message.body.sync_aggregate.sync_committee_bits) >= MIN_SYNC_COMMITTEE_PARTICIPANTS
+//    ...
+//    # next_sync_committee is only useful if the message is signed by the current sync committee
+//    if update_attested_period == update_signature_period:
+//        next_sync_committee = attested_state.next_sync_committee
+//        next_sync_committee_branch = compute_merkle_proof_for_state(attested_state, NEXT_SYNC_COMMITTEE_INDEX)
+//    else:
+//        next_sync_committee = SyncCommittee()
+//        next_sync_committee_branch = [Bytes32() for _ in range(floorlog2(NEXT_SYNC_COMMITTEE_INDEX))]
+//
+//    # Indicate finality whenever possible
+//    if finalized_block is not None:
+//        if finalized_block.message.slot != GENESIS_SLOT:
+//            finalized_header = BeaconBlockHeader(...)
+//            assert hash_tree_root(finalized_header) == attested_state.finalized_checkpoint.root
+//        else:
+//            assert attested_state.finalized_checkpoint.root == Bytes32()
+//            finalized_header = BeaconBlockHeader()
+//        finality_branch = compute_merkle_proof_for_state(attested_state, FINALIZED_ROOT_INDEX)
+//    else:
+//        finalized_header = BeaconBlockHeader()
+//        finality_branch = [Bytes32() for _ in range(floorlog2(FINALIZED_ROOT_INDEX))]
+//    ...
+func NewLightClientUpdate(
+	ctx context.Context,
+	signatureBlock interfaces.SignedBeaconBlock,
+	attestedState state.BeaconState,
+	attestedBlock interfaces.SignedBeaconBlock,
+	finalizedBlock interfaces.SignedBeaconBlock,
+) (*ethpb.LightClientUpdate, error) {
+	if err := wrapper.BeaconBlockIsNil(signatureBlock); err != nil {
+		return nil, err
+	}
+	if signatureBlock.Version() == version.Phase0 {
+		return nil, errPreAltairState
+	}
+	aggregate, err := signatureBlock.Block().Body().SyncAggregate()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get sync aggregate")
+	}
+	if aggregate.SyncCommitteeBits.Count() < params.BeaconConfig().MinSyncCommitteeParticipants {
+		return nil, errNotEnoughParticipants
+	}
+	attestedHeader, err := blockHeader(ctx, attestedState, attestedBlock)
+	if err != nil {
+		return nil, err
+	}
+	attestedRoot, err := attestedHeader.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(attestedRoot[:], signatureBlock.Block().ParentRoot()) {
+		return nil, errUnlinkedAttestedBlock
+	}
+
+	update := &ethpb.LightClientUpdate{
+		AttestedHeader:          attestedHeader,
+		NextSyncCommittee:       emptySyncCommittee(),
+		NextSyncCommitteeBranch: emptyBranch(syncCommitteeBranchDepth),
+		FinalizedHeader:         emptyHeader(),
+		FinalityBranch:          emptyBranch(finalityBranchDepth),
+		SyncAggregate:           aggregate,
+		SignatureSlot:           signatureBlock.Block().Slot(),
+	}
+	if Period(attestedHeader.Slot) == Period(update.SignatureSlot) {
+		update.NextSyncCommittee, err = attestedState.NextSyncCommittee()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get next sync committee")
+		}
+		update.NextSyncCommitteeBranch, err = attestedState.NextSyncCommitteeProof(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute next sync committee proof")
+		}
+	}
+
+	if finalizedBlock == nil || finalizedBlock.IsNil() {
+		return update, nil
+	}
+	finalizedRoot := bytesutil.ToBytes32(attestedState.FinalizedCheckpoint().Root)
+	if finalizedBlock.Block().Slot() != params.BeaconConfig().GenesisSlot {
+		header, err := finalizedBlock.Header()
+		if err != nil {
+			return nil, err
+		}
+		root, err := header.Header.HashTreeRoot()
+		if err != nil {
+			return nil, err
+		}
+		if root != finalizedRoot {
+			return nil, errors.Errorf("finalized block root %#x does not match the finalized checkpoint root %#x", root, finalizedRoot)
+		}
+		update.FinalizedHeader = header.Header
+	} else if finalizedRoot != params.BeaconConfig().ZeroHash {
+		return nil, errors.Errorf("finalized checkpoint root %#x is not zero for the genesis block", finalizedRoot)
+	}
+	update.FinalityBranch, err = attestedState.FinalizedRootProof(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute finalized root proof")
+	}
+	return update, nil
+}
+
+// FinalityUpdate returns the light client finality update of the given light client update.
+func FinalityUpdate(update *ethpb.LightClientUpdate) *ethpb.LightClientFinalityUpdate {
+	return &ethpb.LightClientFinalityUpdate{
+		AttestedHeader:  update.AttestedHeader,
+		FinalizedHeader: update.FinalizedHeader,
+		FinalityBranch:  update.FinalityBranch,
+		SyncAggregate:   update.SyncAggregate,
+		SignatureSlot:   update.SignatureSlot,
+	}
+}
+
+// OptimisticUpdate returns the light client optimistic update of the given light client update.
+func OptimisticUpdate(update *ethpb.LightClientUpdate) *ethpb.LightClientOptimisticUpdate {
+	return &ethpb.LightClientOptimisticUpdate{
+		AttestedHeader: update.AttestedHeader,
+		SyncAggregate:  update.SyncAggregate,
+		SignatureSlot:  update.SignatureSlot,
+	}
+}
+
+// IsBetterUpdate returns true if the new update should replace the old update as the best update of a sync
+// committee period.
+//
+// Spec code:
+// def is_better_update(new_update: LightClientUpdate, old_update: LightClientUpdate) -> bool:
+//    # Compare supermajority (> 2/3) sync committee participation
+//    ...
+//    # Compare presence of relevant sync committee
+//    ...
+//    # Compare indication of any finality
+//    ...
+//    # Compare sync committee finality
+//    ...
+//    # Tiebreaker 1: Sync committee participation beyond supermajority
+//    ...
+//    # Tiebreaker 2: Prefer older data (fewer changes to best)
+//    if new_update.attested_header.slot != old_update.attested_header.slot:
+//        return new_update.attested_header.slot < old_update.attested_header.slot
+//    return new_update.signature_slot < old_update.signature_slot
+func IsBetterUpdate(newUpdate, oldUpdate *ethpb.LightClientUpdate) bool {
+	maxParticipants := newUpdate.SyncAggregate.SyncCommitteeBits.Len()
+	newParticipants := newUpdate.SyncAggregate.SyncCommitteeBits.Count()
+	oldParticipants := oldUpdate.SyncAggregate.SyncCommitteeBits.Count()
+	newSupermajority := newParticipants*3 >= maxParticipants*2
+	oldSupermajority := oldParticipants*3 >= maxParticipants*2
+	if newSupermajority != oldSupermajority {
+		return newSupermajority
+	}
+	if !newSupermajority && newParticipants != oldParticipants {
+		return newParticipants > oldParticipants
+	}
+
+	newRelevantSyncCommittee := isSyncCommitteeUpdate(newUpdate) &&
+		Period(newUpdate.AttestedHeader.Slot) == Period(newUpdate.SignatureSlot)
+	oldRelevantSyncCommittee := isSyncCommitteeUpdate(oldUpdate) &&
+		Period(oldUpdate.AttestedHeader.Slot) == Period(oldUpdate.SignatureSlot)
+	if newRelevantSyncCommittee != oldRelevantSyncCommittee {
+		return newRelevantSyncCommittee
+	}
+
+	newFinality := isFinalityUpdate(newUpdate)
+	oldFinality := isFinalityUpdate(oldUpdate)
+	if newFinality != oldFinality {
+		return newFinality
+	}
+	if newFinality {
+		newSyncCommitteeFinality := Period(newUpdate.FinalizedHeader.Slot) == Period(newUpdate.AttestedHeader.Slot)
+		oldSyncCommitteeFinality := Period(oldUpdate.FinalizedHeader.Slot) == Period(oldUpdate.AttestedHeader.Slot)
+		if newSyncCommitteeFinality != oldSyncCommitteeFinality {
+			return newSyncCommitteeFinality
+		}
+	}
+
+	if newParticipants != oldParticipants {
+		return newParticipants > oldParticipants
+	}
+	if newUpdate.AttestedHeader.Slot != oldUpdate.AttestedHeader.Slot {
+		return newUpdate.AttestedHeader.Slot < oldUpdate.AttestedHeader.Slot
+	}
+	return newUpdate.SignatureSlot < oldUpdate.SignatureSlot
+}
+
+// Period returns the sync committee period of the given slot.
+func Period(slot types.Slot) uint64 {
+	return slots.SyncCommitteePeriod(slots.ToEpoch(slot))
+}
+
+// blockHeader returns the header of the given block, after checking that the state is the post state of the block.
+func blockHeader(ctx context.Context, st state.BeaconState, blk interfaces.SignedBeaconBlock) (*ethpb.BeaconBlockHeader, error) {
+	if st == nil || st.IsNil() {
+		return nil, errors.New("nil state")
+	}
+	if err := wrapper.BeaconBlockIsNil(blk); err != nil {
+		return nil, err
+	}
+	if st.Version() == version.Phase0 {
+		return nil, errPreAltairState
+	}
+	if st.Slot() != st.LatestBlockHeader().Slot {
+		return nil, errors.Errorf("state slot %d is not the slot of its latest block header %d", st.Slot(), st.LatestBlockHeader().Slot)
+	}
+	header, err := blk.Header()
+	if err != nil {
+		return nil, err
+	}
+	stateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(stateRoot[:], header.Header.StateRoot) {
+		return nil, errors.Errorf("state root %#x does not match the state root of the block %#x", stateRoot, header.Header.StateRoot)
+	}
+	return header.Header, nil
+}
+
+// isSyncCommitteeUpdate returns true if the update carries the next sync committee.
+func isSyncCommitteeUpdate(update *ethpb.LightClientUpdate) bool {
+	return !isEmptyBranch(update.NextSyncCommitteeBranch)
+}
+
+// isFinalityUpdate returns true if the update carries a finalized header.
+func isFinalityUpdate(update *ethpb.LightClientUpdate) bool {
+	return !isEmptyBranch(update.FinalityBranch)
+}
+
+func isEmptyBranch(branch [][]byte) bool {
+	for _, node := range branch {
+		if !bytes.Equal(node, params.BeaconConfig().ZeroHash[:]) {
+			return false
+		}
+	}
+	return true
+}
+
+func emptyBranch(depth int) [][]byte {
+	branch := make([][]byte, depth)
+	for i := range branch {
+		branch[i] = make([]byte, fieldparams.RootLength)
+	}
+	return branch
+}
+
+func emptyHeader() *ethpb.BeaconBlockHeader {
+	return &ethpb.BeaconBlockHeader{
+		ParentRoot: make([]byte, fieldparams.RootLength),
+		StateRoot:  make([]byte, fieldparams.RootLength),
+		BodyRoot:   make([]byte, fieldparams.RootLength),
+	}
+}
+
+func emptySyncCommittee() *ethpb.SyncCommittee {
+	pubkeys := make([][]byte, params.BeaconConfig().SyncCommitteeSize)
+	for i := range pubkeys {
+		pubkeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	return &ethpb.SyncCommittee{
+		Pubkeys:         pubkeys,
+		AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength),
+	}
+}
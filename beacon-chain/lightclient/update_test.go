@@ -0,0 +1,208 @@
+package lightclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+// attestedBlockAndState returns an altair block of the given slot along with its post state.
+func attestedBlockAndState(t *testing.T, slot types.Slot) (interfaces.SignedBeaconBlock, state.BeaconState) {
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisStateAltair(t, 64)
+	require.NoError(t, st.SetSlot(slot))
+	b := util.NewBeaconBlockAltair()
+	b.Block.Slot = slot
+	wsb, err := wrapper.WrappedSignedBeaconBlock(b)
+	require.NoError(t, err)
+	header, err := wsb.Header()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(header.Header))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	b.Block.StateRoot = stateRoot[:]
+	wsb, err = wrapper.WrappedSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return wsb, st
+}
+
+// signatureBlock returns an altair block of the given slot, child of the given parent, whose sync aggregate has the
+// given number of participants.
+func signatureBlock(t *testing.T, slot types.Slot, parent interfaces.SignedBeaconBlock, participants uint64) interfaces.SignedBeaconBlock {
+	parentRoot, err := parent.Block().HashTreeRoot()
+	require.NoError(t, err)
+	b := util.NewBeaconBlockAltair()
+	b.Block.Slot = slot
+	b.Block.ParentRoot = parentRoot[:]
+	bits := bitfield.NewBitvector512()
+	for i := uint64(0); i < participants; i++ {
+		bits.SetBitAt(i, true)
+	}
+	b.Block.Body.SyncAggregate.SyncCommitteeBits = bits
+	wsb, err := wrapper.WrappedSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return wsb
+}
+
+func testUpdate(attestedSlot, signatureSlot types.Slot, participants uint64, syncCommittee, finality bool) *ethpb.LightClientUpdate {
+	bits := bitfield.NewBitvector512()
+	for i := uint64(0); i < participants; i++ {
+		bits.SetBitAt(i, true)
+	}
+	u := &ethpb.LightClientUpdate{
+		AttestedHeader:          &ethpb.BeaconBlockHeader{Slot: attestedSlot},
+		NextSyncCommitteeBranch: emptyBranch(syncCommitteeBranchDepth),
+		FinalizedHeader:         &ethpb.BeaconBlockHeader{},
+		FinalityBranch:          emptyBranch(finalityBranchDepth),
+		SyncAggregate:           &ethpb.SyncAggregate{SyncCommitteeBits: bits},
+		SignatureSlot:           signatureSlot,
+	}
+	if syncCommittee {
+		u.NextSyncCommitteeBranch[0] = []byte{0x01}
+	}
+	if finality {
+		u.FinalityBranch[0] = []byte{0x01}
+		u.FinalizedHeader.Slot = attestedSlot - 64
+	}
+	return u
+}
+
+func TestNewLightClientBootstrap(t *testing.T) {
+	ctx := context.Background()
+	blk, st := attestedBlockAndState(t, 10)
+
+	bootstrap, err := NewLightClientBootstrap(ctx, st, blk)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(10), bootstrap.Header.Slot)
+	committee, err := st.CurrentSyncCommittee()
+	require.NoError(t, err)
+	assert.DeepEqual(t, committee, bootstrap.CurrentSyncCommittee)
+	assert.Equal(t, syncCommitteeBranchDepth, len(bootstrap.CurrentSyncCommitteeBranch))
+
+	require.NoError(t, st.SetSlot(11))
+	_, err = NewLightClientBootstrap(ctx, st, blk)
+	require.ErrorContains(t, "is not the slot of its latest block header", err)
+
+	phase0, err := util.NewBeaconState()
+	require.NoError(t, err)
+	_, err = NewLightClientBootstrap(ctx, phase0, blk)
+	require.ErrorIs(t, err, errPreAltairState)
+}
+
+func TestNewLightClientUpdate(t *testing.T) {
+	ctx := context.Background()
+	attestedBlock, attestedState := attestedBlockAndState(t, 10)
+	genesis, err := wrapper.WrappedSignedBeaconBlock(util.NewBeaconBlock())
+	require.NoError(t, err)
+
+	t.Run("with finality", func(t *testing.T) {
+		sigBlock := signatureBlock(t, 11, attestedBlock, 400)
+		update, err := NewLightClientUpdate(ctx, sigBlock, attestedState, attestedBlock, genesis)
+		require.NoError(t, err)
+		assert.Equal(t, types.Slot(10), update.AttestedHeader.Slot)
+		assert.Equal(t, types.Slot(11), update.SignatureSlot)
+		assert.Equal(t, uint64(400), update.SyncAggregate.SyncCommitteeBits.Count())
+		committee, err := attestedState.NextSyncCommittee()
+		require.NoError(t, err)
+		assert.DeepEqual(t, committee, update.NextSyncCommittee)
+		assert.Equal(t, true, isSyncCommitteeUpdate(update))
+		// The finalized checkpoint of the attested state is the genesis block, which is indicated by an empty header
+		// and a finality branch.
+		assert.DeepEqual(t, emptyHeader(), update.FinalizedHeader)
+		assert.Equal(t, finalityBranchDepth, len(update.FinalityBranch))
+		assert.Equal(t, true, isFinalityUpdate(update))
+	})
+	t.Run("without finalized block", func(t *testing.T) {
+		sigBlock := signatureBlock(t, 11, attestedBlock, 400)
+		update, err := NewLightClientUpdate(ctx, sigBlock, attestedState, attestedBlock, nil)
+		require.NoError(t, err)
+		assert.Equal(t, false, isFinalityUpdate(update))
+		assert.DeepEqual(t, emptyBranch(finalityBranchDepth), update.FinalityBranch)
+	})
+	t.Run("signed in the next period", func(t *testing.T) {
+		slot := types.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * params.BeaconConfig().SlotsPerEpoch
+		sigBlock := signatureBlock(t, slot, attestedBlock, 400)
+		update, err := NewLightClientUpdate(ctx, sigBlock, attestedState, attestedBlock, nil)
+		require.NoError(t, err)
+		assert.Equal(t, false, isSyncCommitteeUpdate(update))
+		assert.Equal(t, fieldparams.SyncCommitteeLength, len(update.NextSyncCommittee.Pubkeys))
+	})
+	t.Run("not enough participants", func(t *testing.T) {
+		sigBlock := signatureBlock(t, 11, attestedBlock, 0)
+		_, err := NewLightClientUpdate(ctx, sigBlock, attestedState, attestedBlock, nil)
+		require.ErrorIs(t, err, errNotEnoughParticipants)
+	})
+	t.Run("unlinked attested block", func(t *testing.T) {
+		sigBlock := signatureBlock(t, 11, genesis, 400)
+		_, err := NewLightClientUpdate(ctx, sigBlock, attestedState, attestedBlock, nil)
+		require.ErrorIs(t, err, errUnlinkedAttestedBlock)
+	})
+}
+
+func TestIsBetterUpdate(t *testing.T) {
+	tests := []struct {
+		name      string
+		newUpdate *ethpb.LightClientUpdate
+		oldUpdate *ethpb.LightClientUpdate
+		want      bool
+	}{
+		{
+			name:      "supermajority wins",
+			newUpdate: testUpdate(10, 11, 400, false, false),
+			oldUpdate: testUpdate(10, 11, 300, true, true),
+			want:      true,
+		},
+		{
+			name:      "more participants without supermajority",
+			newUpdate: testUpdate(10, 11, 200, false, false),
+			oldUpdate: testUpdate(10, 11, 100, true, true),
+			want:      true,
+		},
+		{
+			name:      "relevant sync committee",
+			newUpdate: testUpdate(10, 11, 400, false, true),
+			oldUpdate: testUpdate(10, 11, 400, true, false),
+			want:      false,
+		},
+		{
+			name:      "finality",
+			newUpdate: testUpdate(100, 101, 400, true, true),
+			oldUpdate: testUpdate(100, 101, 400, true, false),
+			want:      true,
+		},
+		{
+			name:      "more participants beyond supermajority",
+			newUpdate: testUpdate(10, 11, 500, true, false),
+			oldUpdate: testUpdate(10, 11, 400, true, false),
+			want:      true,
+		},
+		{
+			name:      "older attested header",
+			newUpdate: testUpdate(10, 12, 400, true, false),
+			oldUpdate: testUpdate(11, 12, 400, true, false),
+			want:      true,
+		},
+		{
+			name:      "same update",
+			newUpdate: testUpdate(10, 11, 400, true, false),
+			oldUpdate: testUpdate(10, 11, 400, true, false),
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsBetterUpdate(tt.newUpdate, tt.oldUpdate))
+		})
+	}
+}
@@ -51,6 +51,37 @@ func TestConfigureSafeSlotsToImportOptimistically(t *testing.T) {
 	assert.Equal(t, types.Slot(128), params.BeaconConfig().SafeSlotsToImportOptimistically)
 }
 
+func TestConfigureLocalBlockValueBoost(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.Uint64(flags.LocalBlockValueBoost.Name, 0, "")
+	require.NoError(t, set.Set(flags.LocalBlockValueBoost.Name, strconv.Itoa(10)))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	require.NoError(t, configureLocalBlockValueBoost(cliCtx))
+
+	assert.Equal(t, uint64(10), params.BeaconConfig().LocalBlockValueBoost)
+}
+
+func TestConfigureBuilderCircuitBreaker(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.Int(flags.MaxBuilderConsecutiveMissedSlots.Name, 0, "")
+	set.Int(flags.MaxBuilderEpochMissedSlots.Name, 0, "")
+	require.NoError(t, set.Set(flags.MaxBuilderConsecutiveMissedSlots.Name, strconv.Itoa(5)))
+	require.NoError(t, set.Set(flags.MaxBuilderEpochMissedSlots.Name, strconv.Itoa(10)))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	require.NoError(t, configureBuilderCircuitBreaker(cliCtx))
+
+	assert.Equal(t, types.Slot(5), params.BeaconConfig().MaxBuilderConsecutiveMissedSlots)
+	assert.Equal(t, types.Slot(10), params.BeaconConfig().MaxBuilderEpochMissedSlots)
+}
+
 func TestConfigureSlotsPerArchivedPoint(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 
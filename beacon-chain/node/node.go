@@ -16,6 +16,8 @@ import (
 	"syscall"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gorilla/mux"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/pkg/errors"
 	apigateway "github.com/prysmaticlabs/prysm/api/gateway"
 	"github.com/prysmaticlabs/prysm/async/event"
@@ -25,12 +27,14 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/pruner"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/slasherkv"
 	interopcoldstart "github.com/prysmaticlabs/prysm/beacon-chain/deterministic-genesis"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/doubly-linked-tree"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/gateway"
+	"github.com/prysmaticlabs/prysm/beacon-chain/lightclient"
 	"github.com/prysmaticlabs/prysm/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/beacon-chain/node/registration"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
@@ -41,6 +45,8 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apimiddleware"
+	checkpointrpc "github.com/prysmaticlabs/prysm/beacon-chain/rpc/checkpoint"
+	lightclientrpc "github.com/prysmaticlabs/prysm/beacon-chain/rpc/lightclient"
 	"github.com/prysmaticlabs/prysm/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
@@ -53,11 +59,13 @@ import (
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/container/slice"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/monitoring/backup"
 	"github.com/prysmaticlabs/prysm/monitoring/prometheus"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/runtime"
 	"github.com/prysmaticlabs/prysm/runtime/debug"
 	"github.com/prysmaticlabs/prysm/runtime/prereqs"
@@ -78,6 +86,8 @@ type serviceFlagOpts struct {
 	blockchainFlagOpts []blockchain.Option
 	powchainFlagOpts   []powchain.Option
 	builderOpts        []builder.Option
+	backfillOpts       []backfill.Option
+	prunerOpts         []pruner.Option
 }
 
 // BeaconNode defines a struct that handles the services running a random beacon chain
@@ -139,6 +149,12 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 	if err := configureSlotsPerArchivedPoint(cliCtx); err != nil {
 		return nil, err
 	}
+	if err := configureLocalBlockValueBoost(cliCtx); err != nil {
+		return nil, err
+	}
+	if err := configureBuilderCircuitBreaker(cliCtx); err != nil {
+		return nil, err
+	}
 	if err := configureEth1Config(cliCtx); err != nil {
 		return nil, err
 	}
@@ -239,11 +255,26 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	log.Debugln("Registering Light Client Service")
+	if err := beacon.registerLightClientService(); err != nil {
+		return nil, err
+	}
+
 	log.Debugln("Registering Sync Service")
 	if err := beacon.registerSyncService(); err != nil {
 		return nil, err
 	}
 
+	log.Debugln("Registering Backfill Service")
+	if err := beacon.registerBackfillService(bfs); err != nil {
+		return nil, err
+	}
+
+	log.Debugln("Registering Pruner Service")
+	if err := beacon.registerPrunerService(bfs); err != nil {
+		return nil, err
+	}
+
 	log.Debugln("Registering Slasher Service")
 	if err := beacon.registerSlasherService(); err != nil {
 		return nil, err
@@ -502,6 +533,13 @@ func (b *BeaconNode) startSlasherDB(cliCtx *cli.Context) error {
 
 func (b *BeaconNode) startStateGen(ctx context.Context, bfs *backfill.Status) error {
 	opts := []stategen.StateGenOption{stategen.WithBackfillStatus(bfs)}
+	if b.cliCtx.Bool(flags.ArchiveMode.Name) {
+		interval := b.cliCtx.Uint64(flags.ArchiveSnapshotInterval.Name)
+		if interval == 0 {
+			return errors.New("archive snapshot interval must be greater than zero")
+		}
+		opts = append(opts, stategen.WithArchiveMode(types.Slot(interval)))
+	}
 	sg := stategen.New(b.db, opts...)
 
 	cp, err := b.db.FinalizedCheckpoint(ctx)
@@ -530,6 +568,7 @@ func (b *BeaconNode) startStateGen(ctx context.Context, bfs *backfill.Status) er
 	}
 
 	b.stateGen = sg
+	go sg.RunArchiver(b.ctx)
 	return nil
 }
 
@@ -557,6 +596,7 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 		DenyListCIDR:      slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
 		EnableUPnP:        cliCtx.Bool(cmd.EnableUPnPFlag.Name),
 		DisableDiscv5:     cliCtx.Bool(flags.DisableDiscv5.Name),
+		PeerScoreMetrics:  cliCtx.Bool(flags.PeerScoreMetrics.Name),
 		StateNotifier:     b,
 		DB:                b.db,
 	})
@@ -677,8 +717,7 @@ func (b *BeaconNode) registerSyncService() error {
 		return err
 	}
 
-	rs := regularsync.NewService(
-		b.ctx,
+	opts := []regularsync.Option{
 		regularsync.WithDatabase(b.db),
 		regularsync.WithP2P(b.fetchP2P()),
 		regularsync.WithChainService(chainService),
@@ -695,7 +734,15 @@ func (b *BeaconNode) registerSyncService() error {
 		regularsync.WithSlasherAttestationsFeed(b.slasherAttestationsFeed),
 		regularsync.WithSlasherBlockHeadersFeed(b.slasherBlockHeadersFeed),
 		regularsync.WithExecutionPayloadReconstructor(web3Service),
-	)
+	}
+	if features.Get().EnableLightClientServer {
+		var lcService *lightclient.Service
+		if err := b.services.FetchService(&lcService); err != nil {
+			return err
+		}
+		opts = append(opts, regularsync.WithLightClientUpdates(lcService))
+	}
+	rs := regularsync.NewService(b.ctx, opts...)
 	return b.services.RegisterService(rs)
 }
 
@@ -715,6 +762,78 @@ func (b *BeaconNode) registerInitialSyncService() error {
 	return b.services.RegisterService(is)
 }
 
+func (b *BeaconNode) registerLightClientService() error {
+	if !features.Get().EnableLightClientServer {
+		return nil
+	}
+
+	var initSync *initialsync.Service
+	if err := b.services.FetchService(&initSync); err != nil {
+		return err
+	}
+
+	lc, err := lightclient.NewService(
+		b.ctx,
+		lightclient.WithDatabase(b.db),
+		lightclient.WithStateGen(b.stateGen),
+		lightclient.WithStateNotifier(b),
+		lightclient.WithBroadcaster(b.fetchP2P()),
+		lightclient.WithInitialSync(initSync),
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize light client service")
+	}
+	return b.services.RegisterService(lc)
+}
+
+func (b *BeaconNode) registerBackfillService(bfs *backfill.Status) error {
+	if b.serviceFlagOpts.backfillOpts == nil {
+		return nil
+	}
+
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	var initSync *initialsync.Service
+	if err := b.services.FetchService(&initSync); err != nil {
+		return err
+	}
+
+	p2pService := b.fetchP2P()
+	requester := func(ctx context.Context, pid peer.ID, req *ethpb.BeaconBlocksByRangeRequest) ([]interfaces.SignedBeaconBlock, error) {
+		return regularsync.SendBeaconBlocksByRangeRequest(ctx, chainService, p2pService, pid, req, nil)
+	}
+	opts := append(b.serviceFlagOpts.backfillOpts,
+		backfill.WithStatus(bfs),
+		backfill.WithDatabase(b.db),
+		backfill.WithP2P(p2pService),
+		backfill.WithBlocksByRangeRequester(requester),
+		backfill.WithInitialSync(initSync),
+	)
+	bf, err := backfill.NewService(b.ctx, opts...)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize backfill service")
+	}
+	return b.services.RegisterService(bf)
+}
+
+func (b *BeaconNode) registerPrunerService(bfs *backfill.Status) error {
+	if b.serviceFlagOpts.prunerOpts == nil {
+		return nil
+	}
+	opts := append(b.serviceFlagOpts.prunerOpts,
+		pruner.WithDatabase(b.db),
+		pruner.WithBackfillStatus(bfs),
+	)
+	p, err := pruner.NewService(b.ctx, opts...)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize pruner service")
+	}
+	return b.services.RegisterService(p)
+}
+
 func (b *BeaconNode) registerSlasherService() error {
 	if !features.Get().EnableSlasher {
 		return nil
@@ -799,6 +918,10 @@ func (b *BeaconNode) registerRPCService() error {
 	}
 
 	p2pService := b.fetchP2P()
+	var peerAccessManager *p2p.Service
+	if err := b.services.FetchService(&peerAccessManager); err != nil {
+		return err
+	}
 	rpcService := rpc.NewService(b.ctx, &rpc.Config{
 		ExecutionEngineCaller:         web3Service,
 		ExecutionPayloadReconstructor: web3Service,
@@ -812,6 +935,7 @@ func (b *BeaconNode) registerRPCService() error {
 		Broadcaster:                   p2pService,
 		PeersFetcher:                  p2pService,
 		PeerManager:                   p2pService,
+		PeerAccessManager:             peerAccessManager,
 		MetadataProvider:              p2pService,
 		ChainInfoFetcher:              chainService,
 		HeadUpdater:                   chainService,
@@ -923,6 +1047,30 @@ func (b *BeaconNode) registerGRPCGateway() error {
 	if flags.EnableHTTPEthAPI(httpModules) {
 		opts = append(opts, apigateway.WithApiMiddleware(&apimiddleware.BeaconEndpointFactory{}))
 	}
+	router := mux.NewRouter()
+	if b.cliCtx.Bool(flags.EnableCheckpointSyncServing.Name) {
+		var chainService *blockchain.Service
+		if err := b.services.FetchService(&chainService); err != nil {
+			return err
+		}
+		var web3Service *powchain.Service
+		if err := b.services.FetchService(&web3Service); err != nil {
+			return err
+		}
+		checkpointrpc.NewServer(b.db, b.stateGen, chainService, web3Service).RegisterRoutes(router)
+	}
+	if features.Get().EnableLightClientServer {
+		var lcService *lightclient.Service
+		if err := b.services.FetchService(&lcService); err != nil {
+			return err
+		}
+		var chainService *blockchain.Service
+		if err := b.services.FetchService(&chainService); err != nil {
+			return err
+		}
+		lightclientrpc.NewServer(lcService, chainService).RegisterRoutes(router)
+	}
+	opts = append(opts, apigateway.WithRouter(router))
 	g, err := apigateway.New(b.ctx, opts...)
 	if err != nil {
 		return err
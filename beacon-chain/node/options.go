@@ -3,7 +3,9 @@ package node
 import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/builder"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/pruner"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
 )
 
 // Option for beacon node configuration.
@@ -32,3 +34,19 @@ func WithBuilderFlagOptions(opts []builder.Option) Option {
 		return nil
 	}
 }
+
+// WithBackfillOptions enables the backfill service, configured with functional options related to CLI flags.
+func WithBackfillOptions(opts []backfill.Option) Option {
+	return func(bn *BeaconNode) error {
+		bn.serviceFlagOpts.backfillOpts = opts
+		return nil
+	}
+}
+
+// WithPrunerOptions enables the history pruner service, configured with functional options related to CLI flags.
+func WithPrunerOptions(opts []pruner.Option) Option {
+	return func(bn *BeaconNode) error {
+		bn.serviceFlagOpts.prunerOpts = opts
+		return nil
+	}
+}
@@ -0,0 +1,327 @@
+package p2p
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/pkg/errors"
+	ecdsaprysm "github.com/prysmaticlabs/prysm/crypto/ecdsa"
+	"github.com/prysmaticlabs/prysm/io/file"
+	"github.com/sirupsen/logrus"
+)
+
+const accessListPath = "peer-access.json"
+
+// ErrInvalidAccessEntry is returned when a peer access list entry cannot be parsed, or is not valid for its list.
+var ErrInvalidAccessEntry = errors.New("invalid peer access entry")
+
+// AccessList identifies one of the peer access lists managed at runtime.
+type AccessList int
+
+const (
+	// AllowList holds the peers and ranges which are allowed to connect, regardless of the address filter,
+	// the dial limits and the peer scores.
+	AllowList AccessList = iota
+	// DenyList holds the peers and ranges which are never connected to.
+	DenyList
+)
+
+// AccessEntry is an entry of a peer access list. The target is either a peer ID, an ENR or a CIDR range.
+// Trusted peers are never pruned, and only allowlist entries identifying a peer can be trusted.
+type AccessEntry struct {
+	Target  string `json:"target"`
+	Trusted bool   `json:"trusted,omitempty"`
+}
+
+// accessRule is a parsed access list entry. Exactly one of pid and ipNet is set.
+type accessRule struct {
+	entry AccessEntry
+	pid   peer.ID
+	ipNet *net.IPNet
+}
+
+// accessLists holds the runtime peer allowlist and denylist, persisted as JSON in the data directory. A nil
+// *accessLists matches nothing, so the connection gater works without it.
+type accessLists struct {
+	sync.RWMutex
+	path  string
+	allow map[string]*accessRule
+	deny  map[string]*accessRule
+}
+
+type accessListsJSON struct {
+	AllowList []AccessEntry `json:"allowlist"`
+	DenyList  []AccessEntry `json:"denylist"`
+}
+
+// loadAccessLists reads the access lists persisted in the data directory. The lists are kept in memory only when
+// no data directory is configured.
+func loadAccessLists(dataDir string) (*accessLists, error) {
+	al := &accessLists{
+		allow: make(map[string]*accessRule),
+		deny:  make(map[string]*accessRule),
+	}
+	if dataDir == "" {
+		return al, nil
+	}
+	al.path = path.Join(dataDir, accessListPath)
+	if !file.FileExists(al.path) {
+		return al, nil
+	}
+	enc, err := os.ReadFile(al.path) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read peer access lists")
+	}
+	lists := &accessListsJSON{}
+	if err := json.Unmarshal(enc, lists); err != nil {
+		return nil, errors.Wrap(err, "could not decode peer access lists")
+	}
+	for list, entries := range map[AccessList][]AccessEntry{AllowList: lists.AllowList, DenyList: lists.DenyList} {
+		rules, err := parseAccessEntries(list, entries)
+		if err != nil {
+			return nil, err
+		}
+		al.add(list, rules)
+	}
+	return al, nil
+}
+
+// parseAccessEntries validates and parses the entries to add to the given list.
+func parseAccessEntries(list AccessList, entries []AccessEntry) ([]*accessRule, error) {
+	rules := make([]*accessRule, 0, len(entries))
+	for _, e := range entries {
+		r, err := parseAccessEntry(e)
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %v", ErrInvalidAccessEntry, e.Target, err)
+		}
+		if r.entry.Trusted && (list != AllowList || r.ipNet != nil) {
+			return nil, fmt.Errorf("%w %q: only allowlisted peers can be trusted", ErrInvalidAccessEntry, e.Target)
+		}
+		rules = append(rules, r)
+	}
+	return rules, nil
+}
+
+func parseAccessEntry(e AccessEntry) (*accessRule, error) {
+	target := strings.TrimSpace(e.Target)
+	r := &accessRule{entry: AccessEntry{Target: target, Trusted: e.Trusted}}
+	switch {
+	case strings.HasPrefix(target, "enr:"):
+		node, err := enode.Parse(enode.ValidSchemes, target)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not parse ENR")
+		}
+		pubkey, err := ecdsaprysm.ConvertToInterfacePubkey(node.Pubkey())
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get pubkey")
+		}
+		r.pid, err = peer.IDFromPublicKey(pubkey)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get peer id")
+		}
+	case strings.Contains(target, "/"):
+		_, ipNet, err := net.ParseCIDR(target)
+		if err != nil {
+			return nil, err
+		}
+		r.ipNet = ipNet
+	default:
+		pid, err := peer.Decode(target)
+		if err != nil {
+			return nil, err
+		}
+		r.pid = pid
+	}
+	return r, nil
+}
+
+func (al *accessLists) rules(list AccessList) map[string]*accessRule {
+	if list == DenyList {
+		return al.deny
+	}
+	return al.allow
+}
+
+// add adds the rules to the given list, removing the same targets from the other list. The caller must hold the
+// lock of the lists.
+func (al *accessLists) add(list AccessList, rules []*accessRule) {
+	other := DenyList
+	if list == DenyList {
+		other = AllowList
+	}
+	for _, r := range rules {
+		al.rules(list)[r.entry.Target] = r
+		delete(al.rules(other), r.entry.Target)
+	}
+}
+
+// remove removes the targets from the given list. The caller must hold the lock of the lists.
+func (al *accessLists) remove(list AccessList, targets []string) {
+	for _, t := range targets {
+		delete(al.rules(list), strings.TrimSpace(t))
+	}
+}
+
+// entries returns the entries of the given list, sorted by target.
+func (al *accessLists) entries(list AccessList) []AccessEntry {
+	entries := make([]AccessEntry, 0, len(al.rules(list)))
+	for _, r := range al.rules(list) {
+		entries = append(entries, r.entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Target < entries[j].Target
+	})
+	return entries
+}
+
+// trustedPeers returns the peers marked as trusted in the allowlist. The caller must hold the lock of the lists.
+func (al *accessLists) trustedPeers() map[peer.ID]bool {
+	trusted := make(map[peer.ID]bool)
+	for _, r := range al.allow {
+		if r.entry.Trusted {
+			trusted[r.pid] = true
+		}
+	}
+	return trusted
+}
+
+// save persists the lists in the data directory. The caller must hold the lock of the lists.
+func (al *accessLists) save() error {
+	if al.path == "" {
+		return nil
+	}
+	enc, err := json.MarshalIndent(&accessListsJSON{
+		AllowList: al.entries(AllowList),
+		DenyList:  al.entries(DenyList),
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := file.WriteFile(al.path, enc); err != nil {
+		return errors.Wrap(err, "could not persist peer access lists")
+	}
+	return nil
+}
+
+// matchesPeer checks whether the peer is in the given list.
+func (al *accessLists) matchesPeer(list AccessList, pid peer.ID) bool {
+	if al == nil {
+		return false
+	}
+	al.RLock()
+	defer al.RUnlock()
+	for _, r := range al.rules(list) {
+		if r.pid != "" && r.pid == pid {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAddr checks whether the ip of the multiaddr is in a range of the given list.
+func (al *accessLists) matchesAddr(list AccessList, addr multiaddr.Multiaddr) bool {
+	if al == nil {
+		return false
+	}
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return false
+	}
+	al.RLock()
+	defer al.RUnlock()
+	for _, r := range al.rules(list) {
+		if r.ipNet != nil && r.ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches checks whether the peer, or the remote address of any of its connections, is in the given list.
+func (al *accessLists) matches(list AccessList, pid peer.ID, conns []network.Conn) bool {
+	if al.matchesPeer(list, pid) {
+		return true
+	}
+	for _, c := range conns {
+		if al.matchesAddr(list, c.RemoteMultiaddr()) {
+			return true
+		}
+	}
+	return false
+}
+
+// AccessLists returns the entries of the runtime peer allowlist and denylist.
+func (s *Service) AccessLists() (allow, deny []AccessEntry) {
+	s.accessLists.RLock()
+	defer s.accessLists.RUnlock()
+	return s.accessLists.entries(AllowList), s.accessLists.entries(DenyList)
+}
+
+// AddAccessEntries adds the entries to the given peer access list, and persists the lists. Connected peers matching
+// new denylist entries are disconnected immediately, and returned.
+func (s *Service) AddAccessEntries(list AccessList, entries []AccessEntry) ([]peer.ID, error) {
+	rules, err := parseAccessEntries(list, entries)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.updateAccessLists(func(al *accessLists) { al.add(list, rules) }); err != nil {
+		return nil, err
+	}
+	if list != DenyList {
+		return nil, nil
+	}
+	var disconnected []peer.ID
+	for _, pid := range s.host.Network().Peers() {
+		if !s.accessLists.matches(DenyList, pid, s.host.Network().ConnsToPeer(pid)) {
+			continue
+		}
+		if err := s.Disconnect(pid); err != nil {
+			log.WithError(err).WithField("peer", pid).Error("Could not disconnect from denylisted peer")
+			continue
+		}
+		disconnected = append(disconnected, pid)
+	}
+	if len(disconnected) > 0 {
+		log.WithField("peers", len(disconnected)).Info("Disconnected from denylisted peers")
+	}
+	return disconnected, nil
+}
+
+// RemoveAccessEntries removes the targets from the given peer access list, and persists the lists.
+func (s *Service) RemoveAccessEntries(list AccessList, targets []string) error {
+	return s.updateAccessLists(func(al *accessLists) { al.remove(list, targets) })
+}
+
+// updateAccessLists applies the update to the access lists, persists them and updates the trusted peers of the
+// peer status accordingly.
+func (s *Service) updateAccessLists(update func(al *accessLists)) error {
+	s.accessLists.Lock()
+	defer s.accessLists.Unlock()
+	before := s.accessLists.trustedPeers()
+	update(s.accessLists)
+	after := s.accessLists.trustedPeers()
+	for pid := range before {
+		if !after[pid] {
+			s.peers.SetTrusted(pid, false)
+		}
+	}
+	for pid := range after {
+		s.peers.SetTrusted(pid, true)
+	}
+	log.WithFields(logrus.Fields{
+		"allowlist": len(s.accessLists.allow),
+		"denylist":  len(s.accessLists.deny),
+	}).Debug("Updated peer access lists")
+	return s.accessLists.save()
+}
@@ -0,0 +1,178 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+
+	gethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/kevinms/leakybucket-go"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	bh "github.com/libp2p/go-libp2p/p2p/host/blank"
+	swarmt "github.com/libp2p/go-libp2p/p2p/net/swarm/testing"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	ecdsaprysm "github.com/prysmaticlabs/prysm/crypto/ecdsa"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func newAccessListService(t *testing.T, dataDir string) *Service {
+	al, err := loadAccessLists(dataDir)
+	require.NoError(t, err)
+	filter, err := configureFilter(&Config{AllowListCIDR: "192.168.0.0/16"})
+	require.NoError(t, err)
+	return &Service{
+		host:        bh.NewBlankHost(swarmt.GenSwarm(t)),
+		accessLists: al,
+		addrFilter:  filter,
+		ipLimiter:   leakybucket.NewCollector(ipLimit, ipBurst, false),
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    20,
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+}
+
+func TestService_AccessLists_Persisted(t *testing.T) {
+	dataDir := t.TempDir()
+	s := newAccessListService(t, dataDir)
+	pid, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+
+	_, err = s.AddAccessEntries(AllowList, []AccessEntry{
+		{Target: pid.String(), Trusted: true},
+		{Target: "10.0.0.0/8"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, s.peers.IsTrusted(pid))
+
+	// The lists are loaded back from the data directory.
+	restarted := newAccessListService(t, dataDir)
+	allow, deny := restarted.AccessLists()
+	assert.DeepEqual(t, []AccessEntry{{Target: "10.0.0.0/8"}, {Target: pid.String(), Trusted: true}}, allow)
+	assert.Equal(t, 0, len(deny))
+
+	require.NoError(t, s.RemoveAccessEntries(AllowList, []string{pid.String()}))
+	assert.Equal(t, false, s.peers.IsTrusted(pid))
+	restarted = newAccessListService(t, dataDir)
+	allow, _ = restarted.AccessLists()
+	assert.DeepEqual(t, []AccessEntry{{Target: "10.0.0.0/8"}}, allow)
+}
+
+func TestService_AddAccessEntries_DisconnectsDenied(t *testing.T) {
+	s := newAccessListService(t, "")
+	remote := bh.NewBlankHost(swarmt.GenSwarm(t, swarmt.OptDisableQUIC))
+	require.NoError(t, s.host.Connect(context.Background(), peer.AddrInfo{ID: remote.ID(), Addrs: remote.Addrs()}))
+
+	disconnected, err := s.AddAccessEntries(AllowList, []AccessEntry{{Target: remote.ID().String()}})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(disconnected))
+
+	disconnected, err = s.AddAccessEntries(DenyList, []AccessEntry{{Target: remote.ID().String()}})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []peer.ID{remote.ID()}, disconnected)
+	assert.Equal(t, network.NotConnected, s.host.Network().Connectedness(remote.ID()))
+}
+
+func TestService_AddAccessEntries_Invalid(t *testing.T) {
+	s := newAccessListService(t, "")
+	tests := []struct {
+		name    string
+		list    AccessList
+		entry   AccessEntry
+		wantErr string
+	}{
+		{
+			name:    "unknown target",
+			list:    AllowList,
+			entry:   AccessEntry{Target: "foo"},
+			wantErr: "invalid peer access entry",
+		},
+		{
+			name:    "invalid CIDR",
+			list:    DenyList,
+			entry:   AccessEntry{Target: "10.0.0.0/99"},
+			wantErr: "invalid CIDR address",
+		},
+		{
+			name:    "trusted range",
+			list:    AllowList,
+			entry:   AccessEntry{Target: "10.0.0.0/8", Trusted: true},
+			wantErr: "only allowlisted peers can be trusted",
+		},
+		{
+			name:    "trusted denylisted peer",
+			list:    DenyList,
+			entry:   AccessEntry{Target: "16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR", Trusted: true},
+			wantErr: "only allowlisted peers can be trusted",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.AddAccessEntries(tt.list, []AccessEntry{tt.entry})
+			require.ErrorContains(t, tt.wantErr, err)
+		})
+	}
+	allow, deny := s.AccessLists()
+	assert.Equal(t, 0, len(allow))
+	assert.Equal(t, 0, len(deny))
+}
+
+func TestParseAccessEntry_ENR(t *testing.T) {
+	key, err := gethCrypto.GenerateKey()
+	require.NoError(t, err)
+	var record enr.Record
+	require.NoError(t, enode.SignV4(&record, key))
+	node, err := enode.New(enode.ValidSchemes, &record)
+	require.NoError(t, err)
+	pubkey, err := ecdsaprysm.ConvertToInterfacePubkey(&key.PublicKey)
+	require.NoError(t, err)
+	want, err := peer.IDFromPublicKey(pubkey)
+	require.NoError(t, err)
+
+	r, err := parseAccessEntry(AccessEntry{Target: node.String()})
+	require.NoError(t, err)
+	assert.Equal(t, want, r.pid)
+}
+
+func TestService_InterceptAccessLists(t *testing.T) {
+	s := newAccessListService(t, "")
+	denied, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	allowed, err := peer.Decode("16Uiu2HAm4HgJ9N1o222xK61o7LSgToYWoAy1wNTJRkh9gLZapVAy")
+	require.NoError(t, err)
+	_, err = s.AddAccessEntries(DenyList, []AccessEntry{{Target: denied.String()}, {Target: "192.168.1.0/24"}})
+	require.NoError(t, err)
+	_, err = s.AddAccessEntries(AllowList, []AccessEntry{{Target: allowed.String()}, {Target: "10.0.0.0/8"}})
+	require.NoError(t, err)
+
+	inFilter, err := ma.NewMultiaddr("/ip4/192.168.2.1/tcp/13000")
+	require.NoError(t, err)
+	deniedRange, err := ma.NewMultiaddr("/ip4/192.168.1.1/tcp/13000")
+	require.NoError(t, err)
+	allowedRange, err := ma.NewMultiaddr("/ip4/10.0.0.1/tcp/13000")
+	require.NoError(t, err)
+	outsideFilter, err := ma.NewMultiaddr("/ip4/172.16.0.1/tcp/13000")
+	require.NoError(t, err)
+
+	assert.Equal(t, false, s.InterceptPeerDial(denied))
+	assert.Equal(t, true, s.InterceptPeerDial(allowed))
+	assert.Equal(t, false, s.InterceptAddrDial(denied, inFilter))
+	assert.Equal(t, false, s.InterceptAddrDial(allowed, deniedRange))
+	assert.Equal(t, true, s.InterceptAddrDial(allowed, outsideFilter))
+	assert.Equal(t, true, s.InterceptAddrDial("", allowedRange))
+	assert.Equal(t, false, s.InterceptAddrDial("", outsideFilter))
+	assert.Equal(t, false, s.InterceptAccept(&maEndpoints{raddr: deniedRange}))
+	assert.Equal(t, true, s.InterceptAccept(&maEndpoints{raddr: allowedRange}))
+	assert.Equal(t, false, s.InterceptSecured(0, denied, &maEndpoints{raddr: inFilter}))
+	assert.Equal(t, true, s.InterceptSecured(0, allowed, &maEndpoints{raddr: inFilter}))
+
+	// A peer added to the allowlist is removed from the denylist.
+	_, err = s.AddAccessEntries(AllowList, []AccessEntry{{Target: denied.String()}})
+	require.NoError(t, err)
+	assert.Equal(t, true, s.InterceptPeerDial(denied))
+}
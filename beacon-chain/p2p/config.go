@@ -26,6 +26,7 @@ type Config struct {
 	MaxPeers            uint
 	AllowListCIDR       string
 	DenyListCIDR        []string
+	PeerScoreMetrics    bool
 	StateNotifier       statefeed.Notifier
 	DB                  db.ReadOnlyDatabase
 }
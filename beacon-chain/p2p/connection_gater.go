@@ -25,13 +25,20 @@ const (
 )
 
 // InterceptPeerDial tests whether we're permitted to Dial the specified peer.
-func (_ *Service) InterceptPeerDial(_ peer.ID) (allow bool) {
-	return true
+func (s *Service) InterceptPeerDial(pid peer.ID) (allow bool) {
+	return !s.accessLists.matchesPeer(DenyList, pid)
 }
 
 // InterceptAddrDial tests whether we're permitted to dial the specified
 // multiaddr for the given peer.
 func (s *Service) InterceptAddrDial(pid peer.ID, m multiaddr.Multiaddr) (allow bool) {
+	if s.accessLists.matchesPeer(DenyList, pid) || s.accessLists.matchesAddr(DenyList, m) {
+		return false
+	}
+	// Allowlisted peers bypass the peer scores and the address filter.
+	if s.accessLists.matchesPeer(AllowList, pid) || s.accessLists.matchesAddr(AllowList, m) {
+		return true
+	}
 	// Disallow bad peers from dialing in.
 	if s.peers.IsBad(pid) {
 		return false
@@ -41,6 +48,16 @@ func (s *Service) InterceptAddrDial(pid peer.ID, m multiaddr.Multiaddr) (allow b
 
 // InterceptAccept checks whether the incidental inbound connection is allowed.
 func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
+	if s.accessLists.matchesAddr(DenyList, n.RemoteMultiaddr()) {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+			"reason": "denylisted"}).Trace("Not accepting inbound dial from ip address")
+		return false
+	}
+	// Inbound dials from allowlisted ranges bypass the dial and peer limits. The peer ID
+	// is not known yet, so allowlisted peer IDs are only checked once the connection is secured.
+	if s.accessLists.matchesAddr(AllowList, n.RemoteMultiaddr()) {
+		return true
+	}
 	if !s.validateDial(n.RemoteMultiaddr()) {
 		// Allow other go-routines to run in the event
 		// we receive a large amount of junk connections.
@@ -59,8 +76,8 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 
 // InterceptSecured tests whether a given connection, now authenticated,
 // is allowed.
-func (_ *Service) InterceptSecured(_ network.Direction, _ peer.ID, _ network.ConnMultiaddrs) (allow bool) {
-	return true
+func (s *Service) InterceptSecured(_ network.Direction, pid peer.ID, n network.ConnMultiaddrs) (allow bool) {
+	return !s.accessLists.matchesPeer(DenyList, pid) && !s.accessLists.matchesAddr(DenyList, n.RemoteMultiaddr())
 }
 
 // InterceptUpgraded tests whether a fully capable connection is allowed.
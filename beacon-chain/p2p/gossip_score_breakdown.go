@@ -0,0 +1,58 @@
+package p2p
+
+import (
+	"sort"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// gossipScoreBreakdown breaks the gossipsub score snapshot of a peer down into the weighted components of the
+// gossipsub v1.1 score function, using the same parameters as the pubsub router. Topics without score parameters
+// are not scored, so they are not reported. The mesh failure penalty (P3b) is not part of the snapshot, and the
+// mesh message deliveries penalty (P3) is only reported while the peer is in the mesh of the topic.
+func gossipScoreBreakdown(
+	snap *pubsub.PeerScoreSnapshot,
+	scoreParams *pubsub.PeerScoreParams,
+	topicParams map[string]*pubsub.TopicScoreParams,
+) *pbrpc.GossipScoreBreakdown {
+	breakdown := &pbrpc.GossipScoreBreakdown{
+		Score:             snap.Score,
+		AppSpecificScore:  snap.AppSpecificScore * scoreParams.AppSpecificWeight,
+		IpColocationScore: snap.IPColocationFactor * scoreParams.IPColocationFactorWeight,
+	}
+	for topic, ts := range snap.Topics {
+		params, ok := topicParams[topic]
+		if !ok || params == nil {
+			continue
+		}
+		t := &pbrpc.TopicScoreBreakdown{Topic: topic}
+		// The time in mesh is only set while the peer is in the mesh of the topic.
+		if ts.TimeInMesh > 0 {
+			p1 := float64(ts.TimeInMesh / params.TimeInMeshQuantum)
+			if p1 > params.TimeInMeshCap {
+				p1 = params.TimeInMeshCap
+			}
+			t.TimeInMeshScore = p1 * params.TimeInMeshWeight
+		}
+		t.FirstMessageDeliveriesScore = ts.FirstMessageDeliveries * params.FirstMessageDeliveriesWeight
+		if ts.TimeInMesh > params.MeshMessageDeliveriesActivation &&
+			ts.MeshMessageDeliveries < params.MeshMessageDeliveriesThreshold {
+			deficit := params.MeshMessageDeliveriesThreshold - ts.MeshMessageDeliveries
+			t.MeshMessageDeliveriesScore = deficit * deficit * params.MeshMessageDeliveriesWeight
+		}
+		t.InvalidMessageDeliveriesScore = ts.InvalidMessageDeliveries * ts.InvalidMessageDeliveries *
+			params.InvalidMessageDeliveriesWeight
+		t.Score = (t.TimeInMeshScore + t.FirstMessageDeliveriesScore + t.MeshMessageDeliveriesScore +
+			t.InvalidMessageDeliveriesScore) * params.TopicWeight
+		breakdown.Topics = append(breakdown.Topics, t)
+	}
+	sort.Slice(breakdown.Topics, func(i, j int) bool {
+		return breakdown.Topics[i].Topic < breakdown.Topics[j].Topic
+	})
+	if snap.BehaviourPenalty > scoreParams.BehaviourPenaltyThreshold {
+		excess := snap.BehaviourPenalty - scoreParams.BehaviourPenaltyThreshold
+		breakdown.BehaviourPenaltyScore = excess * excess * scoreParams.BehaviourPenaltyWeight
+	}
+	return breakdown
+}
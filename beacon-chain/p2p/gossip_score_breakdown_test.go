@@ -0,0 +1,80 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestGossipScoreBreakdown(t *testing.T) {
+	scoreParams, _ := peerScoringParams()
+	blockParams := defaultBlockTopicParams()
+	topicParams := map[string]*pubsub.TopicScoreParams{
+		"/eth2/abcdef01/beacon_block/ssz_snappy": blockParams,
+	}
+	snap := &pubsub.PeerScoreSnapshot{
+		Score: -20,
+		Topics: map[string]*pubsub.TopicScoreSnapshot{
+			"/eth2/abcdef01/beacon_block/ssz_snappy": {
+				TimeInMesh:               3 * blockParams.TimeInMeshQuantum,
+				FirstMessageDeliveries:   2,
+				InvalidMessageDeliveries: 1,
+			},
+			"/eth2/abcdef01/unknown/ssz_snappy": {
+				FirstMessageDeliveries: 5,
+			},
+		},
+		IPColocationFactor: 2,
+		BehaviourPenalty:   scoreParams.BehaviourPenaltyThreshold + 2,
+	}
+
+	b := gossipScoreBreakdown(snap, scoreParams, topicParams)
+	assert.Equal(t, float64(-20), b.Score)
+	require.Equal(t, 1, len(b.Topics))
+	topic := b.Topics[0]
+	assert.Equal(t, "/eth2/abcdef01/beacon_block/ssz_snappy", topic.Topic)
+	assert.Equal(t, 3*blockParams.TimeInMeshWeight, topic.TimeInMeshScore)
+	assert.Equal(t, 2*blockParams.FirstMessageDeliveriesWeight, topic.FirstMessageDeliveriesScore)
+	assert.Equal(t, float64(0), topic.MeshMessageDeliveriesScore)
+	assert.Equal(t, blockParams.InvalidMessageDeliveriesWeight, topic.InvalidMessageDeliveriesScore)
+	assert.Equal(t, (topic.TimeInMeshScore+topic.FirstMessageDeliveriesScore+topic.InvalidMessageDeliveriesScore)*
+		blockParams.TopicWeight, topic.Score)
+	assert.Equal(t, float64(0), b.AppSpecificScore)
+	assert.Equal(t, 2*scoreParams.IPColocationFactorWeight, b.IpColocationScore)
+	assert.Equal(t, 4*scoreParams.BehaviourPenaltyWeight, b.BehaviourPenaltyScore)
+}
+
+func TestGossipScoreBreakdown_MeshDeliveriesDeficit(t *testing.T) {
+	scoreParams, _ := peerScoringParams()
+	params := &pubsub.TopicScoreParams{
+		TopicWeight:                     0.5,
+		TimeInMeshQuantum:               time.Second,
+		TimeInMeshCap:                   10,
+		TimeInMeshWeight:                1,
+		MeshMessageDeliveriesWeight:     -2,
+		MeshMessageDeliveriesThreshold:  4,
+		MeshMessageDeliveriesActivation: 5 * time.Second,
+	}
+	topicParams := map[string]*pubsub.TopicScoreParams{"topic": params}
+
+	// The penalty is not applied before the activation.
+	b := gossipScoreBreakdown(&pubsub.PeerScoreSnapshot{
+		Topics: map[string]*pubsub.TopicScoreSnapshot{"topic": {TimeInMesh: 4 * time.Second, MeshMessageDeliveries: 1}},
+	}, scoreParams, topicParams)
+	require.Equal(t, 1, len(b.Topics))
+	assert.Equal(t, float64(0), b.Topics[0].MeshMessageDeliveriesScore)
+	assert.Equal(t, float64(2), b.Topics[0].Score)
+
+	// The time in mesh score is capped.
+	b = gossipScoreBreakdown(&pubsub.PeerScoreSnapshot{
+		Topics: map[string]*pubsub.TopicScoreSnapshot{"topic": {TimeInMesh: 20 * time.Second, MeshMessageDeliveries: 1}},
+	}, scoreParams, topicParams)
+	require.Equal(t, 1, len(b.Topics))
+	assert.Equal(t, float64(10), b.Topics[0].TimeInMeshScore)
+	assert.Equal(t, float64(-18), b.Topics[0].MeshMessageDeliveriesScore)
+	assert.Equal(t, float64(-4), b.Topics[0].Score)
+	assert.Equal(t, float64(0), b.BehaviourPenaltyScore)
+}
@@ -20,6 +20,8 @@ var gossipTopicMappings = map[string]proto.Message{
 	AggregateAndProofSubnetTopicFormat:        &ethpb.SignedAggregateAttestationAndProof{},
 	SyncContributionAndProofSubnetTopicFormat: &ethpb.SignedContributionAndProof{},
 	SyncCommitteeSubnetTopicFormat:            &ethpb.SyncCommitteeMessage{},
+	LightClientFinalityUpdateTopicFormat:      &ethpb.LightClientFinalityUpdate{},
+	LightClientOptimisticUpdateTopicFormat:    &ethpb.LightClientOptimisticUpdate{},
 }
 
 // GossipTopicMappings is a function to return the assigned data type
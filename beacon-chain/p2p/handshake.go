@@ -84,8 +84,8 @@ func (s *Service) AddConnectionHandler(reqFunc, goodByeFunc func(ctx context.Con
 					return
 				}
 				s.peers.Add(nil /* ENR */, remotePeer, conn.RemoteMultiaddr(), conn.Stat().Direction)
-				// Defensive check in the event we still get a bad peer.
-				if s.peers.IsBad(remotePeer) {
+				// Defensive check in the event we still get a bad peer. Allowlisted peers are kept regardless.
+				if s.peers.IsBad(remotePeer) && !s.accessLists.matchesPeer(AllowList, remotePeer) {
 					log.WithField("reason", "bad peer").Trace("Ignoring connection request")
 					disconnectFromPeer()
 					return
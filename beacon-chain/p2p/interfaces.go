@@ -81,6 +81,13 @@ type PeerManager interface {
 	AddPingMethod(reqFunc func(ctx context.Context, id peer.ID) error)
 }
 
+// PeerAccessManager manages the peer allowlist and denylist at runtime.
+type PeerAccessManager interface {
+	AccessLists() (allow, deny []AccessEntry)
+	AddAccessEntries(list AccessList, entries []AccessEntry) ([]peer.ID, error)
+	RemoveAccessEntries(list AccessList, targets []string) error
+}
+
 // Sender abstracts the sending functionality from libp2p.
 type Sender interface {
 	Send(context.Context, interface{}, string, peer.ID) (network.Stream, error)
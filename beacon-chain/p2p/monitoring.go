@@ -3,6 +3,7 @@ package p2p
 import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
 )
 
 var (
@@ -43,6 +44,23 @@ var (
 		Name: "p2p_sync_committee_subnet_attempted_broadcasts",
 		Help: "The number of sync committee that were attempted to be broadcast.",
 	})
+	peerScore = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_peer_score",
+		Help: "The weighted score of a peer by scorer, and its overall score. Only exported with --peer-score-metrics.",
+	},
+		[]string{"peer_id", "scorer"})
+	peerGossipScore = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_peer_gossip_score",
+		Help: "The weighted components of the gossipsub score of a peer which are not specific to a topic. " +
+			"Only exported with --peer-score-metrics.",
+	},
+		[]string{"peer_id", "component"})
+	peerGossipTopicScore = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_peer_gossip_topic_score",
+		Help: "The weighted components of the gossipsub score of a peer for a topic. " +
+			"Only exported with --peer-score-metrics.",
+	},
+		[]string{"peer_id", "topic", "component"})
 )
 
 func (s *Service) updateMetrics() {
@@ -52,4 +70,41 @@ func (s *Service) updateMetrics() {
 	p2pPeerCount.WithLabelValues("Connecting").Set(float64(len(s.peers.Connecting())))
 	p2pPeerCount.WithLabelValues("Disconnecting").Set(float64(len(s.peers.Disconnecting())))
 	p2pPeerCount.WithLabelValues("Bad").Set(float64(len(s.peers.Bad())))
+	if s.cfg.PeerScoreMetrics {
+		s.updatePeerScoreMetrics()
+	}
+}
+
+// updatePeerScoreMetrics exports the score breakdown of the peers known to the node. The previous series are
+// reset, so that the series of removed peers are not exported anymore.
+func (s *Service) updatePeerScoreMetrics() {
+	peerScore.Reset()
+	peerGossipScore.Reset()
+	peerGossipTopicScore.Reset()
+	scorer := s.peers.Scorers()
+	for _, pid := range s.peers.All() {
+		id := pid.String()
+		b := scorer.ScoreBreakdown(pid)
+		peerScore.WithLabelValues(id, "overall").Set(b.Total)
+		peerScore.WithLabelValues(id, scorers.BadResponsesScorerName).Set(b.BadResponses)
+		peerScore.WithLabelValues(id, scorers.BlockProviderScorerName).Set(b.BlockProvider)
+		peerScore.WithLabelValues(id, scorers.PeerStatusScorerName).Set(b.PeerStatus)
+		peerScore.WithLabelValues(id, scorers.GossipScorerName).Set(b.Gossip)
+
+		gossip := scorer.GossipScorer().GossipScoreBreakdown(pid)
+		if gossip == nil {
+			continue
+		}
+		peerGossipScore.WithLabelValues(id, "score").Set(gossip.Score)
+		peerGossipScore.WithLabelValues(id, "app_specific").Set(gossip.AppSpecificScore)
+		peerGossipScore.WithLabelValues(id, "ip_colocation").Set(gossip.IpColocationScore)
+		peerGossipScore.WithLabelValues(id, "behaviour_penalty").Set(gossip.BehaviourPenaltyScore)
+		for _, t := range gossip.Topics {
+			peerGossipTopicScore.WithLabelValues(id, t.Topic, "score").Set(t.Score)
+			peerGossipTopicScore.WithLabelValues(id, t.Topic, "time_in_mesh").Set(t.TimeInMeshScore)
+			peerGossipTopicScore.WithLabelValues(id, t.Topic, "first_message_deliveries").Set(t.FirstMessageDeliveriesScore)
+			peerGossipTopicScore.WithLabelValues(id, t.Topic, "mesh_message_deliveries").Set(t.MeshMessageDeliveriesScore)
+			peerGossipTopicScore.WithLabelValues(id, t.Topic, "invalid_message_deliveries").Set(t.InvalidMessageDeliveriesScore)
+		}
+	}
 }
@@ -0,0 +1,126 @@
+package peers
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	prysmTime "github.com/prysmaticlabs/prysm/time"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// maxBanHistory is the number of bans kept in the ban history.
+	maxBanHistory = 256
+	// ipColocationScorer is reported as the scorer of peers banned for sharing their ip with too many other peers.
+	ipColocationScorer = "ip_colocation"
+)
+
+var banCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "p2p_peer_bans_total",
+	Help: "The number of peers found bad, by the scorer which classified them as bad.",
+},
+	[]string{"scorer"})
+
+// Ban describes a peer which has been found bad, and why.
+type Ban struct {
+	PeerID peer.ID
+	// Scorer is the name of the scorer which classified the peer as bad, or ip_colocation for peers
+	// sharing their ip with too many other peers.
+	Scorer string
+	Reason string
+	Score  float64
+	Time   time.Time
+}
+
+// banHistory keeps the most recent bans. A peer is recorded again only after it has stopped being bad.
+type banHistory struct {
+	sync.Mutex
+	banned map[peer.ID]bool
+	bans   []*Ban
+}
+
+func newBanHistory() *banHistory {
+	return &banHistory{
+		banned: make(map[peer.ID]bool),
+	}
+}
+
+// isBanned checks whether the peer has been recorded as bad.
+func (h *banHistory) isBanned(pid peer.ID) bool {
+	h.Lock()
+	defer h.Unlock()
+	return h.banned[pid]
+}
+
+// add records the ban, unless the peer has already been recorded as bad.
+func (h *banHistory) add(ban *Ban) {
+	h.Lock()
+	defer h.Unlock()
+	if h.banned[ban.PeerID] {
+		return
+	}
+	h.banned[ban.PeerID] = true
+	h.bans = append(h.bans, ban)
+	if len(h.bans) > maxBanHistory {
+		h.bans = h.bans[len(h.bans)-maxBanHistory:]
+	}
+	banCount.WithLabelValues(ban.Scorer).Inc()
+}
+
+// forget clears the bad mark of the peer, so that it is recorded again once found bad.
+func (h *banHistory) forget(pid peer.ID) {
+	h.Lock()
+	defer h.Unlock()
+	delete(h.banned, pid)
+}
+
+// list returns the recorded bans, most recent first.
+func (h *banHistory) list() []Ban {
+	h.Lock()
+	defer h.Unlock()
+	bans := make([]Ban, len(h.bans))
+	for i, b := range h.bans {
+		bans[len(h.bans)-1-i] = *b
+	}
+	return bans
+}
+
+// BanHistory returns the most recent peers which have been found bad, most recent first.
+func (p *Status) BanHistory() []Ban {
+	return p.bans.list()
+}
+
+// recordBan updates the ban history with the latest bad peer classification. It is called whenever the data the
+// classification depends on changes, and the caller must hold the peer store lock.
+func (p *Status) recordBan(pid peer.ID) {
+	if !p.isBad(pid) {
+		p.bans.forget(pid)
+		return
+	}
+	if p.bans.isBanned(pid) {
+		return
+	}
+	ban := &Ban{
+		PeerID: pid,
+		Score:  p.scorers.ScoreNoLock(pid),
+		Time:   prysmTime.Now(),
+	}
+	if p.isfromBadIP(pid) {
+		ban.Scorer = ipColocationScorer
+		if peerData, ok := p.store.PeerData(pid); ok {
+			ban.Reason = fmt.Sprintf("more than %d peers share the ip of %v", ColocationLimit, peerData.Address)
+		}
+	} else {
+		ban.Scorer, ban.Reason = p.scorers.BadPeerReasonNoLock(pid)
+	}
+	log.WithFields(logrus.Fields{
+		"peer":   pid,
+		"scorer": ban.Scorer,
+		"reason": ban.Reason,
+	}).Debug("Peer found bad")
+	p.bans.add(ban)
+}
@@ -22,8 +22,9 @@ const (
 
 // BadResponsesScorer represents bad responses scoring service.
 type BadResponsesScorer struct {
-	config *BadResponsesScorerConfig
-	store  *peerdata.Store
+	config   *BadResponsesScorerConfig
+	store    *peerdata.Store
+	onChange BadPeerHandler
 }
 
 // BadResponsesScorerConfig holds configuration parameters for bad response scoring service.
@@ -109,9 +110,10 @@ func (s *BadResponsesScorer) Increment(pid peer.ID) {
 		s.store.SetPeerData(pid, &peerdata.PeerData{
 			BadResponses: 1,
 		})
-		return
+	} else {
+		peerData.BadResponses++
 	}
-	peerData.BadResponses++
+	s.onChange.notify(pid)
 }
 
 // IsBadPeer states if the peer is to be considered bad.
@@ -151,9 +153,10 @@ func (s *BadResponsesScorer) Decay() {
 	s.store.Lock()
 	defer s.store.Unlock()
 
-	for _, peerData := range s.store.Peers() {
+	for pid, peerData := range s.store.Peers() {
 		if peerData.BadResponses > 0 {
 			peerData.BadResponses--
+			s.onChange.notify(pid)
 		}
 	}
 }
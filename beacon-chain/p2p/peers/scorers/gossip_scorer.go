@@ -16,8 +16,9 @@ const (
 // GossipScorer represents scorer that evaluates peers based on their gossip performance.
 // Gossip scoring metrics are periodically calculated in libp2p's internal pubsub module.
 type GossipScorer struct {
-	config *GossipScorerConfig
-	store  *peerdata.Store
+	config   *GossipScorerConfig
+	store    *peerdata.Store
+	onChange BadPeerHandler
 }
 
 // GossipScorerConfig holds configuration parameters for gossip scoring service.
@@ -90,6 +91,27 @@ func (s *GossipScorer) SetGossipData(pid peer.ID, gScore float64,
 	peerData.GossipScore = gScore
 	peerData.BehaviourPenalty = bPenalty
 	peerData.TopicScores = topicScores
+	s.onChange.notify(pid)
+}
+
+// SetGossipScoreBreakdown sets the breakdown of the gossip score of a peer into its components.
+func (s *GossipScorer) SetGossipScoreBreakdown(pid peer.ID, breakdown *pbrpc.GossipScoreBreakdown) {
+	s.store.Lock()
+	defer s.store.Unlock()
+
+	peerData := s.store.PeerDataGetOrCreate(pid)
+	peerData.GossipScoreBreakdown = breakdown
+}
+
+// GossipScoreBreakdown returns the breakdown of the gossip score of the given peer, as last computed
+// from the libp2p peer score snapshot. This can return nil if the breakdown is not known.
+func (s *GossipScorer) GossipScoreBreakdown(pid peer.ID) *pbrpc.GossipScoreBreakdown {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	if peerData, ok := s.store.PeerData(pid); ok {
+		return peerData.GossipScoreBreakdown
+	}
+	return nil
 }
 
 // GossipData gets the gossip related information of the given remote peer.
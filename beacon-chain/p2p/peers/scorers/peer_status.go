@@ -21,6 +21,7 @@ type PeerStatusScorer struct {
 	store               *peerdata.Store
 	ourHeadSlot         types.Slot
 	highestPeerHeadSlot types.Slot
+	onChange            BadPeerHandler
 }
 
 // PeerStatusScorerConfig holds configuration parameters for peer status scoring service.
@@ -121,6 +122,7 @@ func (s *PeerStatusScorer) SetPeerStatus(pid peer.ID, chainState *pb.Status, val
 	if chainState != nil && chainState.HeadSlot > s.highestPeerHeadSlot {
 		s.highestPeerHeadSlot = chainState.HeadSlot
 	}
+	s.onChange.notify(pid)
 }
 
 // PeerStatus gets the chain state of the given remote peer.
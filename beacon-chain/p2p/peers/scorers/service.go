@@ -2,6 +2,7 @@ package scorers
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"time"
 
@@ -21,6 +22,14 @@ const ScoreRoundingFactor = 10000
 // all the other scoring services have their relevant penalties on similar scales.
 const BadPeerScore = gossipThreshold
 
+// Names of the scorers, as reported in score breakdowns and bad peer reasons.
+const (
+	BadResponsesScorerName  = "bad_responses"
+	BlockProviderScorerName = "block_provider"
+	PeerStatusScorerName    = "peer_status"
+	GossipScorerName        = "gossip"
+)
+
 // Scorer defines minimum set of methods every peer scorer must expose.
 type Scorer interface {
 	Score(pid peer.ID) float64
@@ -28,6 +37,17 @@ type Scorer interface {
 	BadPeers() []peer.ID
 }
 
+// BadPeerHandler is called with the peers whose bad responses, chain status or gossip score changed, which may
+// classify them as bad or not bad anymore. It is called while the peer store lock is held.
+type BadPeerHandler func(pid peer.ID)
+
+// notify calls the handler, if any.
+func (h BadPeerHandler) notify(pid peer.ID) {
+	if h != nil {
+		h(pid)
+	}
+}
+
 // Service manages peer scorers that are used to calculate overall peer score.
 type Service struct {
 	store   *peerdata.Store
@@ -72,6 +92,15 @@ func NewService(ctx context.Context, store *peerdata.Store, config *Config) *Ser
 	return s
 }
 
+// SetBadPeerHandler sets the handler notified of the changes of the data the bad peer classification depends on.
+func (s *Service) SetBadPeerHandler(h BadPeerHandler) {
+	s.store.Lock()
+	defer s.store.Unlock()
+	s.scorers.badResponsesScorer.onChange = h
+	s.scorers.peerStatusScorer.onChange = h
+	s.scorers.gossipScorer.onChange = h
+}
+
 // BadResponsesScorer exposes bad responses scoring service.
 func (s *Service) BadResponsesScorer() *BadResponsesScorer {
 	return s.scorers.badResponsesScorer
@@ -112,15 +141,39 @@ func (s *Service) Score(pid peer.ID) float64 {
 
 // ScoreNoLock is a lock-free version of Score.
 func (s *Service) ScoreNoLock(pid peer.ID) float64 {
-	score := float64(0)
+	return s.ScoreBreakdownNoLock(pid).Total
+}
+
+// ScoreBreakdown holds the weighted contribution of every scorer to the overall peer score.
+type ScoreBreakdown struct {
+	BadResponses  float64
+	BlockProvider float64
+	PeerStatus    float64
+	Gossip        float64
+	Total         float64
+}
+
+// ScoreBreakdown returns the weighted contribution of every scorer to the peer score.
+func (s *Service) ScoreBreakdown(pid peer.ID) ScoreBreakdown {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return s.ScoreBreakdownNoLock(pid)
+}
+
+// ScoreBreakdownNoLock is a lock-free version of ScoreBreakdown.
+func (s *Service) ScoreBreakdownNoLock(pid peer.ID) ScoreBreakdown {
 	if _, ok := s.store.PeerData(pid); !ok {
-		return 0
+		return ScoreBreakdown{}
 	}
-	score += s.scorers.badResponsesScorer.score(pid) * s.scorerWeight(s.scorers.badResponsesScorer)
-	score += s.scorers.blockProviderScorer.score(pid) * s.scorerWeight(s.scorers.blockProviderScorer)
-	score += s.scorers.peerStatusScorer.score(pid) * s.scorerWeight(s.scorers.peerStatusScorer)
-	score += s.scorers.gossipScorer.score(pid) * s.scorerWeight(s.scorers.gossipScorer)
-	return math.Round(score*ScoreRoundingFactor) / ScoreRoundingFactor
+	b := ScoreBreakdown{
+		BadResponses:  s.scorers.badResponsesScorer.score(pid) * s.scorerWeight(s.scorers.badResponsesScorer),
+		BlockProvider: s.scorers.blockProviderScorer.score(pid) * s.scorerWeight(s.scorers.blockProviderScorer),
+		PeerStatus:    s.scorers.peerStatusScorer.score(pid) * s.scorerWeight(s.scorers.peerStatusScorer),
+		Gossip:        s.scorers.gossipScorer.score(pid) * s.scorerWeight(s.scorers.gossipScorer),
+	}
+	score := b.BadResponses + b.BlockProvider + b.PeerStatus + b.Gossip
+	b.Total = math.Round(score*ScoreRoundingFactor) / ScoreRoundingFactor
+	return b
 }
 
 // IsBadPeer traverses all the scorers to see if any of them classifies peer as bad.
@@ -146,6 +199,34 @@ func (s *Service) IsBadPeerNoLock(pid peer.ID) bool {
 	return false
 }
 
+// BadPeerReason returns the name of the scorer classifying the peer as bad, and why.
+// Empty strings are returned if the peer is not bad.
+func (s *Service) BadPeerReason(pid peer.ID) (scorer, reason string) {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return s.BadPeerReasonNoLock(pid)
+}
+
+// BadPeerReasonNoLock is a lock-free version of BadPeerReason.
+func (s *Service) BadPeerReasonNoLock(pid peer.ID) (scorer, reason string) {
+	peerData, ok := s.store.PeerData(pid)
+	if !ok {
+		return "", ""
+	}
+	if s.scorers.badResponsesScorer.isBadPeer(pid) {
+		return BadResponsesScorerName, fmt.Sprintf("%d bad responses, threshold is %d",
+			peerData.BadResponses, s.scorers.badResponsesScorer.Params().Threshold)
+	}
+	if s.scorers.peerStatusScorer.isBadPeer(pid) {
+		return PeerStatusScorerName, fmt.Sprintf("invalid chain status: %v", peerData.ChainStateValidationError)
+	}
+	if features.Get().EnablePeerScorer && s.scorers.gossipScorer.isBadPeer(pid) {
+		return GossipScorerName, fmt.Sprintf("gossip score %.2f is below threshold %.2f",
+			peerData.GossipScore, gossipThreshold)
+	}
+	return "", ""
+}
+
 // BadPeers returns the peers that are considered bad by any of registered scorers.
 func (s *Service) BadPeers() []peer.ID {
 	s.store.RLock()
@@ -9,7 +9,9 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 )
 
@@ -308,3 +310,52 @@ func TestScorers_Service_BadPeers(t *testing.T) {
 	assert.Equal(t, true, peerStatuses.Scorers().IsBadPeer("peer3"))
 	assert.Equal(t, 2, len(peerStatuses.Scorers().BadPeers()))
 }
+
+func TestScorers_Service_ScoreBreakdown(t *testing.T) {
+	peerStatuses := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold:     2,
+				DecayInterval: 50 * time.Second,
+			},
+		},
+	})
+	s := peerStatuses.Scorers()
+
+	assert.Equal(t, scorers.ScoreBreakdown{}, s.ScoreBreakdown("peer1"))
+	s.BadResponsesScorer().Increment("peer1")
+	s.GossipScorer().SetGossipData("peer1", 10, 0, nil)
+	b := s.ScoreBreakdown("peer1")
+	assert.Equal(t, s.BadResponsesScorer().Score("peer1")*0.3, b.BadResponses)
+	assert.Equal(t, s.GossipScorer().Score("peer1")*0.4, b.Gossip)
+	assert.Equal(t, s.Score("peer1"), b.Total)
+	assert.Equal(t, roundScore(b.BadResponses+b.BlockProvider+b.PeerStatus+b.Gossip), b.Total)
+}
+
+func TestScorers_Service_BadPeerReason(t *testing.T) {
+	peerStatuses := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold:     2,
+				DecayInterval: 50 * time.Second,
+			},
+		},
+	})
+	s := peerStatuses.Scorers()
+
+	scorer, reason := s.BadPeerReason("peer1")
+	assert.Equal(t, "", scorer)
+	assert.Equal(t, "", reason)
+	s.BadResponsesScorer().Increment("peer1")
+	s.BadResponsesScorer().Increment("peer1")
+	scorer, reason = s.BadPeerReason("peer1")
+	assert.Equal(t, scorers.BadResponsesScorerName, scorer)
+	assert.Equal(t, "2 bad responses, threshold is 2", reason)
+
+	s.PeerStatusScorer().SetPeerStatus("peer2", &pb.Status{}, p2ptypes.ErrWrongForkDigestVersion)
+	scorer, reason = s.BadPeerReason("peer2")
+	assert.Equal(t, scorers.PeerStatusScorerName, scorer)
+	assert.Equal(t, "invalid chain status: "+p2ptypes.ErrWrongForkDigestVersion.Error(), reason)
+}
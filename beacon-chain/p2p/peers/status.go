@@ -82,6 +82,8 @@ type Status struct {
 	scorers   *scorers.Service
 	store     *peerdata.Store
 	ipTracker map[string]uint64
+	trusted   map[peer.ID]bool
+	bans      *banHistory
 	rand      *rand.Rand
 }
 
@@ -98,15 +100,19 @@ func NewStatus(ctx context.Context, config *StatusConfig) *Status {
 	store := peerdata.NewStore(ctx, &peerdata.StoreConfig{
 		MaxPeers: maxLimitBuffer + config.PeerLimit,
 	})
-	return &Status{
+	p := &Status{
 		ctx:       ctx,
 		store:     store,
 		scorers:   scorers.NewService(ctx, store, config.ScorerParams),
 		ipTracker: map[string]uint64{},
+		trusted:   map[peer.ID]bool{},
+		bans:      newBanHistory(),
 		// Random generator used to calculate dial backoff period.
 		// It is ok to use deterministic generator, no need for true entropy.
 		rand: rand.NewDeterministicGenerator(),
 	}
+	p.scorers.SetBadPeerHandler(p.recordBan)
+	return p
 }
 
 // Scorers exposes peer scoring management service.
@@ -135,6 +141,7 @@ func (p *Status) Add(record *enr.Record, pid peer.ID, address ma.Multiaddr, dire
 		}
 		if !sameIP(prevAddress, address) {
 			p.addIpToTracker(pid)
+			p.recordBan(pid)
 		}
 		return
 	}
@@ -149,6 +156,7 @@ func (p *Status) Add(record *enr.Record, pid peer.ID, address ma.Multiaddr, dire
 	}
 	p.store.SetPeerData(pid, peerData)
 	p.addIpToTracker(pid)
+	p.recordBan(pid)
 }
 
 // Address returns the multiaddress of the given remote peer.
@@ -338,6 +346,25 @@ func (p *Status) isBad(pid peer.ID) bool {
 	return p.isfromBadIP(pid) || p.scorers.IsBadPeerNoLock(pid)
 }
 
+// SetTrusted marks or unmarks the peer as trusted. Trusted peers are never pruned, neither from the peer store
+// nor from the connected peers.
+func (p *Status) SetTrusted(pid peer.ID, trusted bool) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	if trusted {
+		p.trusted[pid] = true
+		return
+	}
+	delete(p.trusted, pid)
+}
+
+// IsTrusted states if the peer is marked as trusted.
+func (p *Status) IsTrusted(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.trusted[pid]
+}
+
 // NextValidTime gets the earliest possible time it is to contact/dial
 // a peer again. This is used to back-off from peers in the event
 // they are 'full' or have banned us.
@@ -563,7 +590,7 @@ func (p *Status) Prune() {
 	peersToPrune := make([]*peerResp, 0)
 	// Select disconnected peers with a smaller bad response count.
 	for pid, peerData := range p.store.Peers() {
-		if peerData.ConnState == PeerDisconnected && notBadPeer(pid) {
+		if peerData.ConnState == PeerDisconnected && notBadPeer(pid) && !p.trusted[pid] {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:   pid,
 				score: p.Scorers().ScoreNoLock(pid),
@@ -589,6 +616,7 @@ func (p *Status) Prune() {
 	// Delete peers from map.
 	for _, peerData := range peersToPrune {
 		p.store.DeletePeerData(peerData.pid)
+		p.bans.forget(peerData.pid)
 	}
 	p.tallyIPTracker()
 }
@@ -611,7 +639,7 @@ func (p *Status) deprecatedPrune() {
 	peersToPrune := make([]*peerResp, 0)
 	// Select disconnected peers with a smaller bad response count.
 	for pid, peerData := range p.store.Peers() {
-		if peerData.ConnState == PeerDisconnected && notBadPeer(peerData) {
+		if peerData.ConnState == PeerDisconnected && notBadPeer(peerData) && !p.trusted[pid] {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:     pid,
 				badResp: peerData.BadResponses,
@@ -635,6 +663,7 @@ func (p *Status) deprecatedPrune() {
 	// Delete peers from map.
 	for _, peerData := range peersToPrune {
 		p.store.DeletePeerData(peerData.pid)
+		p.bans.forget(peerData.pid)
 	}
 	p.tallyIPTracker()
 }
@@ -766,10 +795,10 @@ func (p *Status) PeersToPrune() []peer.ID {
 		score float64
 	}
 	peersToPrune := make([]*peerResp, 0)
-	// Select connected and inbound peers to prune.
+	// Select connected and inbound peers to prune, excluding trusted peers.
 	for pid, peerData := range p.store.Peers() {
 		if peerData.ConnState == PeerConnected &&
-			peerData.Direction == network.DirInbound {
+			peerData.Direction == network.DirInbound && !p.trusted[pid] {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:   pid,
 				score: p.scorers.ScoreNoLock(pid),
@@ -832,10 +861,10 @@ func (p *Status) deprecatedPeersToPrune() []peer.ID {
 		badResp int
 	}
 	peersToPrune := make([]*peerResp, 0)
-	// Select connected and inbound peers to prune.
+	// Select connected and inbound peers to prune, excluding trusted peers.
 	for pid, peerData := range p.store.Peers() {
 		if peerData.ConnState == PeerConnected &&
-			peerData.Direction == network.DirInbound {
+			peerData.Direction == network.DirInbound && !p.trusted[pid] {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:     pid,
 				badResp: peerData.BadResponses,
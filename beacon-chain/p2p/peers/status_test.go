@@ -15,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
@@ -755,6 +756,78 @@ func TestPrunePeers(t *testing.T) {
 	}
 }
 
+func TestPrune_TrustedPeers(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 1,
+			},
+		},
+	})
+	for i := 0; i < p.MaxPeerLimit()+10; i++ {
+		addPeer(t, p, peers.PeerDisconnected)
+	}
+	trusted := p.Disconnected()[0]
+	p.SetTrusted(trusted, true)
+	assert.Equal(t, true, p.IsTrusted(trusted))
+
+	p.Prune()
+	_, err := p.ConnectionState(trusted)
+	require.NoError(t, err, "Trusted peer was pruned")
+
+	for i := 0; i < 40; i++ {
+		createPeer(t, p, nil, network.DirInbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+	}
+	inbound := p.InboundConnected()
+	for _, pid := range inbound {
+		p.SetTrusted(pid, true)
+	}
+	assert.Equal(t, 0, len(p.PeersToPrune()))
+
+	p.SetTrusted(inbound[0], false)
+	assert.Equal(t, false, p.IsTrusted(inbound[0]))
+	assert.DeepEqual(t, []peer.ID{inbound[0]}, p.PeersToPrune())
+}
+
+func TestStatus_BanHistory(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 1,
+			},
+		},
+	})
+	pid1 := addPeer(t, p, peers.PeerConnected)
+	pid2 := addPeer(t, p, peers.PeerConnected)
+	assert.Equal(t, false, p.IsBad(pid1))
+	assert.Equal(t, 0, len(p.BanHistory()))
+
+	p.Scorers().BadResponsesScorer().Increment(pid1)
+	assert.Equal(t, true, p.IsBad(pid1))
+	// A peer is only recorded once while it stays bad.
+	assert.Equal(t, true, p.IsBad(pid1))
+	p.Scorers().PeerStatusScorer().SetPeerStatus(pid2, &pb.Status{}, p2ptypes.ErrWrongForkDigestVersion)
+
+	bans := p.BanHistory()
+	require.Equal(t, 2, len(bans))
+	assert.Equal(t, pid2, bans[0].PeerID)
+	assert.Equal(t, scorers.PeerStatusScorerName, bans[0].Scorer)
+	assert.Equal(t, pid1, bans[1].PeerID)
+	assert.Equal(t, scorers.BadResponsesScorerName, bans[1].Scorer)
+	assert.Equal(t, "1 bad responses, threshold is 1", bans[1].Reason)
+
+	// The peer is recorded again once it is found bad after having recovered.
+	p.Scorers().BadResponsesScorer().Decay()
+	assert.Equal(t, false, p.IsBad(pid1))
+	p.Scorers().BadResponsesScorer().Increment(pid1)
+	assert.Equal(t, true, p.IsBad(pid1))
+	bans = p.BanHistory()
+	require.Equal(t, 3, len(bans))
+	assert.Equal(t, pid1, bans[0].PeerID)
+}
+
 func TestStatus_BestPeer(t *testing.T) {
 	type peerConfig struct {
 		headSlot       types.Slot
@@ -113,6 +113,9 @@ func (s *Service) SubscribeToTopic(topic string, opts ...pubsub.SubOpt) (*pubsub
 		if err := topicHandle.SetScoreParams(scoringParams); err != nil {
 			return nil, err
 		}
+		s.topicScoreParamsLock.Lock()
+		s.scoredTopics[topic] = scoringParams
+		s.topicScoreParamsLock.Unlock()
 		logGossipParameters(topic, scoringParams)
 	}
 	return topicHandle.Subscribe(opts...)
@@ -123,9 +126,14 @@ func (s *Service) SubscribeToTopic(topic string, opts ...pubsub.SubOpt) (*pubsub
 func (s *Service) peerInspector(peerMap map[peer.ID]*pubsub.PeerScoreSnapshot) {
 	// Iterate through all the connected peers and through any of their
 	// relevant topics.
+	scoreParams, _ := peerScoringParams()
+	s.topicScoreParamsLock.RLock()
+	defer s.topicScoreParamsLock.RUnlock()
 	for pid, snap := range peerMap {
 		s.peers.Scorers().GossipScorer().SetGossipData(pid, snap.Score,
 			snap.BehaviourPenalty, convertTopicScores(snap.Topics))
+		s.peers.Scorers().GossipScorer().SetGossipScoreBreakdown(pid,
+			gossipScoreBreakdown(snap, scoreParams, s.scoredTopics))
 	}
 }
 
@@ -67,12 +67,15 @@ type Service struct {
 	cfg                   *Config
 	peers                 *peers.Status
 	addrFilter            *multiaddr.Filters
+	accessLists           *accessLists
 	ipLimiter             *leakybucket.Collector
 	privKey               *ecdsa.PrivateKey
 	metaData              metadata.Metadata
 	pubsub                *pubsub.PubSub
 	joinedTopics          map[string]*pubsub.Topic
 	joinedTopicsLock      sync.Mutex
+	topicScoreParamsLock  sync.RWMutex
+	scoredTopics          map[string]*pubsub.TopicScoreParams
 	subnetsLock           map[uint64]*sync.RWMutex
 	subnetsLockLock       sync.Mutex // Lock access to subnetsLock
 	initializationLock    sync.Mutex
@@ -100,6 +103,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		cfg:           cfg,
 		isPreGenesis:  true,
 		joinedTopics:  make(map[string]*pubsub.Topic, len(gossipTopicMappings)),
+		scoredTopics:  make(map[string]*pubsub.TopicScoreParams, len(gossipTopicMappings)),
 		subnetsLock:   make(map[uint64]*sync.RWMutex),
 	}
 
@@ -123,6 +127,11 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		log.WithError(err).Error("Failed to create address filter")
 		return nil, err
 	}
+	s.accessLists, err = loadAccessLists(s.cfg.DataDir)
+	if err != nil {
+		log.WithError(err).Error("Failed to load peer access lists")
+		return nil, err
+	}
 	s.ipLimiter = leakybucket.NewCollector(ipLimit, ipBurst, true /* deleteEmptyBuckets */)
 
 	opts := s.buildOptions(ipAddr, s.privKey)
@@ -173,6 +182,9 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 			},
 		},
 	})
+	for pid := range s.accessLists.trustedPeers() {
+		s.peers.SetTrusted(pid, true)
+	}
 
 	// Initialize Data maps.
 	types.InitializeDataMaps()
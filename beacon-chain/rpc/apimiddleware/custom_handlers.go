@@ -11,9 +11,14 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/api/gateway/apimiddleware"
 	"github.com/prysmaticlabs/prysm/api/grpc"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/events"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/time/slots"
 	"github.com/r3labs/sse"
 )
 
@@ -389,6 +394,24 @@ func receiveEvents(eventChan <-chan *sse.Event, w http.ResponseWriter, req *http
 				data = &eventChainReorgJson{}
 			case events.SyncCommitteeContributionTopic:
 				data = &signedContributionAndProofJson{}
+			case events.PayloadAttributesTopic:
+				data = &eventPayloadAttributesJson{}
+			case events.BlockGossipTopic:
+				data = &eventBlockGossipJson{}
+			case events.ProposerSlashingTopic:
+				data = &proposerSlashingJson{}
+			case events.AttesterSlashingTopic:
+				data = &attesterSlashingJson{}
+			case events.LightClientFinalityUpdateTopic:
+				data = &eventLightClientFinalityUpdateJson{}
+				if errJson := versionLightClientEvent(msg); errJson != nil {
+					return errJson
+				}
+			case events.LightClientOptimisticUpdateTopic:
+				data = &eventLightClientOptimisticUpdateJson{}
+				if errJson := versionLightClientEvent(msg); errJson != nil {
+					return errJson
+				}
 			case "error":
 				data = &eventErrorJson{}
 			default:
@@ -410,6 +433,40 @@ func receiveEvents(eventChan <-chan *sse.Event, w http.ResponseWriter, req *http
 	}
 }
 
+// versionLightClientEvent wraps the light client update of the event data into an object holding the name of the fork
+// of its attested header, as light client updates are served along with their version.
+func versionLightClientEvent(msg *sse.Event) apimiddleware.ErrorJson {
+	update := &struct {
+		AttestedHeader *beaconBlockHeaderJson `json:"attested_header"`
+	}{}
+	if err := json.Unmarshal(msg.Data, update); err != nil {
+		return apimiddleware.InternalServerError(err)
+	}
+	if update.AttestedHeader == nil {
+		return apimiddleware.InternalServerError(errors.New("light client update has no attested header"))
+	}
+	slot, err := strconv.ParseUint(update.AttestedHeader.Slot, 10, 64)
+	if err != nil {
+		return apimiddleware.InternalServerErrorWithMessage(err, "slot is not an unsigned integer")
+	}
+	v := version.String(version.Altair)
+	if slots.ToEpoch(types.Slot(slot)) >= params.BeaconConfig().BellatrixForkEpoch {
+		v = version.String(version.Bellatrix)
+	}
+	versioned, err := json.Marshal(&struct {
+		Version string          `json:"version"`
+		Data    json.RawMessage `json:"data"`
+	}{
+		Version: v,
+		Data:    msg.Data,
+	})
+	if err != nil {
+		return apimiddleware.InternalServerError(err)
+	}
+	msg.Data = versioned
+	return nil
+}
+
 func writeEvent(msg *sse.Event, w http.ResponseWriter, data interface{}) apimiddleware.ErrorJson {
 	if err := json.Unmarshal(msg.Data, data); err != nil {
 		return apimiddleware.InternalServerError(err)
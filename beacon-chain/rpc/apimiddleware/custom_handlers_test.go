@@ -325,6 +325,93 @@ data: {"block":"0x666f6f","state":"0x666f6f","epoch":"1","execution_optimistic":
 `, w.Body.String())
 }
 
+func TestReceiveEvents_PayloadAttributes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan *sse.Event)
+	w := httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+	req := httptest.NewRequest("GET", "http://foo.example", &bytes.Buffer{})
+	req = req.WithContext(ctx)
+
+	go func() {
+		base64Val := "Zm9v"
+		data := &eventPayloadAttributesJson{
+			Version: "bellatrix",
+			Data: &eventBasicPayloadAttributesJson{
+				ProposalSlot:      "2",
+				ProposerIndex:     "3",
+				ParentBlockRoot:   base64Val,
+				ParentBlockNumber: "1",
+				ParentBlockHash:   base64Val,
+				PayloadAttributes: &eventPayloadAttributesV1Json{
+					Timestamp:             "24",
+					PrevRandao:            base64Val,
+					SuggestedFeeRecipient: base64Val,
+				},
+			},
+		}
+		bData, err := json.Marshal(data)
+		require.NoError(t, err)
+		msg := &sse.Event{
+			Data:  bData,
+			Event: []byte(events.PayloadAttributesTopic),
+		}
+		ch <- msg
+		time.Sleep(time.Second)
+		cancel()
+	}()
+
+	errJson := receiveEvents(ch, w, req)
+	assert.Equal(t, true, errJson == nil)
+	assert.Equal(t, `event: payload_attributes
+data: {"version":"bellatrix","data":{"proposal_slot":"2","proposer_index":"3","parent_block_root":"0x666f6f","parent_block_number":"1","parent_block_hash":"0x666f6f","payload_attributes":{"timestamp":"24","prev_randao":"0x666f6f","suggested_fee_recipient":"0x666f6f"}}}
+
+`, w.Body.String())
+}
+
+func TestReceiveEvents_LightClientOptimisticUpdate(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan *sse.Event)
+	w := httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+	req := httptest.NewRequest("GET", "http://foo.example", &bytes.Buffer{})
+	req = req.WithContext(ctx)
+
+	go func() {
+		base64Val := "Zm9v"
+		data := &lightClientOptimisticUpdateJson{
+			AttestedHeader: &beaconBlockHeaderJson{
+				Slot:          "2",
+				ProposerIndex: "3",
+				ParentRoot:    base64Val,
+				StateRoot:     base64Val,
+				BodyRoot:      base64Val,
+			},
+			SyncAggregate: &syncAggregateJson{
+				SyncCommitteeBits:      base64Val,
+				SyncCommitteeSignature: base64Val,
+			},
+			SignatureSlot: "3",
+		}
+		bData, err := json.Marshal(data)
+		require.NoError(t, err)
+		msg := &sse.Event{
+			Data:  bData,
+			Event: []byte(events.LightClientOptimisticUpdateTopic),
+		}
+		ch <- msg
+		time.Sleep(time.Second)
+		cancel()
+	}()
+
+	errJson := receiveEvents(ch, w, req)
+	assert.Equal(t, true, errJson == nil)
+	assert.Equal(t, `event: light_client_optimistic_update
+data: {"version":"altair","data":{"attested_header":{"slot":"2","proposer_index":"3","parent_root":"0x666f6f","state_root":"0x666f6f","body_root":"0x666f6f"},"sync_aggregate":{"sync_committee_bits":"0x666f6f","sync_committee_signature":"0x666f6f"},"signature_slot":"3"}}
+
+`, w.Body.String())
+}
+
 func TestWriteEvent(t *testing.T) {
 	base64Val := "Zm9v"
 	data := &eventFinalizedCheckpointJson{
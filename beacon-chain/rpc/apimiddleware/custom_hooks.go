@@ -2,6 +2,7 @@ package apimiddleware
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,6 +11,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/api/gateway/apimiddleware"
 	"github.com/prysmaticlabs/prysm/config/params"
@@ -41,6 +43,29 @@ func wrapFeeRecipientsArray(
 	return true, nil
 }
 
+// https://ethereum.github.io/beacon-apis/#/Validator/registerValidator expects posting a top-level array.
+// We make it more proto-friendly by wrapping it in a struct with a 'registrations' field.
+func wrapSignedValidatorRegistrationsArray(
+	endpoint *apimiddleware.Endpoint,
+	_ http.ResponseWriter,
+	req *http.Request,
+) (apimiddleware.RunDefault, apimiddleware.ErrorJson) {
+	if _, ok := endpoint.PostRequest.(*signedValidatorRegistrationsRequestJson); !ok {
+		return true, nil
+	}
+	registrations := make([]*signedValidatorRegistrationJson, 0)
+	if err := json.NewDecoder(req.Body).Decode(&registrations); err != nil {
+		return false, apimiddleware.InternalServerErrorWithMessage(err, "could not decode body")
+	}
+	j := &signedValidatorRegistrationsRequestJson{Registrations: registrations}
+	b, err := json.Marshal(j)
+	if err != nil {
+		return false, apimiddleware.InternalServerErrorWithMessage(err, "could not marshal wrapped body")
+	}
+	req.Body = io.NopCloser(bytes.NewReader(b))
+	return true, nil
+}
+
 // https://ethereum.github.io/beacon-apis/#/Beacon/submitPoolAttestations expects posting a top-level array.
 // We make it more proto-friendly by wrapping it in a struct with a 'data' field.
 func wrapAttestationsArray(
@@ -195,6 +220,52 @@ func wrapSignedContributionAndProofsArray(
 	return true, nil
 }
 
+// https://ethereum.github.io/beacon-APIs/#/Beacon/getAttestationsRewards and
+// https://ethereum.github.io/beacon-APIs/#/Beacon/getSyncCommitteeRewards expect posting a top-level array
+// of validator indices or public keys. We make it more proto-friendly by wrapping it in a struct with a 'validators' field.
+// Each ID is converted to its bytes representation, which is the raw public key or the index string.
+func wrapValidatorIdsArray(
+	endpoint *apimiddleware.Endpoint,
+	_ http.ResponseWriter,
+	req *http.Request,
+) (apimiddleware.RunDefault, apimiddleware.ErrorJson) {
+	switch endpoint.PostRequest.(type) {
+	case *attestationRewardsRequestJson, *syncCommitteeRewardsRequestJson:
+	default:
+		return true, nil
+	}
+	ids := make([]string, 0)
+	// The body is optional, no IDs means all validators.
+	if err := json.NewDecoder(req.Body).Decode(&ids); err != nil && err != io.EOF {
+		return false, apimiddleware.InternalServerErrorWithMessage(err, "could not decode body")
+	}
+	validators := make([]string, len(ids))
+	for i, id := range ids {
+		b := []byte(id)
+		if strings.HasPrefix(id, "0x") {
+			var err error
+			b, err = hexutil.Decode(id)
+			if err != nil {
+				e := errors.Wrap(err, "could not decode validator public key")
+				return false, &apimiddleware.DefaultErrorJson{Message: e.Error(), Code: http.StatusBadRequest}
+			}
+		}
+		validators[i] = base64.StdEncoding.EncodeToString(b)
+	}
+	var j interface{}
+	if _, ok := endpoint.PostRequest.(*attestationRewardsRequestJson); ok {
+		j = &attestationRewardsRequestJson{Validators: validators}
+	} else {
+		j = &syncCommitteeRewardsRequestJson{Validators: validators}
+	}
+	b, err := json.Marshal(j)
+	if err != nil {
+		return false, apimiddleware.InternalServerErrorWithMessage(err, "could not marshal wrapped body")
+	}
+	req.Body = io.NopCloser(bytes.NewReader(b))
+	return true, nil
+}
+
 type phase0PublishBlockRequestJson struct {
 	Phase0Block *beaconBlockJson `json:"phase0_block"`
 	Signature   string           `json:"signature" hex:"true"`
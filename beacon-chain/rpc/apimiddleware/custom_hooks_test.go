@@ -2,6 +2,7 @@ package apimiddleware
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -245,6 +246,73 @@ func TestWrapSyncCommitteeSubscriptionsArray(t *testing.T) {
 	})
 }
 
+func TestWrapValidatorIdsArray(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		endpoint := &apimiddleware.Endpoint{
+			PostRequest: &attestationRewardsRequestJson{},
+		}
+		var body bytes.Buffer
+		_, err := body.Write([]byte(`["1","0x0102"]`))
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		runDefault, errJson := wrapValidatorIdsArray(endpoint, nil, request)
+		require.Equal(t, true, errJson == nil)
+		assert.Equal(t, apimiddleware.RunDefault(true), runDefault)
+		wrappedIds := &attestationRewardsRequestJson{}
+		require.NoError(t, json.NewDecoder(request.Body).Decode(wrappedIds))
+		require.Equal(t, 2, len(wrappedIds.Validators), "wrong number of wrapped items")
+		assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("1")), wrappedIds.Validators[0])
+		assert.Equal(t, base64.StdEncoding.EncodeToString([]byte{1, 2}), wrappedIds.Validators[1])
+	})
+
+	t.Run("empty_body", func(t *testing.T) {
+		endpoint := &apimiddleware.Endpoint{
+			PostRequest: &syncCommitteeRewardsRequestJson{},
+		}
+		request := httptest.NewRequest("POST", "http://foo.example", &bytes.Buffer{})
+
+		runDefault, errJson := wrapValidatorIdsArray(endpoint, nil, request)
+		require.Equal(t, true, errJson == nil)
+		assert.Equal(t, apimiddleware.RunDefault(true), runDefault)
+		wrappedIds := &syncCommitteeRewardsRequestJson{}
+		require.NoError(t, json.NewDecoder(request.Body).Decode(wrappedIds))
+		assert.Equal(t, 0, len(wrappedIds.Validators))
+	})
+
+	t.Run("invalid_body", func(t *testing.T) {
+		endpoint := &apimiddleware.Endpoint{
+			PostRequest: &attestationRewardsRequestJson{},
+		}
+		var body bytes.Buffer
+		_, err := body.Write([]byte("invalid"))
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		runDefault, errJson := wrapValidatorIdsArray(endpoint, nil, request)
+		require.Equal(t, false, errJson == nil)
+		assert.Equal(t, apimiddleware.RunDefault(false), runDefault)
+		assert.Equal(t, true, strings.Contains(errJson.Msg(), "could not decode body"))
+		assert.Equal(t, http.StatusInternalServerError, errJson.StatusCode())
+	})
+
+	t.Run("invalid_public_key", func(t *testing.T) {
+		endpoint := &apimiddleware.Endpoint{
+			PostRequest: &attestationRewardsRequestJson{},
+		}
+		var body bytes.Buffer
+		_, err := body.Write([]byte(`["0xzz"]`))
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		runDefault, errJson := wrapValidatorIdsArray(endpoint, nil, request)
+		require.Equal(t, false, errJson == nil)
+		assert.Equal(t, apimiddleware.RunDefault(false), runDefault)
+		assert.Equal(t, true, strings.Contains(errJson.Msg(), "could not decode validator public key"))
+		assert.Equal(t, http.StatusBadRequest, errJson.StatusCode())
+	})
+}
+
 func TestWrapSyncCommitteeSignaturesArray(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
 		endpoint := &apimiddleware.Endpoint{
@@ -362,6 +430,66 @@ func TestWrapSignedContributionAndProofsArray(t *testing.T) {
 	})
 }
 
+func TestWrapSignedValidatorRegistrationsArray(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		endpoint := &apimiddleware.Endpoint{
+			PostRequest: &signedValidatorRegistrationsRequestJson{},
+		}
+		unwrapped := []*signedValidatorRegistrationJson{
+			{
+				Message: &validatorRegistrationJson{
+					FeeRecipient: "recipient",
+					GasLimit:     "30000000",
+					Timestamp:    "1",
+					Pubkey:       "pubkey",
+				},
+				Signature: "sig",
+			},
+			{
+				Message:   &validatorRegistrationJson{},
+				Signature: "sig",
+			},
+		}
+		unwrappedJson, err := json.Marshal(unwrapped)
+		require.NoError(t, err)
+
+		var body bytes.Buffer
+		_, err = body.Write(unwrappedJson)
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		runDefault, errJson := wrapSignedValidatorRegistrationsArray(endpoint, nil, request)
+		require.Equal(t, true, errJson == nil)
+		assert.Equal(t, apimiddleware.RunDefault(true), runDefault)
+		wrapped := &signedValidatorRegistrationsRequestJson{}
+		require.NoError(t, json.NewDecoder(request.Body).Decode(wrapped))
+		require.Equal(t, 2, len(wrapped.Registrations), "wrong number of wrapped items")
+		assert.Equal(t, "sig", wrapped.Registrations[0].Signature)
+		require.NotNil(t, wrapped.Registrations[0].Message)
+		msg := wrapped.Registrations[0].Message
+		assert.Equal(t, "recipient", msg.FeeRecipient)
+		assert.Equal(t, "30000000", msg.GasLimit)
+		assert.Equal(t, "1", msg.Timestamp)
+		assert.Equal(t, "pubkey", msg.Pubkey)
+	})
+
+	t.Run("invalid_body", func(t *testing.T) {
+		endpoint := &apimiddleware.Endpoint{
+			PostRequest: &signedValidatorRegistrationsRequestJson{},
+		}
+		var body bytes.Buffer
+		_, err := body.Write([]byte("invalid"))
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		runDefault, errJson := wrapSignedValidatorRegistrationsArray(endpoint, nil, request)
+		require.Equal(t, false, errJson == nil)
+		assert.Equal(t, apimiddleware.RunDefault(false), runDefault)
+		assert.Equal(t, true, strings.Contains(errJson.Msg(), "could not decode body"))
+		assert.Equal(t, http.StatusInternalServerError, errJson.StatusCode())
+	})
+}
+
 func TestSetInitialPublishBlockPostRequest(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 	cfg := params.BeaconConfig().Copy()
@@ -39,6 +39,9 @@ func (_ *BeaconEndpointFactory) Paths() []string {
 		"/eth/v1/beacon/pool/voluntary_exits",
 		"/eth/v1/beacon/pool/sync_committees",
 		"/eth/v1/beacon/weak_subjectivity",
+		"/eth/v1/beacon/rewards/blocks/{block_id}",
+		"/eth/v1/beacon/rewards/attestations/{epoch}",
+		"/eth/v1/beacon/rewards/sync_committee/{block_id}",
 		"/eth/v1/node/identity",
 		"/eth/v1/node/peers",
 		"/eth/v1/node/peers/{peer_id}",
@@ -68,6 +71,8 @@ func (_ *BeaconEndpointFactory) Paths() []string {
 		"/eth/v1/validator/sync_committee_contribution",
 		"/eth/v1/validator/contribution_and_proofs",
 		"/eth/v1/validator/prepare_beacon_proposer",
+		"/eth/v1/validator/register_validator",
+		"/eth/v1/validator/liveness/{epoch}",
 	}
 }
 
@@ -155,6 +160,20 @@ func (_ *BeaconEndpointFactory) Create(path string) (*apimiddleware.Endpoint, er
 		}
 	case "/eth/v1/beacon/weak_subjectivity":
 		endpoint.GetResponse = &WeakSubjectivityResponse{}
+	case "/eth/v1/beacon/rewards/blocks/{block_id}":
+		endpoint.GetResponse = &blockRewardsResponseJson{}
+	case "/eth/v1/beacon/rewards/attestations/{epoch}":
+		endpoint.PostRequest = &attestationRewardsRequestJson{}
+		endpoint.PostResponse = &attestationRewardsResponseJson{}
+		endpoint.Hooks = apimiddleware.HookCollection{
+			OnPreDeserializeRequestBodyIntoContainer: wrapValidatorIdsArray,
+		}
+	case "/eth/v1/beacon/rewards/sync_committee/{block_id}":
+		endpoint.PostRequest = &syncCommitteeRewardsRequestJson{}
+		endpoint.PostResponse = &syncCommitteeRewardsResponseJson{}
+		endpoint.Hooks = apimiddleware.HookCollection{
+			OnPreDeserializeRequestBodyIntoContainer: wrapValidatorIdsArray,
+		}
 	case "/eth/v1/node/identity":
 		endpoint.GetResponse = &identityResponseJson{}
 	case "/eth/v1/node/peers":
@@ -268,6 +287,19 @@ func (_ *BeaconEndpointFactory) Create(path string) (*apimiddleware.Endpoint, er
 		endpoint.Hooks = apimiddleware.HookCollection{
 			OnPreDeserializeRequestBodyIntoContainer: wrapFeeRecipientsArray,
 		}
+	case "/eth/v1/validator/register_validator":
+		endpoint.PostRequest = &signedValidatorRegistrationsRequestJson{}
+		endpoint.Err = &indexedVerificationFailureErrorJson{}
+		endpoint.Hooks = apimiddleware.HookCollection{
+			OnPreDeserializeRequestBodyIntoContainer: wrapSignedValidatorRegistrationsArray,
+		}
+	case "/eth/v1/validator/liveness/{epoch}":
+		endpoint.PostRequest = &dutiesRequestJson{}
+		endpoint.PostResponse = &livenessResponseJson{}
+		endpoint.RequestURLLiterals = []string{"epoch"}
+		endpoint.Hooks = apimiddleware.HookCollection{
+			OnPreDeserializeRequestBodyIntoContainer: wrapValidatorIndicesArray,
+		}
 	default:
 		return nil, errors.New("invalid path")
 	}
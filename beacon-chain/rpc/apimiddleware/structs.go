@@ -35,6 +35,10 @@ type feeRecipientsRequestJSON struct {
 	Recipients []*feeRecipientJson `json:"recipients"`
 }
 
+type signedValidatorRegistrationsRequestJson struct {
+	Registrations []*signedValidatorRegistrationJson `json:"registrations"`
+}
+
 type stateRootResponseJson struct {
 	Data                *stateRootResponse_StateRootJson `json:"data"`
 	ExecutionOptimistic bool                             `json:"execution_optimistic"`
@@ -139,6 +143,29 @@ type submitSyncCommitteeSignaturesRequestJson struct {
 	Data []*syncCommitteeMessageJson `json:"data"`
 }
 
+type blockRewardsResponseJson struct {
+	Data                *blockRewardsJson `json:"data"`
+	ExecutionOptimistic bool              `json:"execution_optimistic"`
+}
+
+type attestationRewardsRequestJson struct {
+	Validators []string `json:"validators"`
+}
+
+type attestationRewardsResponseJson struct {
+	Data                *attestationRewardsJson `json:"data"`
+	ExecutionOptimistic bool                    `json:"execution_optimistic"`
+}
+
+type syncCommitteeRewardsRequestJson struct {
+	Validators []string `json:"validators"`
+}
+
+type syncCommitteeRewardsResponseJson struct {
+	Data                []*syncCommitteeRewardJson `json:"data"`
+	ExecutionOptimistic bool                       `json:"execution_optimistic"`
+}
+
 type identityResponseJson struct {
 	Data *identityJson `json:"data"`
 }
@@ -221,6 +248,10 @@ type syncCommitteeDutiesResponseJson struct {
 	ExecutionOptimistic bool                 `json:"execution_optimistic"`
 }
 
+type livenessResponseJson struct {
+	Data []*livenessJson `json:"data"`
+}
+
 type produceBlockResponseJson struct {
 	Data *beaconBlockJson `json:"data"`
 }
@@ -500,6 +531,18 @@ type feeRecipientJson struct {
 	FeeRecipient   string `json:"fee_recipient" hex:"true"`
 }
 
+type signedValidatorRegistrationJson struct {
+	Message   *validatorRegistrationJson `json:"message"`
+	Signature string                     `json:"signature" hex:"true"`
+}
+
+type validatorRegistrationJson struct {
+	FeeRecipient string `json:"fee_recipient" hex:"true"`
+	GasLimit     string `json:"gas_limit"`
+	Timestamp    string `json:"timestamp"`
+	Pubkey       string `json:"pubkey" hex:"true"`
+}
+
 type attestationJson struct {
 	AggregationBits string               `json:"aggregation_bits" hex:"true"`
 	Data            *attestationDataJson `json:"data"`
@@ -698,6 +741,41 @@ type syncCommitteeValidatorsJson struct {
 	ValidatorAggregates [][]string `json:"validator_aggregates"`
 }
 
+type blockRewardsJson struct {
+	ProposerIndex     string `json:"proposer_index"`
+	Total             string `json:"total"`
+	Attestations      string `json:"attestations"`
+	SyncAggregate     string `json:"sync_aggregate"`
+	ProposerSlashings string `json:"proposer_slashings"`
+	AttesterSlashings string `json:"attester_slashings"`
+}
+
+type attestationRewardsJson struct {
+	IdealRewards []*idealAttestationRewardsJson `json:"ideal_rewards"`
+	TotalRewards []*totalAttestationRewardsJson `json:"total_rewards"`
+}
+
+type idealAttestationRewardsJson struct {
+	EffectiveBalance string `json:"effective_balance"`
+	Head             string `json:"head"`
+	Target           string `json:"target"`
+	Source           string `json:"source"`
+	Inactivity       string `json:"inactivity"`
+}
+
+type totalAttestationRewardsJson struct {
+	ValidatorIndex string `json:"validator_index"`
+	Head           string `json:"head"`
+	Target         string `json:"target"`
+	Source         string `json:"source"`
+	Inactivity     string `json:"inactivity"`
+}
+
+type syncCommitteeRewardJson struct {
+	ValidatorIndex string `json:"validator_index"`
+	Reward         string `json:"reward"`
+}
+
 type pendingAttestationJson struct {
 	AggregationBits string               `json:"aggregation_bits" hex:"true"`
 	Data            *attestationDataJson `json:"data"`
@@ -743,6 +821,11 @@ type syncCommitteeDuty struct {
 	ValidatorSyncCommitteeIndices []string `json:"validator_sync_committee_indices"`
 }
 
+type livenessJson struct {
+	Index  string `json:"index"`
+	IsLive bool   `json:"is_live"`
+}
+
 type signedAggregateAttestationAndProofJson struct {
 	Message   *aggregateAttestationAndProofJson `json:"message"`
 	Signature string                            `json:"signature" hex:"true"`
@@ -854,6 +937,55 @@ type eventChainReorgJson struct {
 	ExecutionOptimistic bool   `json:"execution_optimistic"`
 }
 
+type eventBlockGossipJson struct {
+	Slot  string `json:"slot"`
+	Block string `json:"block" hex:"true"`
+}
+
+type eventPayloadAttributesJson struct {
+	Version string                           `json:"version"`
+	Data    *eventBasicPayloadAttributesJson `json:"data"`
+}
+
+type eventBasicPayloadAttributesJson struct {
+	ProposalSlot      string                        `json:"proposal_slot"`
+	ProposerIndex     string                        `json:"proposer_index"`
+	ParentBlockRoot   string                        `json:"parent_block_root" hex:"true"`
+	ParentBlockNumber string                        `json:"parent_block_number"`
+	ParentBlockHash   string                        `json:"parent_block_hash" hex:"true"`
+	PayloadAttributes *eventPayloadAttributesV1Json `json:"payload_attributes"`
+}
+
+type eventPayloadAttributesV1Json struct {
+	Timestamp             string `json:"timestamp"`
+	PrevRandao            string `json:"prev_randao" hex:"true"`
+	SuggestedFeeRecipient string `json:"suggested_fee_recipient" hex:"true"`
+}
+
+type eventLightClientFinalityUpdateJson struct {
+	Version string                         `json:"version"`
+	Data    *lightClientFinalityUpdateJson `json:"data"`
+}
+
+type lightClientFinalityUpdateJson struct {
+	AttestedHeader  *beaconBlockHeaderJson `json:"attested_header"`
+	FinalizedHeader *beaconBlockHeaderJson `json:"finalized_header"`
+	FinalityBranch  []string               `json:"finality_branch" hex:"true"`
+	SyncAggregate   *syncAggregateJson     `json:"sync_aggregate"`
+	SignatureSlot   string                 `json:"signature_slot"`
+}
+
+type eventLightClientOptimisticUpdateJson struct {
+	Version string                           `json:"version"`
+	Data    *lightClientOptimisticUpdateJson `json:"data"`
+}
+
+type lightClientOptimisticUpdateJson struct {
+	AttestedHeader *beaconBlockHeaderJson `json:"attested_header"`
+	SyncAggregate  *syncAggregateJson     `json:"sync_aggregate"`
+	SignatureSlot  string                 `json:"signature_slot"`
+}
+
 // ---------------
 // Error handling.
 // ---------------
@@ -0,0 +1,185 @@
+// Package checkpoint serves the data needed by other beacon nodes to initialize themselves via checkpoint sync.
+package checkpoint
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// FinalizedStatePath serves the ssz-encoded finalized state.
+	FinalizedStatePath = "/prysm/checkpoint/finalized/state"
+	// FinalizedBlockPath serves the ssz-encoded block of the finalized checkpoint, which is the block most recently
+	// applied to the finalized state.
+	FinalizedBlockPath = "/prysm/checkpoint/finalized/block"
+
+	versionHeader = "Eth-Consensus-Version"
+	octetStream   = "application/octet-stream"
+)
+
+// encoded is an ssz-encoded value of the finalized checkpoint.
+type encoded struct {
+	root    [32]byte
+	version string
+	data    []byte
+}
+
+// Server serves the finalized state and block. The ssz encoding of the block is kept for as long as the finalized
+// checkpoint does not change. The state, which is much larger, is encoded for each request and never kept in memory.
+// Responses carry the finalized block root as ETag, which is known without encoding anything, and support
+// conditional and range requests.
+type Server struct {
+	db             db.ReadOnlyDatabase
+	stateGen       stategen.StateManager
+	finalizedFetch blockchain.FinalizationFetcher
+	reconstructor  powchain.ExecutionPayloadReconstructor
+
+	blockLock sync.Mutex
+	block     *encoded
+}
+
+// NewServer creates a Server serving the finalized checkpoint tracked by the given finalization fetcher. The
+// execution payload reconstructor is used to serve blocks which are stored blinded.
+func NewServer(
+	beaconDB db.ReadOnlyDatabase,
+	stateGen stategen.StateManager,
+	ff blockchain.FinalizationFetcher,
+	reconstructor powchain.ExecutionPayloadReconstructor,
+) *Server {
+	return &Server{
+		db:             beaconDB,
+		stateGen:       stateGen,
+		finalizedFetch: ff,
+		reconstructor:  reconstructor,
+	}
+}
+
+// RegisterRoutes registers the handlers of the server on the given router.
+func (s *Server) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc(FinalizedStatePath, s.FinalizedState).Methods(http.MethodGet, http.MethodHead)
+	r.HandleFunc(FinalizedBlockPath, s.FinalizedBlock).Methods(http.MethodGet, http.MethodHead)
+}
+
+// FinalizedState serves the ssz-encoded finalized state.
+func (s *Server) FinalizedState(w http.ResponseWriter, r *http.Request) {
+	s.serve(w, r, "state", s.encodedState)
+}
+
+// FinalizedBlock serves the ssz-encoded block of the finalized checkpoint.
+func (s *Server) FinalizedBlock(w http.ResponseWriter, r *http.Request) {
+	s.serve(w, r, "block", s.encodedBlock)
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request, name string, encode func(context.Context, [32]byte) (*encoded, error)) {
+	root, err := s.finalizedRoot(r.Context())
+	if err != nil {
+		log.WithError(err).Error("Could not determine finalized block root")
+		http.Error(w, "Could not determine finalized block root", http.StatusServiceUnavailable)
+		return
+	}
+	etag := fmt.Sprintf("%q", fmt.Sprintf("%#x", root))
+	w.Header().Set("ETag", etag)
+	// The finalized checkpoint changes every epoch at most, caches must revalidate using the ETag.
+	w.Header().Set("Cache-Control", "no-cache")
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	e, err := encode(r.Context(), root)
+	if err != nil {
+		log.WithError(err).Errorf("Could not encode finalized %s", name)
+		http.Error(w, fmt.Sprintf("Could not encode finalized %s", name), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", octetStream)
+	w.Header().Set(versionHeader, e.version)
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(e.data))
+}
+
+// etagMatches reports whether the If-None-Match header lists the given ETag, so that the response can be answered
+// before the value is loaded and encoded.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, t := range strings.Split(ifNoneMatch, ",") {
+		t = strings.TrimSpace(t)
+		if t == "*" || strings.TrimPrefix(t, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// finalizedRoot returns the block root of the finalized checkpoint, which is the genesis block root before the first
+// finalization.
+func (s *Server) finalizedRoot(ctx context.Context) ([32]byte, error) {
+	cp := s.finalizedFetch.FinalizedCheckpt()
+	if cp == nil {
+		return [32]byte{}, errors.New("nil finalized checkpoint")
+	}
+	root := bytesutil.ToBytes32(cp.Root)
+	if root != params.BeaconConfig().ZeroHash {
+		return root, nil
+	}
+	return s.db.GenesisBlockRoot(ctx)
+}
+
+func (s *Server) encodedState(ctx context.Context, root [32]byte) (*encoded, error) {
+	st, err := s.stateGen.StateByRoot(ctx, root)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get state for block root %#x", root)
+	}
+	if st == nil || st.IsNil() {
+		return nil, errors.Errorf("no state for block root %#x", root)
+	}
+	data, err := st.MarshalSSZ()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal state")
+	}
+	return &encoded{root: root, version: version.String(st.Version()), data: data}, nil
+}
+
+func (s *Server) encodedBlock(ctx context.Context, root [32]byte) (*encoded, error) {
+	s.blockLock.Lock()
+	defer s.blockLock.Unlock()
+	if s.block != nil && s.block.root == root {
+		return s.block, nil
+	}
+	blk, err := s.db.Block(ctx, root)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get block with root %#x", root)
+	}
+	if err := wrapper.BeaconBlockIsNil(blk); err != nil {
+		return nil, err
+	}
+	if blk.Block().IsBlinded() {
+		if s.reconstructor == nil {
+			return nil, errors.Errorf("block with root %#x is stored without its execution payload", root)
+		}
+		blk, err = s.reconstructor.ReconstructFullBellatrixBlock(ctx, blk)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not reconstruct full execution payload of block with root %#x", root)
+		}
+	}
+	data, err := blk.MarshalSSZ()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal block")
+	}
+	s.block = &encoded{root: root, version: version.String(blk.Version()), data: data}
+	return s.block, nil
+}
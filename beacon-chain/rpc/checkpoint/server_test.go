@@ -0,0 +1,205 @@
+package checkpoint
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+type finalizedData struct {
+	root  [32]byte
+	block []byte
+	state []byte
+}
+
+func saveFinalized(t *testing.T, beaconDB db.Database, sg *stategen.State, slot types.Slot) *finalizedData {
+	ctx := context.Background()
+	b := util.NewBeaconBlock()
+	b.Block.Slot = slot
+	wsb, err := wrapper.WrappedSignedBeaconBlock(b)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, wsb))
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	sg.SaveFinalizedState(slot, root, st)
+
+	sb, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	bb, err := wsb.MarshalSSZ()
+	require.NoError(t, err)
+	return &finalizedData{root: root, block: bb, state: sb}
+}
+
+func setupServer(t *testing.T) (*Server, *mock.ChainService, db.Database, *stategen.State) {
+	beaconDB := dbtest.SetupDB(t)
+	sg := stategen.New(beaconDB)
+	chain := &mock.ChainService{}
+	return NewServer(beaconDB, sg, chain, nil), chain, beaconDB, sg
+}
+
+func request(t *testing.T, s *Server, path string, headers map[string]string) *httptest.ResponseRecorder {
+	r := mux.NewRouter()
+	s.RegisterRoutes(r)
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestServer_FinalizedStateAndBlock(t *testing.T) {
+	s, chain, beaconDB, sg := setupServer(t)
+	fd := saveFinalized(t, beaconDB, sg, 64)
+	chain.FinalizedCheckPoint = &ethpb.Checkpoint{Epoch: 2, Root: fd.root[:]}
+	etag := fmt.Sprintf("\"%#x\"", fd.root)
+
+	w := request(t, s, FinalizedStatePath, nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, true, bytes.Equal(fd.state, w.Body.Bytes()))
+	assert.Equal(t, octetStream, w.Header().Get("Content-Type"))
+	assert.Equal(t, etag, w.Header().Get("ETag"))
+	assert.Equal(t, "phase0", w.Header().Get(versionHeader))
+
+	w = request(t, s, FinalizedBlockPath, nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, true, bytes.Equal(fd.block, w.Body.Bytes()))
+	assert.Equal(t, etag, w.Header().Get("ETag"))
+
+	w = request(t, s, FinalizedStatePath, map[string]string{"If-None-Match": etag})
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Equal(t, 0, w.Body.Len())
+
+	w = request(t, s, FinalizedStatePath, map[string]string{"Range": "bytes=0-99"})
+	require.Equal(t, http.StatusPartialContent, w.Code)
+	assert.Equal(t, true, bytes.Equal(fd.state[:100], w.Body.Bytes()))
+}
+
+func TestServer_FinalizedCheckpointChanges(t *testing.T) {
+	s, chain, beaconDB, sg := setupServer(t)
+	first := saveFinalized(t, beaconDB, sg, 64)
+	chain.FinalizedCheckPoint = &ethpb.Checkpoint{Epoch: 2, Root: first.root[:]}
+	w := request(t, s, FinalizedStatePath, nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, true, bytes.Equal(first.state, w.Body.Bytes()))
+
+	second := saveFinalized(t, beaconDB, sg, 96)
+	chain.FinalizedCheckPoint = &ethpb.Checkpoint{Epoch: 3, Root: second.root[:]}
+	w = request(t, s, FinalizedStatePath, map[string]string{"If-None-Match": fmt.Sprintf("\"%#x\"", first.root)})
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, true, bytes.Equal(second.state, w.Body.Bytes()))
+	w = request(t, s, FinalizedBlockPath, nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, true, bytes.Equal(second.block, w.Body.Bytes()))
+}
+
+func TestServer_NotModifiedWithoutEncoding(t *testing.T) {
+	s, chain, _, _ := setupServer(t)
+	// Neither the block nor the state of the checkpoint are stored, a matching ETag is answered without them.
+	root := bytes.Repeat([]byte{0x01}, 32)
+	chain.FinalizedCheckPoint = &ethpb.Checkpoint{Epoch: 2, Root: root}
+	etag := fmt.Sprintf("\"%#x\"", root)
+
+	w := request(t, s, FinalizedStatePath, map[string]string{"If-None-Match": etag})
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Equal(t, etag, w.Header().Get("ETag"))
+	w = request(t, s, FinalizedBlockPath, map[string]string{"If-None-Match": "W/" + etag})
+	assert.Equal(t, http.StatusNotModified, w.Code)
+}
+
+func TestServer_BlindedBlock(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	chain := &mock.ChainService{}
+
+	payload := &enginev1.ExecutionPayload{
+		ParentHash:    make([]byte, fieldparams.RootLength),
+		FeeRecipient:  make([]byte, fieldparams.FeeRecipientLength),
+		StateRoot:     make([]byte, fieldparams.RootLength),
+		ReceiptsRoot:  make([]byte, fieldparams.RootLength),
+		LogsBloom:     make([]byte, fieldparams.LogsBloomLength),
+		PrevRandao:    make([]byte, fieldparams.RootLength),
+		BaseFeePerGas: make([]byte, fieldparams.RootLength),
+		BlockHash:     bytes.Repeat([]byte{0x02}, fieldparams.RootLength),
+		Transactions:  [][]byte{[]byte("transaction")},
+	}
+	wrappedPayload, err := wrapper.WrappedExecutionPayload(payload)
+	require.NoError(t, err)
+	header, err := wrapper.PayloadToHeader(wrappedPayload)
+	require.NoError(t, err)
+	b := util.NewBlindedBeaconBlockBellatrix()
+	b.Block.Slot = 64
+	b.Block.Body.ExecutionPayloadHeader = header
+	wsb, err := wrapper.WrappedSignedBeaconBlock(b)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, wsb))
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	chain.FinalizedCheckPoint = &ethpb.Checkpoint{Epoch: 2, Root: root[:]}
+
+	// Without a way to reconstruct the execution payload the block cannot be served.
+	w := request(t, NewServer(beaconDB, stategen.New(beaconDB), chain, nil), FinalizedBlockPath, nil)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+	engine := &mockPOW.EngineClient{
+		ExecutionPayloadByBlockHash: map[[32]byte]*enginev1.ExecutionPayload{
+			bytesutil.ToBytes32(payload.BlockHash): payload,
+		},
+	}
+	w = request(t, NewServer(beaconDB, stategen.New(beaconDB), chain, engine), FinalizedBlockPath, nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "bellatrix", w.Header().Get(versionHeader))
+	full, err := wrapper.BuildSignedBeaconBlockFromExecutionPayload(wsb, payload)
+	require.NoError(t, err)
+	want, err := full.MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, true, bytes.Equal(want, w.Body.Bytes()))
+	assert.Equal(t, uint64(1), engine.NumReconstructedPayloads)
+}
+
+func TestServer_Genesis(t *testing.T) {
+	ctx := context.Background()
+	s, chain, beaconDB, _ := setupServer(t)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveGenesisData(ctx, st))
+	root, err := beaconDB.GenesisBlockRoot(ctx)
+	require.NoError(t, err)
+	chain.FinalizedCheckPoint = &ethpb.Checkpoint{Root: make([]byte, 32)}
+
+	w := request(t, s, FinalizedBlockPath, nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, fmt.Sprintf("\"%#x\"", root), w.Header().Get("ETag"))
+	w = request(t, s, FinalizedStatePath, nil)
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestServer_MissingBlock(t *testing.T) {
+	s, chain, _, _ := setupServer(t)
+	chain.FinalizedCheckPoint = &ethpb.Checkpoint{Epoch: 2, Root: bytes.Repeat([]byte{0x01}, 32)}
+	w := request(t, s, FinalizedBlockPath, nil)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,376 @@
+package beacon
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	coreblocks "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	corehelpers "github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetBlockRewards retrieves the rewards that the proposer of the given block received for including attestations,
+// the sync aggregate and slashings. The rewards are computed by applying the block's operations to its pre-state.
+func (bs *Server) GetBlockRewards(ctx context.Context, req *ethpbv1.BlockRequest) (*ethpbv2.BlockRewardsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon.GetBlockRewards")
+	defer span.End()
+
+	blk, err := bs.blockFromBlockID(ctx, req.BlockId)
+	err = handleGetBlockError(blk, err)
+	if err != nil {
+		return nil, err
+	}
+	if blk.Version() == version.Phase0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Block rewards are not supported for Phase 0 blocks")
+	}
+	st, err := bs.blockPreState(ctx, blk)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get block pre-state: %v", err)
+	}
+
+	proposerIndex := blk.Block().ProposerIndex()
+	body := blk.Block().Body()
+	initBalance, err := st.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get proposer's balance: %v", err)
+	}
+	st, err = coreblocks.ProcessProposerSlashings(ctx, st, body.ProposerSlashings(), validators.SlashValidator)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process proposer slashings: %v", err)
+	}
+	proposerSlashingsBalance, err := st.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get proposer's balance: %v", err)
+	}
+	st, err = coreblocks.ProcessAttesterSlashings(ctx, st, body.AttesterSlashings(), validators.SlashValidator)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process attester slashings: %v", err)
+	}
+	attesterSlashingsBalance, err := st.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get proposer's balance: %v", err)
+	}
+	st, err = altair.ProcessAttestationsNoVerifySignature(ctx, st, blk)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process attestations: %v", err)
+	}
+	attestationsBalance, err := st.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get proposer's balance: %v", err)
+	}
+
+	syncAggregate, err := body.SyncAggregate()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get sync aggregate: %v", err)
+	}
+	activeBalance, err := corehelpers.TotalActiveBalance(st)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get total active balance: %v", err)
+	}
+	proposerReward, _, err := altair.SyncRewards(activeBalance)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get sync rewards: %v", err)
+	}
+
+	rewards := &ethpbv2.BlockRewards{
+		ProposerIndex:     proposerIndex,
+		ProposerSlashings: balanceIncrease(initBalance, proposerSlashingsBalance),
+		AttesterSlashings: balanceIncrease(proposerSlashingsBalance, attesterSlashingsBalance),
+		Attestations:      balanceIncrease(attesterSlashingsBalance, attestationsBalance),
+		SyncAggregate:     syncAggregate.SyncCommitteeBits.Count() * proposerReward,
+	}
+	rewards.Total = rewards.ProposerSlashings + rewards.AttesterSlashings + rewards.Attestations + rewards.SyncAggregate
+
+	isOptimistic, err := bs.isBlockOptimistic(ctx, blk)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not check if block is optimistic: %v", err)
+	}
+
+	return &ethpbv2.BlockRewardsResponse{
+		Data:                rewards,
+		ExecutionOptimistic: isOptimistic,
+	}, nil
+}
+
+// ListAttestationRewards retrieves the rewards and penalties that validators received for their attestations in the
+// given epoch. Rewards for an epoch are applied during the epoch transition at the end of the next epoch, so they only
+// become available once that epoch has finished.
+func (bs *Server) ListAttestationRewards(ctx context.Context, req *ethpbv2.AttestationRewardsRequest) (*ethpbv2.AttestationRewardsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon.ListAttestationRewards")
+	defer span.End()
+
+	if req.Epoch < params.BeaconConfig().AltairForkEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "Attestation rewards are not supported for Phase 0 epochs")
+	}
+	currentEpoch := slots.ToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	if currentEpoch < req.Epoch || currentEpoch-req.Epoch < 2 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Attestation rewards for epoch %d are not available until epoch %d, current epoch: %d",
+			req.Epoch,
+			req.Epoch+2,
+			currentEpoch,
+		)
+	}
+
+	// The state at the last slot of the following epoch is the one on which the rewards for the epoch get processed.
+	slot, err := slots.EpochEnd(req.Epoch + 1)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get end slot of epoch %d: %v", req.Epoch+1, err)
+	}
+	st, err := bs.StateFetcher.StateBySlot(ctx, slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get state for slot %d: %v", slot, err)
+	}
+	isOptimistic, err := helpers.IsOptimistic(ctx, st, bs.OptimisticModeFetcher)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not check if slot's block is optimistic: %v", err)
+	}
+
+	vals, bal, err := altair.InitializePrecomputeValidators(ctx, st)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not initialize precompute validators: %v", err)
+	}
+	vals, bal, err = altair.ProcessEpochParticipation(ctx, st, bal, vals)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process epoch participation: %v", err)
+	}
+	st, err = precompute.ProcessJustificationAndFinalizationPreCompute(st, bal)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process justification: %v", err)
+	}
+	st, vals, err = altair.ProcessInactivityScores(ctx, st, vals)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process inactivity updates: %v", err)
+	}
+
+	idealRewards, err := idealAttestationRewards(st, bal)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute ideal attestation rewards: %v", err)
+	}
+
+	deltas, err := altair.AttestationsDeltaBreakdown(st, bal, vals)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute attestation rewards: %v", err)
+	}
+	var indices []types.ValidatorIndex
+	if len(req.Validators) == 0 {
+		indices = make([]types.ValidatorIndex, len(deltas))
+		for i := range deltas {
+			indices[i] = types.ValidatorIndex(i)
+		}
+	} else {
+		indices, err = valIndicesByRequestIds(st, req.Validators)
+		if err != nil {
+			return nil, handleValContainerErr(err)
+		}
+	}
+	totalRewards := make([]*ethpbv2.TotalAttestationRewards, len(indices))
+	for i, index := range indices {
+		d := deltas[index]
+		totalRewards[i] = &ethpbv2.TotalAttestationRewards{
+			ValidatorIndex: index,
+			Head:           int64(d.HeadReward),
+			Target:         int64(d.TargetReward) - int64(d.TargetPenalty),
+			Source:         int64(d.SourceReward) - int64(d.SourcePenalty),
+			Inactivity:     -int64(d.InactivityPenalty),
+		}
+	}
+
+	return &ethpbv2.AttestationRewardsResponse{
+		Data: &ethpbv2.AttestationRewards{
+			IdealRewards: idealRewards,
+			TotalRewards: totalRewards,
+		},
+		ExecutionOptimistic: isOptimistic,
+	}, nil
+}
+
+// ListSyncCommitteeRewards retrieves the rewards and penalties that sync committee members received for the
+// sync aggregate of the given block.
+func (bs *Server) ListSyncCommitteeRewards(ctx context.Context, req *ethpbv2.SyncCommitteeRewardsRequest) (*ethpbv2.SyncCommitteeRewardsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon.ListSyncCommitteeRewards")
+	defer span.End()
+
+	blk, err := bs.blockFromBlockID(ctx, req.BlockId)
+	err = handleGetBlockError(blk, err)
+	if err != nil {
+		return nil, err
+	}
+	if blk.Version() == version.Phase0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Sync committee rewards are not supported for Phase 0 blocks")
+	}
+	st, err := bs.blockPreState(ctx, blk)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get block pre-state: %v", err)
+	}
+
+	syncAggregate, err := blk.Block().Body().SyncAggregate()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get sync aggregate: %v", err)
+	}
+	committeeIndices, _, err := currentCommitteeIndicesFromState(st)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get sync committee indices: %v", err)
+	}
+	if syncAggregate.SyncCommitteeBits.Len() > uint64(len(committeeIndices)) {
+		return nil, status.Errorf(codes.Internal, "Sync committee bits length exceeds committee length")
+	}
+	activeBalance, err := corehelpers.TotalActiveBalance(st)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get total active balance: %v", err)
+	}
+	_, participantReward, err := altair.SyncRewards(activeBalance)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get sync rewards: %v", err)
+	}
+
+	var requested map[types.ValidatorIndex]bool
+	if len(req.Validators) > 0 {
+		requestedIndices, err := valIndicesByRequestIds(st, req.Validators)
+		if err != nil {
+			return nil, handleValContainerErr(err)
+		}
+		requested = make(map[types.ValidatorIndex]bool, len(requestedIndices))
+		for _, index := range requestedIndices {
+			requested[index] = true
+		}
+	}
+
+	// A validator can be part of the sync committee more than once, in which case its rewards get aggregated.
+	rewards := make(map[types.ValidatorIndex]*ethpbv2.SyncCommitteeReward)
+	data := make([]*ethpbv2.SyncCommitteeReward, 0)
+	for i := uint64(0); i < syncAggregate.SyncCommitteeBits.Len(); i++ {
+		index := committeeIndices[i]
+		if requested != nil && !requested[index] {
+			continue
+		}
+		reward, ok := rewards[index]
+		if !ok {
+			reward = &ethpbv2.SyncCommitteeReward{ValidatorIndex: index}
+			rewards[index] = reward
+			data = append(data, reward)
+		}
+		if syncAggregate.SyncCommitteeBits.BitAt(i) {
+			reward.Reward += int64(participantReward)
+		} else {
+			reward.Reward -= int64(participantReward)
+		}
+	}
+
+	isOptimistic, err := bs.isBlockOptimistic(ctx, blk)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not check if block is optimistic: %v", err)
+	}
+
+	return &ethpbv2.SyncCommitteeRewardsResponse{
+		Data:                data,
+		ExecutionOptimistic: isOptimistic,
+	}, nil
+}
+
+// blockPreState returns the state of the block's parent advanced to the block's slot,
+// which is the state that the block's operations get applied to.
+func (bs *Server) blockPreState(ctx context.Context, blk interfaces.SignedBeaconBlock) (state.BeaconState, error) {
+	parentRoot := bytesutil.ToBytes32(blk.Block().ParentRoot())
+	st, err := bs.StateGenService.StateByRoot(ctx, parentRoot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get state for parent root %#x", parentRoot)
+	}
+	st, err = transition.ProcessSlots(ctx, st, blk.Block().Slot())
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not process slots up to %d", blk.Block().Slot())
+	}
+	return st, nil
+}
+
+func (bs *Server) isBlockOptimistic(ctx context.Context, blk interfaces.SignedBeaconBlock) (bool, error) {
+	if blk.Version() < version.Bellatrix {
+		return false, nil
+	}
+	root, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "could not get block root")
+	}
+	return bs.OptimisticModeFetcher.IsOptimisticForRoot(ctx, root)
+}
+
+// idealAttestationRewards computes the rewards of a validator that attested correctly and on time,
+// for every effective balance increment up to the maximum effective balance.
+func idealAttestationRewards(st state.BeaconState, bal *precompute.Balance) ([]*ethpbv2.IdealAttestationRewards, error) {
+	cfg := params.BeaconConfig()
+	idealVals := make([]*precompute.Validator, 0, cfg.MaxEffectiveBalance/cfg.EffectiveBalanceIncrement)
+	for effectiveBalance := cfg.EffectiveBalanceIncrement; effectiveBalance <= cfg.MaxEffectiveBalance; effectiveBalance += cfg.EffectiveBalanceIncrement {
+		idealVals = append(idealVals, &precompute.Validator{
+			IsActivePrevEpoch:            true,
+			CurrentEpochEffectiveBalance: effectiveBalance,
+			IsPrevEpochSourceAttester:    true,
+			IsPrevEpochTargetAttester:    true,
+			IsPrevEpochHeadAttester:      true,
+		})
+	}
+	deltas, err := altair.AttestationsDeltaBreakdown(st, bal, idealVals)
+	if err != nil {
+		return nil, err
+	}
+	idealRewards := make([]*ethpbv2.IdealAttestationRewards, len(deltas))
+	for i, d := range deltas {
+		idealRewards[i] = &ethpbv2.IdealAttestationRewards{
+			EffectiveBalance: idealVals[i].CurrentEpochEffectiveBalance,
+			Head:             int64(d.HeadReward),
+			Target:           int64(d.TargetReward),
+			Source:           int64(d.SourceReward),
+		}
+	}
+	return idealRewards, nil
+}
+
+// valIndicesByRequestIds returns the indices of the validators identified by the passed in IDs.
+// A validator ID could be its public key or its index. Well-formed IDs of unknown validators are ignored.
+func valIndicesByRequestIds(st state.BeaconState, validatorIds [][]byte) ([]types.ValidatorIndex, error) {
+	numVals := types.ValidatorIndex(st.NumValidators())
+	indices := make([]types.ValidatorIndex, 0, len(validatorIds))
+	for _, validatorId := range validatorIds {
+		if len(validatorId) == params.BeaconConfig().BLSPubkeyLength {
+			index, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(validatorId))
+			if ok {
+				indices = append(indices, index)
+			}
+			continue
+		}
+		index, err := strconv.ParseUint(string(validatorId), 10, 64)
+		if err != nil {
+			e := newInvalidValidatorIdError(validatorId, err)
+			return nil, &e
+		}
+		if types.ValidatorIndex(index) < numVals {
+			indices = append(indices, types.ValidatorIndex(index))
+		}
+	}
+	return indices, nil
+}
+
+// balanceIncrease returns by how much a balance went up, treating a decrease as no increase.
+func balanceIncrease(before, after uint64) uint64 {
+	if after < before {
+		return 0
+	}
+	return after - before
+}
@@ -0,0 +1,220 @@
+package beacon
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/testutil"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	mockstategen "github.com/prysmaticlabs/prysm/beacon-chain/state/stategen/mock"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	ethpbalpha "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+// rewardsTestServer returns a server that can serve the rewards of a full Altair block at slot 1, along with
+// the block and its parent state.
+func rewardsTestServer(t *testing.T) (*Server, *ethpbalpha.SignedBeaconBlockAltair, state.BeaconState) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	st, privKeys := util.DeterministicGenesisStateAltair(t, 64)
+	syncCommittee, err := altair.NextSyncCommittee(ctx, st)
+	require.NoError(t, err)
+	require.NoError(t, st.SetCurrentSyncCommittee(syncCommittee))
+	conf := util.DefaultBlockGenConfig()
+	conf.NumProposerSlashings = 1
+	conf.NumAttestations = 1
+	b, err := util.GenerateFullBlockAltair(st, privKeys, conf, 1)
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, b)
+
+	stateGen := mockstategen.NewMockService()
+	// The mock hands out the same state on every call, so give it a copy to leave the parent state untouched.
+	stateGen.StatesByRoot[bytesutil.ToBytes32(b.Block.ParentRoot)] = st.Copy()
+	return &Server{
+		BeaconDB:              beaconDB,
+		StateGenService:       stateGen,
+		OptimisticModeFetcher: &mock.ChainService{},
+	}, b, st
+}
+
+func TestServer_GetBlockRewards(t *testing.T) {
+	ctx := context.Background()
+	bs, b, st := rewardsTestServer(t)
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	resp, err := bs.GetBlockRewards(ctx, &ethpbv1.BlockRequest{BlockId: root[:]})
+	require.NoError(t, err)
+
+	totalBalance, err := helpers.TotalActiveBalance(st)
+	require.NoError(t, err)
+	proposerReward, _, err := altair.SyncRewards(totalBalance)
+	require.NoError(t, err)
+
+	data := resp.Data
+	assert.Equal(t, b.Block.ProposerIndex, data.ProposerIndex)
+	assert.Equal(t, true, data.ProposerSlashings > 0)
+	assert.Equal(t, uint64(0), data.AttesterSlashings)
+	assert.Equal(t, true, data.Attestations > 0)
+	assert.Equal(t, b.Block.Body.SyncAggregate.SyncCommitteeBits.Count()*proposerReward, data.SyncAggregate)
+	assert.Equal(t, data.ProposerSlashings+data.AttesterSlashings+data.Attestations+data.SyncAggregate, data.Total)
+	assert.Equal(t, false, resp.ExecutionOptimistic)
+}
+
+func TestServer_GetBlockRewards_Phase0(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 123
+	wsb, err := wrapper.WrappedSignedBeaconBlock(b)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, wsb))
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	bs := &Server{BeaconDB: beaconDB}
+	_, err = bs.GetBlockRewards(ctx, &ethpbv1.BlockRequest{BlockId: root[:]})
+	assert.ErrorContains(t, "not supported for Phase 0 blocks", err)
+}
+
+func TestServer_ListSyncCommitteeRewards(t *testing.T) {
+	ctx := context.Background()
+	bs, b, st := rewardsTestServer(t)
+	// Unset the first participation bit so that the corresponding member gets penalized.
+	b.Block.Body.SyncAggregate.SyncCommitteeBits.SetBitAt(0, false)
+	util.SaveBlock(t, ctx, bs.BeaconDB.(db.Database), b)
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	parentRoot := bytesutil.ToBytes32(b.Block.ParentRoot)
+	stateGen := bs.StateGenService.(*mockstategen.MockStateManager)
+
+	totalBalance, err := helpers.TotalActiveBalance(st)
+	require.NoError(t, err)
+	_, participantReward, err := altair.SyncRewards(totalBalance)
+	require.NoError(t, err)
+	committeeIndices, _, err := currentCommitteeIndicesFromState(st)
+	require.NoError(t, err)
+	want := make(map[types.ValidatorIndex]int64)
+	for i, index := range committeeIndices {
+		if i == 0 {
+			want[index] -= int64(participantReward)
+		} else {
+			want[index] += int64(participantReward)
+		}
+	}
+
+	t.Run("all members", func(t *testing.T) {
+		stateGen.StatesByRoot[parentRoot] = st.Copy()
+		resp, err := bs.ListSyncCommitteeRewards(ctx, &ethpbv2.SyncCommitteeRewardsRequest{BlockId: root[:]})
+		require.NoError(t, err)
+		require.Equal(t, len(want), len(resp.Data))
+		for _, r := range resp.Data {
+			assert.Equal(t, want[r.ValidatorIndex], r.Reward)
+		}
+		assert.Equal(t, false, resp.ExecutionOptimistic)
+	})
+	t.Run("requested members", func(t *testing.T) {
+		stateGen.StatesByRoot[parentRoot] = st.Copy()
+		vals := st.Validators()
+		resp, err := bs.ListSyncCommitteeRewards(ctx, &ethpbv2.SyncCommitteeRewardsRequest{
+			BlockId: root[:],
+			Validators: [][]byte{
+				[]byte(strconv.Itoa(int(committeeIndices[0]))),
+				vals[committeeIndices[1]].PublicKey,
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Data))
+		for _, r := range resp.Data {
+			assert.Equal(t, want[r.ValidatorIndex], r.Reward)
+		}
+	})
+	t.Run("invalid validator id", func(t *testing.T) {
+		stateGen.StatesByRoot[parentRoot] = st.Copy()
+		_, err := bs.ListSyncCommitteeRewards(ctx, &ethpbv2.SyncCommitteeRewardsRequest{
+			BlockId:    root[:],
+			Validators: [][]byte{[]byte("foo")},
+		})
+		assert.ErrorContains(t, "Invalid validator ID", err)
+	})
+}
+
+func TestServer_ListAttestationRewards(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisStateAltair(t, 64)
+	slot, err := slots.EpochEnd(1)
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	// Validator 0 did not attest, validator 1 attested to source only and all other validators attested to everything.
+	participation := make([]byte, st.NumValidators())
+	for i := range participation {
+		participation[i] = 0b111
+	}
+	participation[0] = 0
+	participation[1] = 0b1
+	require.NoError(t, st.SetPreviousParticipationBits(participation))
+
+	currentSlot := slot + 1
+	bs := &Server{
+		GenesisTimeFetcher:    &mock.ChainService{Slot: &currentSlot},
+		StateFetcher:          &testutil.MockFetcher{BeaconState: st},
+		OptimisticModeFetcher: &mock.ChainService{},
+	}
+
+	t.Run("requested validators", func(t *testing.T) {
+		resp, err := bs.ListAttestationRewards(ctx, &ethpbv2.AttestationRewardsRequest{
+			Epoch:      0,
+			Validators: [][]byte{[]byte("0"), []byte("1"), []byte("2")},
+		})
+		require.NoError(t, err)
+		maxIncrements := params.BeaconConfig().MaxEffectiveBalance / params.BeaconConfig().EffectiveBalanceIncrement
+		require.Equal(t, int(maxIncrements), len(resp.Data.IdealRewards))
+		ideal := resp.Data.IdealRewards[maxIncrements-1]
+		assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, ideal.EffectiveBalance)
+
+		require.Equal(t, 3, len(resp.Data.TotalRewards))
+		missed := resp.Data.TotalRewards[0]
+		assert.Equal(t, types.ValidatorIndex(0), missed.ValidatorIndex)
+		assert.Equal(t, int64(0), missed.Head)
+		assert.Equal(t, true, missed.Source < 0)
+		assert.Equal(t, true, missed.Target < 0)
+		sourceOnly := resp.Data.TotalRewards[1]
+		assert.Equal(t, true, sourceOnly.Source > 0)
+		assert.Equal(t, true, sourceOnly.Target < 0)
+		assert.Equal(t, int64(0), sourceOnly.Head)
+		full := resp.Data.TotalRewards[2]
+		assert.Equal(t, ideal.Head, full.Head)
+		assert.Equal(t, ideal.Target, full.Target)
+		assert.Equal(t, ideal.Source, full.Source)
+		assert.Equal(t, int64(0), full.Inactivity)
+	})
+	t.Run("all validators", func(t *testing.T) {
+		resp, err := bs.ListAttestationRewards(ctx, &ethpbv2.AttestationRewardsRequest{Epoch: 0})
+		require.NoError(t, err)
+		assert.Equal(t, st.NumValidators(), len(resp.Data.TotalRewards))
+	})
+	t.Run("epoch not finished", func(t *testing.T) {
+		_, err := bs.ListAttestationRewards(ctx, &ethpbv2.AttestationRewardsRequest{Epoch: 1})
+		assert.ErrorContains(t, "are not available until epoch 3", err)
+	})
+}
@@ -12,6 +12,7 @@ import (
 	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/proto/migration"
+	ethpbalpha "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
@@ -33,16 +34,34 @@ const (
 	ChainReorgTopic = "chain_reorg"
 	// SyncCommitteeContributionTopic represents a new sync committee contribution event topic.
 	SyncCommitteeContributionTopic = "contribution_and_proof"
+	// PayloadAttributesTopic represents a new payload attributes for execution payload building event topic.
+	PayloadAttributesTopic = "payload_attributes"
+	// BlockGossipTopic represents a new block received on gossip, before it is imported, event topic.
+	BlockGossipTopic = "block_gossip"
+	// ProposerSlashingTopic represents a new proposer slashing event topic.
+	ProposerSlashingTopic = "proposer_slashing"
+	// AttesterSlashingTopic represents a new attester slashing event topic.
+	AttesterSlashingTopic = "attester_slashing"
+	// LightClientFinalityUpdateTopic represents a new light client finality update event topic.
+	LightClientFinalityUpdateTopic = "light_client_finality_update"
+	// LightClientOptimisticUpdateTopic represents a new light client optimistic update event topic.
+	LightClientOptimisticUpdateTopic = "light_client_optimistic_update"
 )
 
 var casesHandled = map[string]bool{
-	HeadTopic:                      true,
-	BlockTopic:                     true,
-	AttestationTopic:               true,
-	VoluntaryExitTopic:             true,
-	FinalizedCheckpointTopic:       true,
-	ChainReorgTopic:                true,
-	SyncCommitteeContributionTopic: true,
+	HeadTopic:                        true,
+	BlockTopic:                       true,
+	AttestationTopic:                 true,
+	VoluntaryExitTopic:               true,
+	FinalizedCheckpointTopic:         true,
+	ChainReorgTopic:                  true,
+	SyncCommitteeContributionTopic:   true,
+	PayloadAttributesTopic:           true,
+	BlockGossipTopic:                 true,
+	ProposerSlashingTopic:            true,
+	AttesterSlashingTopic:            true,
+	LightClientFinalityUpdateTopic:   true,
+	LightClientOptimisticUpdateTopic: true,
 }
 
 // StreamEvents allows requesting all events from a set of topics defined in the Ethereum consensus API standard.
@@ -129,6 +148,19 @@ func handleBlockEvents(
 			ExecutionOptimistic: blkData.IsOptimistic,
 		}
 		return streamData(stream, BlockTopic, eventBlock)
+	case blockfeed.ReceivedGossipBlock:
+		if _, ok := requestedTopics[BlockGossipTopic]; !ok {
+			return nil
+		}
+		blkData, ok := event.Data.(*blockfeed.ReceivedGossipBlockData)
+		if !ok {
+			return nil
+		}
+		eventBlockGossip := &ethpb.EventBlockGossip{
+			Slot:  blkData.SignedBlock.Block().Slot(),
+			Block: blkData.BlockRoot[:],
+		}
+		return streamData(stream, BlockGossipTopic, eventBlockGossip)
 	default:
 		return nil
 	}
@@ -178,6 +210,26 @@ func handleBlockOperationEvents(
 		}
 		v2Data := migration.V1Alpha1SignedContributionAndProofToV2(contributionData.Contribution)
 		return streamData(stream, SyncCommitteeContributionTopic, v2Data)
+	case operation.ProposerSlashingReceived:
+		if _, ok := requestedTopics[ProposerSlashingTopic]; !ok {
+			return nil
+		}
+		slashingData, ok := event.Data.(*operation.ProposerSlashingReceivedData)
+		if !ok {
+			return nil
+		}
+		v1Data := migration.V1Alpha1ProposerSlashingToV1(slashingData.ProposerSlashing)
+		return streamData(stream, ProposerSlashingTopic, v1Data)
+	case operation.AttesterSlashingReceived:
+		if _, ok := requestedTopics[AttesterSlashingTopic]; !ok {
+			return nil
+		}
+		slashingData, ok := event.Data.(*operation.AttesterSlashingReceivedData)
+		if !ok {
+			return nil
+		}
+		v1Data := migration.V1Alpha1AttSlashingToV1(slashingData.AttesterSlashing)
+		return streamData(stream, AttesterSlashingTopic, v1Data)
 	default:
 		return nil
 	}
@@ -214,6 +266,33 @@ func handleStateEvents(
 			return nil
 		}
 		return streamData(stream, ChainReorgTopic, reorg)
+	case statefeed.PayloadAttributes:
+		if _, ok := requestedTopics[PayloadAttributesTopic]; !ok {
+			return nil
+		}
+		attributes, ok := event.Data.(*ethpb.EventPayloadAttributes)
+		if !ok {
+			return nil
+		}
+		return streamData(stream, PayloadAttributesTopic, attributes)
+	case statefeed.LightClientFinalityUpdate:
+		if _, ok := requestedTopics[LightClientFinalityUpdateTopic]; !ok {
+			return nil
+		}
+		update, ok := event.Data.(*ethpbalpha.LightClientFinalityUpdate)
+		if !ok {
+			return nil
+		}
+		return streamData(stream, LightClientFinalityUpdateTopic, update)
+	case statefeed.LightClientOptimisticUpdate:
+		if _, ok := requestedTopics[LightClientOptimisticUpdateTopic]; !ok {
+			return nil
+		}
+		update, ok := event.Data.(*ethpbalpha.LightClientOptimisticUpdate)
+		if !ok {
+			return nil
+		}
+		return streamData(stream, LightClientOptimisticUpdateTopic, update)
 	default:
 		return nil
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/proto/migration"
 	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -84,6 +85,45 @@ func TestStreamEvents_BlockEvents(t *testing.T) {
 			feed: srv.BlockNotifier.BlockFeed(),
 		})
 	})
+	t.Run(BlockGossipTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedBlock := util.HydrateSignedBeaconBlock(&eth.SignedBeaconBlock{
+			Block: &eth.BeaconBlock{
+				Slot: 8,
+			},
+		})
+		wantedBlockRoot, err := wantedBlock.Block.HashTreeRoot()
+		require.NoError(t, err)
+		genericResponse, err := anypb.New(&ethpb.EventBlockGossip{
+			Slot:  8,
+			Block: wantedBlockRoot[:],
+		})
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: BlockGossipTopic,
+			Data:  genericResponse,
+		}
+		wsb, err := wrapper.WrappedSignedBeaconBlock(wantedBlock)
+		require.NoError(t, err)
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{BlockGossipTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: blockfeed.ReceivedGossipBlock,
+				Data: &blockfeed.ReceivedGossipBlockData{
+					SignedBlock: wsb,
+					BlockRoot:   wantedBlockRoot,
+				},
+			},
+			feed: srv.BlockNotifier.BlockFeed(),
+		})
+	})
 }
 
 func TestStreamEvents_OperationsEvents(t *testing.T) {
@@ -232,6 +272,81 @@ func TestStreamEvents_OperationsEvents(t *testing.T) {
 			feed: srv.OperationNotifier.OperationFeed(),
 		})
 	})
+	t.Run(ProposerSlashingTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedSlashingV1alpha1 := &eth.ProposerSlashing{
+			Header_1: util.HydrateSignedBeaconHeader(&eth.SignedBeaconBlockHeader{
+				Header: &eth.BeaconBlockHeader{Slot: 1, ProposerIndex: 1},
+			}),
+			Header_2: util.HydrateSignedBeaconHeader(&eth.SignedBeaconBlockHeader{
+				Header: &eth.BeaconBlockHeader{Slot: 1, ProposerIndex: 1, BodyRoot: bytesutil.PadTo([]byte("body"), 32)},
+			}),
+		}
+		wantedSlashing := migration.V1Alpha1ProposerSlashingToV1(wantedSlashingV1alpha1)
+		genericResponse, err := anypb.New(wantedSlashing)
+		require.NoError(t, err)
+
+		wantedMessage := &gateway.EventSource{
+			Event: ProposerSlashingTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{ProposerSlashingTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: operation.ProposerSlashingReceived,
+				Data: &operation.ProposerSlashingReceivedData{
+					ProposerSlashing: wantedSlashingV1alpha1,
+				},
+			},
+			feed: srv.OperationNotifier.OperationFeed(),
+		})
+	})
+	t.Run(AttesterSlashingTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedSlashingV1alpha1 := &eth.AttesterSlashing{
+			Attestation_1: util.HydrateIndexedAttestation(&eth.IndexedAttestation{
+				AttestingIndices: []uint64{1, 2},
+			}),
+			Attestation_2: util.HydrateIndexedAttestation(&eth.IndexedAttestation{
+				AttestingIndices: []uint64{1, 2},
+				Data:             &eth.AttestationData{Slot: 1},
+			}),
+		}
+		wantedSlashing := migration.V1Alpha1AttSlashingToV1(wantedSlashingV1alpha1)
+		genericResponse, err := anypb.New(wantedSlashing)
+		require.NoError(t, err)
+
+		wantedMessage := &gateway.EventSource{
+			Event: AttesterSlashingTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{AttesterSlashingTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: operation.AttesterSlashingReceived,
+				Data: &operation.AttesterSlashingReceivedData{
+					AttesterSlashing: wantedSlashingV1alpha1,
+				},
+			},
+			feed: srv.OperationNotifier.OperationFeed(),
+		})
+	})
 }
 
 func TestStreamEvents_StateEvents(t *testing.T) {
@@ -335,6 +450,114 @@ func TestStreamEvents_StateEvents(t *testing.T) {
 			feed: srv.StateNotifier.StateFeed(),
 		})
 	})
+	t.Run(PayloadAttributesTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedAttributes := &ethpb.EventPayloadAttributes{
+			Version: "bellatrix",
+			Data: &ethpb.EventPayloadAttributes_BasicPayloadAttributes{
+				ProposalSlot:      9,
+				ProposerIndex:     2,
+				ParentBlockRoot:   make([]byte, 32),
+				ParentBlockNumber: 7,
+				ParentBlockHash:   make([]byte, 32),
+				PayloadAttributes: &ethpb.EventPayloadAttributes_PayloadAttributes{
+					Timestamp:             108,
+					PrevRandao:            make([]byte, 32),
+					SuggestedFeeRecipient: make([]byte, 20),
+				},
+			},
+		}
+		genericResponse, err := anypb.New(wantedAttributes)
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: PayloadAttributesTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{PayloadAttributesTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.PayloadAttributes,
+				Data: wantedAttributes,
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
+	t.Run(LightClientFinalityUpdateTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedUpdate := &eth.LightClientFinalityUpdate{
+			AttestedHeader:  util.HydrateBeaconHeader(&eth.BeaconBlockHeader{Slot: 8}),
+			FinalizedHeader: util.HydrateBeaconHeader(&eth.BeaconBlockHeader{}),
+			FinalityBranch:  [][]byte{make([]byte, 32)},
+			SyncAggregate: &eth.SyncAggregate{
+				SyncCommitteeBits:      bitfield.NewBitvector512(),
+				SyncCommitteeSignature: make([]byte, 96),
+			},
+			SignatureSlot: 9,
+		}
+		genericResponse, err := anypb.New(wantedUpdate)
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: LightClientFinalityUpdateTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{LightClientFinalityUpdateTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.LightClientFinalityUpdate,
+				Data: wantedUpdate,
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
+	t.Run(LightClientOptimisticUpdateTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedUpdate := &eth.LightClientOptimisticUpdate{
+			AttestedHeader: util.HydrateBeaconHeader(&eth.BeaconBlockHeader{Slot: 8}),
+			SyncAggregate: &eth.SyncAggregate{
+				SyncCommitteeBits:      bitfield.NewBitvector512(),
+				SyncCommitteeSignature: make([]byte, 96),
+			},
+			SignatureSlot: 9,
+		}
+		genericResponse, err := anypb.New(wantedUpdate)
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: LightClientOptimisticUpdateTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{LightClientOptimisticUpdateTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.LightClientOptimisticUpdate,
+				Data: wantedUpdate,
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
 }
 
 func TestStreamEvents_CommaSeparatedTopics(t *testing.T) {
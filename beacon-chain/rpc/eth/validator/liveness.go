@@ -0,0 +1,132 @@
+package validator
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetLiveness requests the beacon node to indicate if the given validators have been observed to be live in
+// the given epoch. A validator is live if the beacon node has seen one of its attestations included in the chain
+// for the epoch, or one of its blocks in the epoch.
+func (vs *Server) GetLiveness(ctx context.Context, req *ethpbv2.GetLivenessRequest) (*ethpbv2.GetLivenessResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.GetLiveness")
+	defer span.End()
+
+	currentEpoch := slots.ToEpoch(vs.TimeFetcher.CurrentSlot())
+	if req.Epoch > currentEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested epoch %d is in the future, current epoch is %d", req.Epoch, currentEpoch)
+	}
+
+	headState, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	numVals := types.ValidatorIndex(headState.NumValidators())
+	for _, index := range req.Index {
+		if index >= numVals {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid validator index %d", index)
+		}
+	}
+
+	participation, err := vs.epochParticipation(ctx, headState, req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	proposers, err := vs.epochProposers(ctx, req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ethpbv2.GetLivenessResponse{Data: make([]*ethpbv2.GetLivenessResponse_Liveness, len(req.Index))}
+	for i, index := range req.Index {
+		isLive := proposers[index]
+		if uint64(index) < uint64(len(participation)) && participation[index] != 0 {
+			isLive = true
+		}
+		resp.Data[i] = &ethpbv2.GetLivenessResponse_Liveness{Index: index, IsLive: isLive}
+	}
+	return resp, nil
+}
+
+// epochParticipation returns the participation flags of the validators for the given epoch, as recorded in the
+// chain. Nil is returned when the chain holds no participation for the epoch, which is the case for epochs prior
+// to Altair and for epochs without any block yet.
+func (vs *Server) epochParticipation(ctx context.Context, headState state.BeaconState, epoch types.Epoch) ([]byte, error) {
+	headEpoch := slots.ToEpoch(headState.Slot())
+	var (
+		st      state.BeaconState
+		current bool
+	)
+	switch {
+	case epoch > headEpoch:
+		return nil, nil
+	case epoch == headEpoch:
+		st, current = headState, true
+	case epoch+1 == headEpoch:
+		st = headState
+	default:
+		// Attestations of an epoch can be included until the end of the next epoch,
+		// so the participation is final in the last state of the next epoch.
+		slot, err := slots.EpochEnd(epoch + 1)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get end slot of epoch %d: %v", epoch+1, err)
+		}
+		st, err = vs.StateFetcher.State(ctx, []byte(strconv.FormatUint(uint64(slot), 10)))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get state at slot %d: %v", slot, err)
+		}
+	}
+	if st.Version() == version.Phase0 {
+		return nil, nil
+	}
+	var (
+		participation []byte
+		err           error
+	)
+	if current {
+		participation, err = st.CurrentEpochParticipation()
+	} else {
+		participation, err = st.PreviousEpochParticipation()
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get participation: %v", err)
+	}
+	return participation, nil
+}
+
+// epochProposers returns the proposers of the canonical blocks of the given epoch.
+func (vs *Server) epochProposers(ctx context.Context, epoch types.Epoch) (map[types.ValidatorIndex]bool, error) {
+	startSlot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get start slot of epoch %d: %v", epoch, err)
+	}
+	endSlot, err := slots.EpochEnd(epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get end slot of epoch %d: %v", epoch, err)
+	}
+	blks, roots, err := vs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(endSlot))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get blocks: %v", err)
+	}
+	proposers := make(map[types.ValidatorIndex]bool, len(blks))
+	for i, blk := range blks {
+		canonical, err := vs.CanonicalFetcher.IsCanonical(ctx, roots[i])
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not determine if block is canonical: %v", err)
+		}
+		if canonical {
+			proposers[blk.Block().ProposerIndex()] = true
+		}
+	}
+	return proposers, nil
+}
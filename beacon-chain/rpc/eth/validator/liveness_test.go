@@ -0,0 +1,89 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/testutil"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestGetLiveness(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbutil.SetupDB(t)
+
+	// The head state is in epoch 3. Validator 0 attested in epoch 3, validator 1 attested in epoch 2
+	// and validator 2 attested in epoch 1.
+	headSt, _ := util.DeterministicGenesisStateAltair(t, 8)
+	headSlot := params.BeaconConfig().SlotsPerEpoch.Mul(3) + 1
+	require.NoError(t, headSt.SetSlot(headSlot))
+	current := make([]byte, headSt.NumValidators())
+	current[0] = 1
+	require.NoError(t, headSt.SetCurrentParticipationBits(current))
+	previous := make([]byte, headSt.NumValidators())
+	previous[1] = 1
+	require.NoError(t, headSt.SetPreviousParticipationBits(previous))
+
+	oldSt, _ := util.DeterministicGenesisStateAltair(t, 8)
+	oldPrevious := make([]byte, oldSt.NumValidators())
+	oldPrevious[2] = 1
+	require.NoError(t, oldSt.SetPreviousParticipationBits(oldPrevious))
+
+	// Validator 3 proposed a block in epoch 2, and validator 4 proposed a block which was orphaned.
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = params.BeaconConfig().SlotsPerEpoch.Mul(2) + 5
+	blk.Block.ProposerIndex = 3
+	util.SaveBlock(t, ctx, beaconDB, blk)
+	blkRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	orphaned := util.NewBeaconBlock()
+	orphaned.Block.Slot = params.BeaconConfig().SlotsPerEpoch.Mul(2) + 6
+	orphaned.Block.ProposerIndex = 4
+	util.SaveBlock(t, ctx, beaconDB, orphaned)
+
+	currentSlot := headSlot + 1
+	vs := &Server{
+		HeadFetcher:      &mockChain.ChainService{State: headSt},
+		CanonicalFetcher: &mockChain.ChainService{CanonicalRoots: map[[32]byte]bool{blkRoot: true}},
+		TimeFetcher:      &mockChain.ChainService{Slot: &currentSlot},
+		StateFetcher:     &testutil.MockFetcher{BeaconState: oldSt},
+		BeaconDB:         beaconDB,
+	}
+	indices := []types.ValidatorIndex{0, 1, 2, 3, 4}
+	isLive := func(t *testing.T, epoch types.Epoch) []bool {
+		resp, err := vs.GetLiveness(ctx, &ethpbv2.GetLivenessRequest{Epoch: epoch, Index: indices})
+		require.NoError(t, err)
+		require.Equal(t, len(indices), len(resp.Data))
+		live := make([]bool, len(resp.Data))
+		for i, l := range resp.Data {
+			assert.Equal(t, indices[i], l.Index)
+			live[i] = l.IsLive
+		}
+		return live
+	}
+
+	t.Run("current epoch", func(t *testing.T) {
+		assert.DeepEqual(t, []bool{true, false, false, false, false}, isLive(t, 3))
+	})
+	t.Run("previous epoch", func(t *testing.T) {
+		assert.DeepEqual(t, []bool{false, true, false, true, false}, isLive(t, 2))
+	})
+	t.Run("older epoch", func(t *testing.T) {
+		assert.DeepEqual(t, []bool{false, false, true, false, false}, isLive(t, 1))
+	})
+	t.Run("future epoch", func(t *testing.T) {
+		_, err := vs.GetLiveness(ctx, &ethpbv2.GetLivenessRequest{Epoch: 4, Index: indices})
+		assert.ErrorContains(t, "is in the future", err)
+	})
+	t.Run("invalid index", func(t *testing.T) {
+		_, err := vs.GetLiveness(ctx, &ethpbv2.GetLivenessRequest{Epoch: 3, Index: []types.ValidatorIndex{8}})
+		assert.ErrorContains(t, "Invalid validator index 8", err)
+	})
+}
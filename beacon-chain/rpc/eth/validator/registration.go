@@ -0,0 +1,203 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/api/grpc"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	rpchelpers "github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/helpers"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	ethpbalpha "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	log "github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	// registrationBatchSize is the maximum number of registrations sent to the builder relays in a single request.
+	registrationBatchSize = 1000
+	// maxRegistrationTimestampDrift is how far in the future the timestamp of a registration may be.
+	maxRegistrationTimestampDrift = 10 * time.Second
+)
+
+// SubmitValidatorRegistration verifies the signed validator registrations and forwards the ones which are not
+// already known to the node to the builder relays. Registrations that are identical to, or older than, the stored
+// registration of the validator are skipped. Registrations of validators which are not in the head state yet have no
+// stored registration to compare with, so they are verified and passed on to the builder service as well.
+func (vs *Server) SubmitValidatorRegistration(
+	ctx context.Context, req *ethpbv1.SubmitValidatorRegistrationsRequest,
+) (*emptypb.Empty, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.SubmitValidatorRegistration")
+	defer span.End()
+
+	// No-op if the builder is not configured. The node should still function without a builder.
+	if vs.BlockBuilder == nil || !vs.BlockBuilder.Configured() {
+		return &emptypb.Empty{}, nil
+	}
+
+	var failures []*rpchelpers.SingleIndexedVerificationFailure
+	// The position of each pending registration in the request, used to report signature failures.
+	var positions []int
+	var pending []*ethpbalpha.SignedValidatorRegistrationV1
+	latestTimestamp := uint64(time.Now().Add(maxRegistrationTimestampDrift).Unix())
+	for i, r := range req.Registrations {
+		reg := migration.V1SignedValidatorRegistrationToV1Alpha1(r)
+		if reg.Message == nil {
+			failures = append(failures, &rpchelpers.SingleIndexedVerificationFailure{
+				Index:   i,
+				Message: "Missing registration message",
+			})
+			continue
+		}
+		if reg.Message.Timestamp > latestTimestamp {
+			failures = append(failures, &rpchelpers.SingleIndexedVerificationFailure{
+				Index:   i,
+				Message: fmt.Sprintf("Registration timestamp %d is in the future", reg.Message.Timestamp),
+			})
+			continue
+		}
+		known, err := vs.isKnownRegistration(ctx, reg.Message)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get stored registration: %v", err)
+		}
+		if known {
+			continue
+		}
+		positions = append(positions, i)
+		pending = append(pending, reg)
+	}
+
+	invalid, err := verifyRegistrationSignatures(pending)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not verify registration signatures: %v", err)
+	}
+	valid := make([]*ethpbalpha.SignedValidatorRegistrationV1, 0, len(pending))
+	for i, reg := range pending {
+		if msg, ok := invalid[i]; ok {
+			failures = append(failures, &rpchelpers.SingleIndexedVerificationFailure{
+				Index:   positions[i],
+				Message: msg,
+			})
+			continue
+		}
+		valid = append(valid, reg)
+	}
+
+	for start := 0; start < len(valid); start += registrationBatchSize {
+		end := start + registrationBatchSize
+		if end > len(valid) {
+			end = len(valid)
+		}
+		if err := vs.BlockBuilder.RegisterValidator(ctx, valid[start:end], nil /* default relays */); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not register validators with the builder: %v", err)
+		}
+	}
+	if len(valid) > 0 {
+		log.WithField("registrations", len(valid)).Debug("Forwarded validator registrations to the builder")
+	}
+
+	if len(failures) > 0 {
+		sort.Slice(failures, func(i, j int) bool {
+			return failures[i].Index < failures[j].Index
+		})
+		failuresContainer := &rpchelpers.IndexedVerificationFailure{Failures: failures}
+		err := grpc.AppendCustomErrorHeader(ctx, failuresContainer)
+		if err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"One or more registrations failed validation. Could not prepare registration failure information: %v",
+				err,
+			)
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "One or more registrations failed validation")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// isKnownRegistration returns true if the stored registration of the validator has the same content as the given
+// registration, or is more recent than it, in which case there is nothing to forward to the builder. Validators which
+// are not in the head state are never known.
+func (vs *Server) isKnownRegistration(ctx context.Context, reg *ethpbalpha.ValidatorRegistrationV1) (bool, error) {
+	index, ok := vs.HeadFetcher.HeadPublicKeyToValidatorIndex(bytesutil.ToBytes48(reg.Pubkey))
+	if !ok {
+		return false, nil
+	}
+	stored, err := vs.BeaconDB.RegistrationByValidatorID(ctx, index)
+	if errors.Is(err, kv.ErrNotFoundFeeRecipient) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(stored.Pubkey, reg.Pubkey) {
+		return false, nil
+	}
+	if stored.Timestamp > reg.Timestamp {
+		return true, nil
+	}
+	return bytes.Equal(stored.FeeRecipient, reg.FeeRecipient) && stored.GasLimit == reg.GasLimit, nil
+}
+
+// verifyRegistrationSignatures verifies the signatures of all the registrations at once, and falls back to verifying
+// them one by one if the batch fails. It returns the failure message of the invalid registrations, by position.
+func verifyRegistrationSignatures(regs []*ethpbalpha.SignedValidatorRegistrationV1) (map[int]string, error) {
+	invalid := make(map[int]string)
+	if len(regs) == 0 {
+		return invalid, nil
+	}
+	// Per spec, registrations are signed with the genesis fork version and a zero genesis validators root.
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs := make([][]byte, 0, len(regs))
+	roots := make([][32]byte, 0, len(regs))
+	pubKeys := make([]bls.PublicKey, 0, len(regs))
+	for i, reg := range regs {
+		if _, err := bls.SignatureFromBytes(reg.Signature); err != nil {
+			invalid[i] = "Incorrect registration signature: " + err.Error()
+			continue
+		}
+		pubKey, err := bls.PublicKeyFromBytes(reg.Message.Pubkey)
+		if err != nil {
+			invalid[i] = "Incorrect validator public key: " + err.Error()
+			continue
+		}
+		root, err := signing.ComputeSigningRoot(reg.Message, domain)
+		if err != nil {
+			return nil, err
+		}
+		sigs = append(sigs, reg.Signature)
+		roots = append(roots, root)
+		pubKeys = append(pubKeys, pubKey)
+	}
+	if len(sigs) == 0 {
+		return invalid, nil
+	}
+	verified, err := bls.VerifyMultipleSignatures(sigs, roots, pubKeys)
+	if err == nil && verified {
+		return invalid, nil
+	}
+	for i, reg := range regs {
+		if _, ok := invalid[i]; ok {
+			continue
+		}
+		if err := signing.VerifyRegistrationSignature(reg); err != nil {
+			invalid[i] = "Invalid registration signature: " + err.Error()
+		}
+	}
+	return invalid, nil
+}
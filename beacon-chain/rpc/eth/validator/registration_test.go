@@ -0,0 +1,150 @@
+package validator
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	grpcutil "github.com/prysmaticlabs/prysm/api/grpc"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	mockBuilder "github.com/prysmaticlabs/prysm/beacon-chain/builder/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbalpha "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/grpc"
+)
+
+func signedTestRegistration(t *testing.T, key bls.SecretKey, timestamp uint64) *ethpbv1.SubmitValidatorRegistrationsRequest_SignedValidatorRegistration {
+	msg := &ethpbalpha.ValidatorRegistrationV1{
+		FeeRecipient: make([]byte, 20),
+		GasLimit:     30000000,
+		Timestamp:    timestamp,
+		Pubkey:       key.PublicKey().Marshal(),
+	}
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil, nil)
+	require.NoError(t, err)
+	root, err := signing.ComputeSigningRoot(msg, domain)
+	require.NoError(t, err)
+	return &ethpbv1.SubmitValidatorRegistrationsRequest_SignedValidatorRegistration{
+		Message: &ethpbv1.SubmitValidatorRegistrationsRequest_ValidatorRegistration{
+			FeeRecipient: msg.FeeRecipient,
+			GasLimit:     msg.GasLimit,
+			Timestamp:    msg.Timestamp,
+			Pubkey:       msg.Pubkey,
+		},
+		Signature: key.Sign(root[:]).Marshal(),
+	}
+}
+
+func TestSubmitValidatorRegistration(t *testing.T) {
+	key1, err := bls.RandKey()
+	require.NoError(t, err)
+	key2, err := bls.RandKey()
+	require.NoError(t, err)
+	now := uint64(time.Now().Unix())
+
+	t.Run("OK", func(t *testing.T) {
+		builder := &mockBuilder.MockBuilderService{HasConfigured: true}
+		server := &Server{
+			HeadFetcher:  &mockChain.ChainService{},
+			BeaconDB:     dbutil.SetupDB(t),
+			BlockBuilder: builder,
+		}
+		req := &ethpbv1.SubmitValidatorRegistrationsRequest{
+			Registrations: []*ethpbv1.SubmitValidatorRegistrationsRequest_SignedValidatorRegistration{
+				signedTestRegistration(t, key1, now),
+				signedTestRegistration(t, key2, now),
+			},
+		}
+		_, err := server.SubmitValidatorRegistration(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(builder.Registrations))
+		assert.DeepEqual(t, key1.PublicKey().Marshal(), builder.Registrations[0].Message.Pubkey)
+		assert.DeepEqual(t, key2.PublicKey().Marshal(), builder.Registrations[1].Message.Pubkey)
+	})
+	t.Run("known registration skipped", func(t *testing.T) {
+		ctx := context.Background()
+		beaconDB := dbutil.SetupDB(t)
+		builder := &mockBuilder.MockBuilderService{HasConfigured: true}
+		// The mock chain service maps all public keys to validator index 0.
+		stored := signedTestRegistration(t, key1, now)
+		require.NoError(t, beaconDB.SaveRegistrationsByValidatorIDs(ctx, []types.ValidatorIndex{0}, []*ethpbalpha.ValidatorRegistrationV1{
+			{
+				FeeRecipient: stored.Message.FeeRecipient,
+				GasLimit:     stored.Message.GasLimit,
+				Timestamp:    stored.Message.Timestamp,
+				Pubkey:       stored.Message.Pubkey,
+			},
+		}))
+		server := &Server{
+			HeadFetcher:  &mockChain.ChainService{},
+			BeaconDB:     beaconDB,
+			BlockBuilder: builder,
+		}
+		req := &ethpbv1.SubmitValidatorRegistrationsRequest{
+			Registrations: []*ethpbv1.SubmitValidatorRegistrationsRequest_SignedValidatorRegistration{
+				signedTestRegistration(t, key1, now+1),
+				signedTestRegistration(t, key1, now-1),
+				signedTestRegistration(t, key2, now),
+			},
+		}
+		_, err := server.SubmitValidatorRegistration(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(builder.Registrations))
+		assert.DeepEqual(t, key2.PublicKey().Marshal(), builder.Registrations[0].Message.Pubkey)
+	})
+	t.Run("invalid registrations", func(t *testing.T) {
+		ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
+		builder := &mockBuilder.MockBuilderService{HasConfigured: true}
+		server := &Server{
+			HeadFetcher:  &mockChain.ChainService{},
+			BeaconDB:     dbutil.SetupDB(t),
+			BlockBuilder: builder,
+		}
+		badSig := signedTestRegistration(t, key1, now)
+		badSig.Message.GasLimit++
+		req := &ethpbv1.SubmitValidatorRegistrationsRequest{
+			Registrations: []*ethpbv1.SubmitValidatorRegistrationsRequest_SignedValidatorRegistration{
+				signedTestRegistration(t, key2, now),
+				badSig,
+				signedTestRegistration(t, key2, now+3600),
+			},
+		}
+		_, err := server.SubmitValidatorRegistration(ctx, req)
+		require.ErrorContains(t, "One or more registrations failed validation", err)
+		require.Equal(t, 1, len(builder.Registrations))
+		assert.DeepEqual(t, key2.PublicKey().Marshal(), builder.Registrations[0].Message.Pubkey)
+
+		sts, ok := grpc.ServerTransportStreamFromContext(ctx).(*runtime.ServerTransportStream)
+		require.Equal(t, true, ok, "type assertion failed")
+		v, ok := sts.Header()[strings.ToLower(grpcutil.CustomErrorMetadataKey)]
+		require.Equal(t, true, ok, "could not retrieve custom error metadata value")
+		require.Equal(t, 1, len(v))
+		assert.Equal(t, true, strings.Contains(v[0], "\"index\":1,\"message\":\"Invalid registration signature"))
+		assert.Equal(t, true, strings.Contains(v[0], "\"index\":2,\"message\":\"Registration timestamp"))
+	})
+	t.Run("builder not configured", func(t *testing.T) {
+		builder := &mockBuilder.MockBuilderService{}
+		server := &Server{
+			HeadFetcher:  &mockChain.ChainService{},
+			BeaconDB:     dbutil.SetupDB(t),
+			BlockBuilder: builder,
+		}
+		req := &ethpbv1.SubmitValidatorRegistrationsRequest{
+			Registrations: []*ethpbv1.SubmitValidatorRegistrationsRequest_SignedValidatorRegistration{
+				signedTestRegistration(t, key1, now),
+			},
+		}
+		_, err := server.SubmitValidatorRegistration(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(builder.Registrations))
+	})
+}
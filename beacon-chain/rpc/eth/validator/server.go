@@ -2,6 +2,8 @@ package validator
 
 import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/builder"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
@@ -14,6 +16,7 @@ import (
 // providing RPC endpoints intended for validator clients.
 type Server struct {
 	HeadFetcher           blockchain.HeadFetcher
+	CanonicalFetcher      blockchain.CanonicalFetcher
 	HeadUpdater           blockchain.HeadUpdater
 	TimeFetcher           blockchain.TimeFetcher
 	SyncChecker           sync.Checker
@@ -23,5 +26,7 @@ type Server struct {
 	StateFetcher          statefetcher.Fetcher
 	OptimisticModeFetcher blockchain.OptimisticModeFetcher
 	SyncCommitteePool     synccommittee.Pool
+	BeaconDB              db.ReadOnlyDatabase
 	V1Alpha1Server        *v1alpha1validator.Server
+	BlockBuilder          builder.BlockBuilder
 }
@@ -0,0 +1,222 @@
+// Package lightclient serves the light client data computed by the beacon node through the standard beacon API.
+package lightclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/lightclient"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// BootstrapPath serves the light client bootstrap of a block root.
+	BootstrapPath = "/eth/v1/beacon/light_client/bootstrap/{block_root}"
+	// UpdatesPath serves the best light client updates of a range of sync committee periods.
+	UpdatesPath = "/eth/v1/beacon/light_client/updates"
+	// FinalityUpdatePath serves the latest light client finality update.
+	FinalityUpdatePath = "/eth/v1/beacon/light_client/finality_update"
+	// OptimisticUpdatePath serves the latest light client optimistic update.
+	OptimisticUpdatePath = "/eth/v1/beacon/light_client/optimistic_update"
+
+	// MaxRequestUpdates is the maximum number of sync committee periods served by a single updates request.
+	MaxRequestUpdates = 128
+
+	versionHeader = "Eth-Consensus-Version"
+	octetStream   = "application/octet-stream"
+	jsonMediaType = "application/json"
+)
+
+// Provider gives access to the light client data of the beacon node.
+type Provider interface {
+	lightclient.UpdatesFetcher
+	Updates(ctx context.Context, startPeriod, count uint64) ([]*ethpb.LightClientUpdate, error)
+	Bootstrap(ctx context.Context, blockRoot [32]byte) (*ethpb.LightClientBootstrap, error)
+}
+
+// sszMarshaler is implemented by every light client object.
+type sszMarshaler interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+// Server serves light client bootstraps and updates as json, or ssz-encoded when requested with an
+// application/octet-stream Accept header. Ranges of updates are ssz-encoded as a sequence of response chunks, each
+// made of its length, the fork digest of the update and the update itself, as specified by the beacon API.
+type Server struct {
+	provider       Provider
+	genesisFetcher blockchain.GenesisFetcher
+}
+
+// NewServer creates a Server serving the data of the given provider. The genesis validators root given by the genesis
+// fetcher is used to compute the fork digest of ssz-encoded updates.
+func NewServer(p Provider, gf blockchain.GenesisFetcher) *Server {
+	return &Server{provider: p, genesisFetcher: gf}
+}
+
+// RegisterRoutes registers the handlers of the server on the given router.
+func (s *Server) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc(BootstrapPath, s.Bootstrap).Methods(http.MethodGet)
+	r.HandleFunc(UpdatesPath, s.Updates).Methods(http.MethodGet)
+	r.HandleFunc(FinalityUpdatePath, s.FinalityUpdate).Methods(http.MethodGet)
+	r.HandleFunc(OptimisticUpdatePath, s.OptimisticUpdate).Methods(http.MethodGet)
+}
+
+// Bootstrap serves the light client bootstrap of the block root given in the path.
+func (s *Server) Bootstrap(w http.ResponseWriter, r *http.Request) {
+	root, err := hexutil.Decode(mux.Vars(r)["block_root"])
+	if err != nil || len(root) != 32 {
+		http.Error(w, "Invalid block root", http.StatusBadRequest)
+		return
+	}
+	b, err := s.provider.Bootstrap(r.Context(), bytesutil.ToBytes32(root))
+	if err != nil {
+		writeError(w, "bootstrap", err)
+		return
+	}
+	write(w, r, b.Header.Slot, b, bootstrapToJson(b))
+}
+
+// Updates serves the best light client updates of the count sync committee periods starting at start_period.
+func (s *Server) Updates(w http.ResponseWriter, r *http.Request) {
+	startPeriod, err := strconv.ParseUint(r.URL.Query().Get("start_period"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid start_period", http.StatusBadRequest)
+		return
+	}
+	count, err := strconv.ParseUint(r.URL.Query().Get("count"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid count", http.StatusBadRequest)
+		return
+	}
+	if count > MaxRequestUpdates {
+		count = MaxRequestUpdates
+	}
+	if count > 0 && startPeriod > math.MaxUint64-(count-1) {
+		http.Error(w, "Invalid start_period, the requested range of periods overflows", http.StatusBadRequest)
+		return
+	}
+	updates, err := s.provider.Updates(r.Context(), startPeriod, count)
+	if err != nil {
+		writeError(w, "updates", err)
+		return
+	}
+	if r.Header.Get("Accept") == octetStream {
+		s.writeUpdatesSSZ(w, updates)
+		return
+	}
+	resp := make([]*versionedJson, len(updates))
+	for i, u := range updates {
+		resp[i] = &versionedJson{Version: forkName(u.AttestedHeader.Slot), Data: updateToJson(u)}
+	}
+	writeJson(w, resp)
+}
+
+// writeUpdatesSSZ serves the updates as a sequence of response chunks. Each chunk starts with its length, as a little
+// endian uint64, followed by the fork digest of the attested header of the update, and the ssz-encoded update.
+func (s *Server) writeUpdatesSSZ(w http.ResponseWriter, updates []*ethpb.LightClientUpdate) {
+	gvr := s.genesisFetcher.GenesisValidatorsRoot()
+	var buf bytes.Buffer
+	for _, u := range updates {
+		digest, err := forks.ForkDigestFromEpoch(slots.ToEpoch(u.AttestedHeader.Slot), gvr[:])
+		if err != nil {
+			log.WithError(err).Error("Could not compute light client update fork digest")
+			http.Error(w, "Could not compute light client update fork digest", http.StatusInternalServerError)
+			return
+		}
+		enc, err := u.MarshalSSZ()
+		if err != nil {
+			log.WithError(err).Error("Could not marshal light client update")
+			http.Error(w, "Could not marshal light client update", http.StatusInternalServerError)
+			return
+		}
+		chunkLen := make([]byte, 8)
+		binary.LittleEndian.PutUint64(chunkLen, uint64(len(digest)+len(enc)))
+		buf.Write(chunkLen)
+		buf.Write(digest[:])
+		buf.Write(enc)
+	}
+	w.Header().Set("Content-Type", octetStream)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		log.WithError(err).Debug("Could not write light client response")
+	}
+}
+
+// FinalityUpdate serves the latest light client finality update.
+func (s *Server) FinalityUpdate(w http.ResponseWriter, r *http.Request) {
+	u, err := s.provider.FinalityUpdate()
+	if err != nil {
+		writeError(w, "finality update", err)
+		return
+	}
+	write(w, r, u.AttestedHeader.Slot, u, finalityUpdateToJson(u))
+}
+
+// OptimisticUpdate serves the latest light client optimistic update.
+func (s *Server) OptimisticUpdate(w http.ResponseWriter, r *http.Request) {
+	u, err := s.provider.OptimisticUpdate()
+	if err != nil {
+		writeError(w, "optimistic update", err)
+		return
+	}
+	write(w, r, u.AttestedHeader.Slot, u, optimisticUpdateToJson(u))
+}
+
+// write serves obj ssz-encoded if the client accepts it, and its json representation otherwise.
+func write(w http.ResponseWriter, r *http.Request, slot types.Slot, obj sszMarshaler, data interface{}) {
+	v := forkName(slot)
+	w.Header().Set(versionHeader, v)
+	if r.Header.Get("Accept") != octetStream {
+		writeJson(w, &versionedJson{Version: v, Data: data})
+		return
+	}
+	enc, err := obj.MarshalSSZ()
+	if err != nil {
+		log.WithError(err).Error("Could not marshal light client data")
+		http.Error(w, "Could not marshal light client data", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", octetStream)
+	if _, err := w.Write(enc); err != nil {
+		log.WithError(err).Debug("Could not write light client response")
+	}
+}
+
+func writeJson(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", jsonMediaType)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.WithError(err).Debug("Could not write light client response")
+	}
+}
+
+func writeError(w http.ResponseWriter, name string, err error) {
+	if errors.Is(err, lightclient.ErrNotFound) {
+		http.Error(w, "No light client "+name+" available", http.StatusNotFound)
+		return
+	}
+	log.WithError(err).Errorf("Could not get light client %s", name)
+	http.Error(w, "Could not get light client "+name, http.StatusInternalServerError)
+}
+
+// forkName returns the name of the fork active at the given slot.
+func forkName(slot types.Slot) string {
+	if slots.ToEpoch(slot) >= params.BeaconConfig().BellatrixForkEpoch {
+		return version.String(version.Bellatrix)
+	}
+	return version.String(version.Altair)
+}
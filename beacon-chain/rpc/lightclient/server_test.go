@@ -0,0 +1,265 @@
+package lightclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/lightclient"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+type mockProvider struct {
+	finality     *ethpb.LightClientFinalityUpdate
+	optimistic   *ethpb.LightClientOptimisticUpdate
+	updates      []*ethpb.LightClientUpdate
+	bootstrap    *ethpb.LightClientBootstrap
+	startPeriod  uint64
+	count        uint64
+	requestedKey [32]byte
+}
+
+func (m *mockProvider) FinalityUpdate() (*ethpb.LightClientFinalityUpdate, error) {
+	if m.finality == nil {
+		return nil, lightclient.ErrNotFound
+	}
+	return m.finality, nil
+}
+
+func (m *mockProvider) OptimisticUpdate() (*ethpb.LightClientOptimisticUpdate, error) {
+	if m.optimistic == nil {
+		return nil, lightclient.ErrNotFound
+	}
+	return m.optimistic, nil
+}
+
+func (m *mockProvider) Updates(_ context.Context, startPeriod, count uint64) ([]*ethpb.LightClientUpdate, error) {
+	m.startPeriod, m.count = startPeriod, count
+	return m.updates, nil
+}
+
+func (m *mockProvider) Bootstrap(_ context.Context, blockRoot [32]byte) (*ethpb.LightClientBootstrap, error) {
+	m.requestedKey = blockRoot
+	if m.bootstrap == nil {
+		return nil, lightclient.ErrNotFound
+	}
+	return m.bootstrap, nil
+}
+
+func testHeader() *ethpb.BeaconBlockHeader {
+	return &ethpb.BeaconBlockHeader{
+		Slot:       10,
+		ParentRoot: bytes.Repeat([]byte{0x01}, fieldparams.RootLength),
+		StateRoot:  bytes.Repeat([]byte{0x02}, fieldparams.RootLength),
+		BodyRoot:   bytes.Repeat([]byte{0x03}, fieldparams.RootLength),
+	}
+}
+
+func testSyncAggregate() *ethpb.SyncAggregate {
+	return &ethpb.SyncAggregate{
+		SyncCommitteeBits:      make([]byte, fieldparams.SyncCommitteeLength/8),
+		SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
+	}
+}
+
+func request(t *testing.T, s *Server, path string, headers map[string]string) *httptest.ResponseRecorder {
+	r := mux.NewRouter()
+	s.RegisterRoutes(r)
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestServer_OptimisticUpdate(t *testing.T) {
+	p := &mockProvider{}
+	s := NewServer(p, &mock.ChainService{})
+
+	w := request(t, s, OptimisticUpdatePath, nil)
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	p.optimistic = &ethpb.LightClientOptimisticUpdate{
+		AttestedHeader: testHeader(),
+		SyncAggregate:  testSyncAggregate(),
+		SignatureSlot:  11,
+	}
+	w = request(t, s, OptimisticUpdatePath, nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, jsonMediaType, w.Header().Get("Content-Type"))
+	assert.Equal(t, version.String(version.Altair), w.Header().Get(versionHeader))
+	resp := &struct {
+		Version string                `json:"version"`
+		Data    *optimisticUpdateJson `json:"data"`
+	}{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	assert.Equal(t, version.String(version.Altair), resp.Version)
+	assert.Equal(t, "10", resp.Data.AttestedHeader.Slot)
+	assert.Equal(t, fmt.Sprintf("%#x", p.optimistic.AttestedHeader.StateRoot), resp.Data.AttestedHeader.StateRoot)
+	assert.Equal(t, "11", resp.Data.SignatureSlot)
+
+	w = request(t, s, OptimisticUpdatePath, map[string]string{"Accept": octetStream})
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, octetStream, w.Header().Get("Content-Type"))
+	enc, err := p.optimistic.MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, true, bytes.Equal(enc, w.Body.Bytes()))
+}
+
+func TestServer_FinalityUpdate(t *testing.T) {
+	p := &mockProvider{}
+	s := NewServer(p, &mock.ChainService{})
+
+	w := request(t, s, FinalityUpdatePath, nil)
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	branch := make([][]byte, 6)
+	for i := range branch {
+		branch[i] = bytes.Repeat([]byte{byte(i)}, fieldparams.RootLength)
+	}
+	p.finality = &ethpb.LightClientFinalityUpdate{
+		AttestedHeader:  testHeader(),
+		FinalizedHeader: testHeader(),
+		FinalityBranch:  branch,
+		SyncAggregate:   testSyncAggregate(),
+		SignatureSlot:   11,
+	}
+	w = request(t, s, FinalityUpdatePath, nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &struct {
+		Data *finalityUpdateJson `json:"data"`
+	}{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	require.Equal(t, 6, len(resp.Data.FinalityBranch))
+	assert.Equal(t, fmt.Sprintf("%#x", branch[5]), resp.Data.FinalityBranch[5])
+
+	w = request(t, s, FinalityUpdatePath, map[string]string{"Accept": octetStream})
+	require.Equal(t, http.StatusOK, w.Code)
+	enc, err := p.finality.MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, true, bytes.Equal(enc, w.Body.Bytes()))
+}
+
+func TestServer_Updates(t *testing.T) {
+	p := &mockProvider{
+		updates: []*ethpb.LightClientUpdate{
+			{
+				AttestedHeader:    testHeader(),
+				NextSyncCommittee: &ethpb.SyncCommittee{AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength)},
+				FinalizedHeader:   testHeader(),
+				SyncAggregate:     testSyncAggregate(),
+				SignatureSlot:     11,
+			},
+		},
+	}
+	s := NewServer(p, &mock.ChainService{})
+
+	w := request(t, s, UpdatesPath+"?start_period=1&count=1000", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, uint64(1), p.startPeriod)
+	assert.Equal(t, uint64(MaxRequestUpdates), p.count)
+	var resp []*versionedJson
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, 1, len(resp))
+	assert.Equal(t, version.String(version.Altair), resp[0].Version)
+
+	w = request(t, s, UpdatesPath+"?start_period=a&count=1", nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = request(t, s, UpdatesPath+"?start_period=1", nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = request(t, s, UpdatesPath+fmt.Sprintf("?start_period=%d&count=2", uint64(math.MaxUint64)), nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = request(t, s, UpdatesPath+fmt.Sprintf("?start_period=%d&count=1", uint64(math.MaxUint64)), nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestServer_UpdatesSSZ(t *testing.T) {
+	gvr := [32]byte{'a'}
+	pubkeys := make([][]byte, fieldparams.SyncCommitteeLength)
+	for i := range pubkeys {
+		pubkeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	branch := func(depth int) [][]byte {
+		b := make([][]byte, depth)
+		for i := range b {
+			b[i] = make([]byte, fieldparams.RootLength)
+		}
+		return b
+	}
+	u := &ethpb.LightClientUpdate{
+		AttestedHeader: testHeader(),
+		NextSyncCommittee: &ethpb.SyncCommittee{
+			Pubkeys:         pubkeys,
+			AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength),
+		},
+		NextSyncCommitteeBranch: branch(5),
+		FinalizedHeader:         testHeader(),
+		FinalityBranch:          branch(6),
+		SyncAggregate:           testSyncAggregate(),
+		SignatureSlot:           11,
+	}
+	p := &mockProvider{updates: []*ethpb.LightClientUpdate{u, u}}
+	s := NewServer(p, &mock.ChainService{ValidatorsRoot: gvr})
+
+	w := request(t, s, UpdatesPath+"?start_period=1&count=2", map[string]string{"Accept": octetStream})
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, octetStream, w.Header().Get("Content-Type"))
+	enc, err := u.MarshalSSZ()
+	require.NoError(t, err)
+	digest, err := forks.ForkDigestFromEpoch(slots.ToEpoch(u.AttestedHeader.Slot), gvr[:])
+	require.NoError(t, err)
+	body := w.Body.Bytes()
+	for i := 0; i < 2; i++ {
+		require.Equal(t, true, len(body) >= 8)
+		chunkLen := binary.LittleEndian.Uint64(body[:8])
+		require.Equal(t, uint64(len(digest)+len(enc)), chunkLen)
+		chunk := body[8 : 8+chunkLen]
+		assert.DeepEqual(t, digest[:], chunk[:4])
+		assert.DeepEqual(t, enc, chunk[4:])
+		body = body[8+chunkLen:]
+	}
+	assert.Equal(t, 0, len(body))
+}
+
+func TestServer_Bootstrap(t *testing.T) {
+	p := &mockProvider{}
+	s := NewServer(p, &mock.ChainService{})
+	root := [32]byte{0xaa}
+
+	w := request(t, s, fmt.Sprintf("/eth/v1/beacon/light_client/bootstrap/%#x", root), nil)
+	require.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, root, p.requestedKey)
+
+	w = request(t, s, "/eth/v1/beacon/light_client/bootstrap/0xaa", nil)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	p.bootstrap = &ethpb.LightClientBootstrap{
+		Header:                     testHeader(),
+		CurrentSyncCommittee:       &ethpb.SyncCommittee{AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength)},
+		CurrentSyncCommitteeBranch: [][]byte{make([]byte, fieldparams.RootLength)},
+	}
+	w = request(t, s, fmt.Sprintf("/eth/v1/beacon/light_client/bootstrap/%#x", root), nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &struct {
+		Data *bootstrapJson `json:"data"`
+	}{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	assert.Equal(t, "10", resp.Data.Header.Slot)
+	require.Equal(t, 1, len(resp.Data.CurrentSyncCommitteeBranch))
+}
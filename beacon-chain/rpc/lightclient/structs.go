@@ -0,0 +1,132 @@
+package lightclient
+
+import (
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+type beaconBlockHeaderJson struct {
+	Slot          string `json:"slot"`
+	ProposerIndex string `json:"proposer_index"`
+	ParentRoot    string `json:"parent_root"`
+	StateRoot     string `json:"state_root"`
+	BodyRoot      string `json:"body_root"`
+}
+
+type syncCommitteeJson struct {
+	Pubkeys         []string `json:"pubkeys"`
+	AggregatePubkey string   `json:"aggregate_pubkey"`
+}
+
+type syncAggregateJson struct {
+	SyncCommitteeBits      string `json:"sync_committee_bits"`
+	SyncCommitteeSignature string `json:"sync_committee_signature"`
+}
+
+type bootstrapJson struct {
+	Header                     *beaconBlockHeaderJson `json:"header"`
+	CurrentSyncCommittee       *syncCommitteeJson     `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []string               `json:"current_sync_committee_branch"`
+}
+
+type updateJson struct {
+	AttestedHeader          *beaconBlockHeaderJson `json:"attested_header"`
+	NextSyncCommittee       *syncCommitteeJson     `json:"next_sync_committee"`
+	NextSyncCommitteeBranch []string               `json:"next_sync_committee_branch"`
+	FinalizedHeader         *beaconBlockHeaderJson `json:"finalized_header"`
+	FinalityBranch          []string               `json:"finality_branch"`
+	SyncAggregate           *syncAggregateJson     `json:"sync_aggregate"`
+	SignatureSlot           string                 `json:"signature_slot"`
+}
+
+type finalityUpdateJson struct {
+	AttestedHeader  *beaconBlockHeaderJson `json:"attested_header"`
+	FinalizedHeader *beaconBlockHeaderJson `json:"finalized_header"`
+	FinalityBranch  []string               `json:"finality_branch"`
+	SyncAggregate   *syncAggregateJson     `json:"sync_aggregate"`
+	SignatureSlot   string                 `json:"signature_slot"`
+}
+
+type optimisticUpdateJson struct {
+	AttestedHeader *beaconBlockHeaderJson `json:"attested_header"`
+	SyncAggregate  *syncAggregateJson     `json:"sync_aggregate"`
+	SignatureSlot  string                 `json:"signature_slot"`
+}
+
+// versionedJson is the envelope of every light client object served as json.
+type versionedJson struct {
+	Version string      `json:"version"`
+	Data    interface{} `json:"data"`
+}
+
+func headerToJson(h *ethpb.BeaconBlockHeader) *beaconBlockHeaderJson {
+	return &beaconBlockHeaderJson{
+		Slot:          strconv.FormatUint(uint64(h.Slot), 10),
+		ProposerIndex: strconv.FormatUint(uint64(h.ProposerIndex), 10),
+		ParentRoot:    hexutil.Encode(h.ParentRoot),
+		StateRoot:     hexutil.Encode(h.StateRoot),
+		BodyRoot:      hexutil.Encode(h.BodyRoot),
+	}
+}
+
+func syncCommitteeToJson(c *ethpb.SyncCommittee) *syncCommitteeJson {
+	return &syncCommitteeJson{
+		Pubkeys:         branchToJson(c.Pubkeys),
+		AggregatePubkey: hexutil.Encode(c.AggregatePubkey),
+	}
+}
+
+func syncAggregateToJson(a *ethpb.SyncAggregate) *syncAggregateJson {
+	return &syncAggregateJson{
+		SyncCommitteeBits:      hexutil.Encode(a.SyncCommitteeBits),
+		SyncCommitteeSignature: hexutil.Encode(a.SyncCommitteeSignature),
+	}
+}
+
+func branchToJson(branch [][]byte) []string {
+	nodes := make([]string, len(branch))
+	for i, node := range branch {
+		nodes[i] = hexutil.Encode(node)
+	}
+	return nodes
+}
+
+func bootstrapToJson(b *ethpb.LightClientBootstrap) *bootstrapJson {
+	return &bootstrapJson{
+		Header:                     headerToJson(b.Header),
+		CurrentSyncCommittee:       syncCommitteeToJson(b.CurrentSyncCommittee),
+		CurrentSyncCommitteeBranch: branchToJson(b.CurrentSyncCommitteeBranch),
+	}
+}
+
+func updateToJson(u *ethpb.LightClientUpdate) *updateJson {
+	return &updateJson{
+		AttestedHeader:          headerToJson(u.AttestedHeader),
+		NextSyncCommittee:       syncCommitteeToJson(u.NextSyncCommittee),
+		NextSyncCommitteeBranch: branchToJson(u.NextSyncCommitteeBranch),
+		FinalizedHeader:         headerToJson(u.FinalizedHeader),
+		FinalityBranch:          branchToJson(u.FinalityBranch),
+		SyncAggregate:           syncAggregateToJson(u.SyncAggregate),
+		SignatureSlot:           strconv.FormatUint(uint64(u.SignatureSlot), 10),
+	}
+}
+
+func finalityUpdateToJson(u *ethpb.LightClientFinalityUpdate) *finalityUpdateJson {
+	return &finalityUpdateJson{
+		AttestedHeader:  headerToJson(u.AttestedHeader),
+		FinalizedHeader: headerToJson(u.FinalizedHeader),
+		FinalityBranch:  branchToJson(u.FinalityBranch),
+		SyncAggregate:   syncAggregateToJson(u.SyncAggregate),
+		SignatureSlot:   strconv.FormatUint(uint64(u.SignatureSlot), 10),
+	}
+}
+
+func optimisticUpdateToJson(u *ethpb.LightClientOptimisticUpdate) *optimisticUpdateJson {
+	return &optimisticUpdateJson{
+		AttestedHeader: headerToJson(u.AttestedHeader),
+		SyncAggregate:  syncAggregateToJson(u.SyncAggregate),
+		SignatureSlot:  strconv.FormatUint(uint64(u.SignatureSlot), 10),
+	}
+}
@@ -0,0 +1,65 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListPeerScores returns the score breakdown of all the peers known to the host node.
+func (ds *Server) ListPeerScores(_ context.Context, _ *empty.Empty) (*ethpb.PeerScoreBreakdowns, error) {
+	var breakdowns []*ethpb.PeerScoreBreakdown
+	for _, pid := range ds.PeersFetcher.Peers().All() {
+		breakdowns = append(breakdowns, ds.peerScoreBreakdown(pid))
+	}
+	return &ethpb.PeerScoreBreakdowns{Breakdowns: breakdowns}, nil
+}
+
+// GetPeerScore returns the score breakdown of the peer defined by the provided peer id.
+func (ds *Server) GetPeerScore(_ context.Context, req *ethpb.PeerScoreRequest) (*ethpb.PeerScoreBreakdown, error) {
+	pid, err := peer.Decode(req.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", err)
+	}
+	if _, err := ds.PeersFetcher.Peers().ConnectionState(pid); err != nil {
+		return nil, status.Errorf(codes.NotFound, "Requested peer does not exist: %v", err)
+	}
+	return ds.peerScoreBreakdown(pid), nil
+}
+
+// ListPeerBans returns the most recent peers found bad by the host node, most recent first.
+func (ds *Server) ListPeerBans(_ context.Context, _ *empty.Empty) (*ethpb.PeerBans, error) {
+	history := ds.PeersFetcher.Peers().BanHistory()
+	bans := make([]*ethpb.PeerBan, len(history))
+	for i, b := range history {
+		bans[i] = &ethpb.PeerBan{
+			PeerId:    b.PeerID.String(),
+			Scorer:    b.Scorer,
+			Reason:    b.Reason,
+			Score:     b.Score,
+			Timestamp: uint64(b.Time.Unix()),
+		}
+	}
+	return &ethpb.PeerBans{Bans: bans}, nil
+}
+
+func (ds *Server) peerScoreBreakdown(pid peer.ID) *ethpb.PeerScoreBreakdown {
+	scorers := ds.PeersFetcher.Peers().Scorers()
+	b := scorers.ScoreBreakdown(pid)
+	badScorer, badReason := scorers.BadPeerReason(pid)
+	return &ethpb.PeerScoreBreakdown{
+		PeerId:             pid.String(),
+		OverallScore:       b.Total,
+		BadResponsesScore:  b.BadResponses,
+		BlockProviderScore: b.BlockProvider,
+		PeerStatusScore:    b.PeerStatus,
+		GossipScore:        b.Gossip,
+		Gossip:             scorers.GossipScorer().GossipScoreBreakdown(pid),
+		BadScorer:          badScorer,
+		BadReason:          badReason,
+	}
+}
@@ -0,0 +1,68 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDebugServer_GetPeerScore(t *testing.T) {
+	peersProvider := &mockP2p.MockPeersProvider{}
+	ds := &Server{PeersFetcher: peersProvider}
+	pid := peersProvider.Peers().All()[0]
+	peersProvider.Peers().Scorers().GossipScorer().SetGossipData(pid, 5, 0, nil)
+	breakdown := &ethpb.GossipScoreBreakdown{
+		Score:  5,
+		Topics: []*ethpb.TopicScoreBreakdown{{Topic: "topic", Score: 5, TimeInMeshScore: 10}},
+	}
+	peersProvider.Peers().Scorers().GossipScorer().SetGossipScoreBreakdown(pid, breakdown)
+
+	res, err := ds.GetPeerScore(context.Background(), &ethpb.PeerScoreRequest{PeerId: pid.String()})
+	require.NoError(t, err)
+	assert.Equal(t, pid.String(), res.PeerId)
+	assert.Equal(t, peersProvider.Peers().Scorers().Score(pid), res.OverallScore)
+	assert.Equal(t, peersProvider.Peers().Scorers().ScoreBreakdown(pid).Gossip, res.GossipScore)
+	assert.DeepEqual(t, breakdown, res.Gossip)
+	assert.Equal(t, "", res.BadScorer)
+
+	_, err = ds.GetPeerScore(context.Background(), &ethpb.PeerScoreRequest{PeerId: "foo"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ds.GetPeerScore(context.Background(), &ethpb.PeerScoreRequest{PeerId: "16Uiu2HAmHcdYnknXWKgZqPKK6ihRhQBEdxoNT1UUnYL3gYLhZdzU"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestDebugServer_ListPeerScoresAndBans(t *testing.T) {
+	peersProvider := &mockP2p.MockPeersProvider{}
+	ds := &Server{PeersFetcher: peersProvider}
+	pid := peersProvider.Peers().All()[0]
+
+	res, err := ds.ListPeerScores(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(res.Breakdowns))
+
+	bans, err := ds.ListPeerBans(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(bans.Bans))
+
+	for i := 0; i < peersProvider.Peers().Scorers().BadResponsesScorer().Params().Threshold; i++ {
+		peersProvider.Peers().Scorers().BadResponsesScorer().Increment(pid)
+	}
+	bans, err = ds.ListPeerBans(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(bans.Bans))
+	assert.Equal(t, pid.String(), bans.Bans[0].PeerId)
+	assert.Equal(t, scorers.BadResponsesScorerName, bans.Bans[0].Scorer)
+	assert.NotEqual(t, uint64(0), bans.Bans[0].Timestamp)
+
+	score, err := ds.GetPeerScore(context.Background(), &ethpb.PeerScoreRequest{PeerId: pid.String()})
+	require.NoError(t, err)
+	assert.Equal(t, scorers.BadResponsesScorerName, score.BadScorer)
+}
@@ -0,0 +1,73 @@
+package node
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListPeerAccess returns the runtime peer allowlist and denylist.
+func (ns *Server) ListPeerAccess(_ context.Context, _ *empty.Empty) (*ethpb.PeerAccessLists, error) {
+	return ns.peerAccessLists(), nil
+}
+
+// AddPeerAccess adds entries to the peer allowlist or denylist. Connected peers matching new
+// denylist entries are disconnected.
+func (ns *Server) AddPeerAccess(_ context.Context, req *ethpb.PeerAccessRequest) (*ethpb.PeerAccessLists, error) {
+	entries := make([]p2p.AccessEntry, len(req.Entries))
+	for i, e := range req.Entries {
+		entries[i] = p2p.AccessEntry{Target: e.Target, Trusted: e.Trusted}
+	}
+	disconnected, err := ns.PeerAccessManager.AddAccessEntries(accessList(req.List), entries)
+	if errors.Is(err, p2p.ErrInvalidAccessEntry) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not add peer access entries: %v", err)
+	}
+	res := ns.peerAccessLists()
+	for _, pid := range disconnected {
+		res.DisconnectedPeers = append(res.DisconnectedPeers, pid.String())
+	}
+	return res, nil
+}
+
+// RemovePeerAccess removes entries from the peer allowlist or denylist.
+func (ns *Server) RemovePeerAccess(_ context.Context, req *ethpb.PeerAccessRequest) (*ethpb.PeerAccessLists, error) {
+	targets := make([]string, len(req.Entries))
+	for i, e := range req.Entries {
+		targets[i] = e.Target
+	}
+	if err := ns.PeerAccessManager.RemoveAccessEntries(accessList(req.List), targets); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not remove peer access entries: %v", err)
+	}
+	return ns.peerAccessLists(), nil
+}
+
+func (ns *Server) peerAccessLists() *ethpb.PeerAccessLists {
+	allow, deny := ns.PeerAccessManager.AccessLists()
+	return &ethpb.PeerAccessLists{
+		Allowlist: peerAccessEntries(allow),
+		Denylist:  peerAccessEntries(deny),
+	}
+}
+
+func peerAccessEntries(entries []p2p.AccessEntry) []*ethpb.PeerAccessEntry {
+	res := make([]*ethpb.PeerAccessEntry, len(entries))
+	for i, e := range entries {
+		res[i] = &ethpb.PeerAccessEntry{Target: e.Target, Trusted: e.Trusted}
+	}
+	return res
+}
+
+func accessList(list ethpb.PeerAccessRequest_List) p2p.AccessList {
+	if list == ethpb.PeerAccessRequest_DENYLIST {
+		return p2p.DenyList
+	}
+	return p2p.AllowList
+}
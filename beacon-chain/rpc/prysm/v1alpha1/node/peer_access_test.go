@@ -0,0 +1,93 @@
+package node
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type mockPeerAccessManager struct {
+	allow        []p2p.AccessEntry
+	deny         []p2p.AccessEntry
+	disconnected []peer.ID
+	err          error
+}
+
+func (m *mockPeerAccessManager) AccessLists() (allow, deny []p2p.AccessEntry) {
+	return m.allow, m.deny
+}
+
+func (m *mockPeerAccessManager) AddAccessEntries(list p2p.AccessList, entries []p2p.AccessEntry) ([]peer.ID, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	if list == p2p.DenyList {
+		m.deny = append(m.deny, entries...)
+		return m.disconnected, nil
+	}
+	m.allow = append(m.allow, entries...)
+	return nil, nil
+}
+
+func (m *mockPeerAccessManager) RemoveAccessEntries(list p2p.AccessList, targets []string) error {
+	if m.err != nil {
+		return m.err
+	}
+	if list == p2p.DenyList {
+		m.deny = nil
+	} else {
+		m.allow = nil
+	}
+	return nil
+}
+
+func TestNodeServer_PeerAccess(t *testing.T) {
+	pid, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	m := &mockPeerAccessManager{disconnected: []peer.ID{pid}}
+	ns := &Server{PeerAccessManager: m}
+
+	res, err := ns.AddPeerAccess(context.Background(), &ethpb.PeerAccessRequest{
+		List:    ethpb.PeerAccessRequest_ALLOWLIST,
+		Entries: []*ethpb.PeerAccessEntry{{Target: "10.0.0.0/8"}},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*ethpb.PeerAccessEntry{{Target: "10.0.0.0/8"}}, res.Allowlist)
+	assert.Equal(t, 0, len(res.DisconnectedPeers))
+
+	res, err = ns.AddPeerAccess(context.Background(), &ethpb.PeerAccessRequest{
+		List:    ethpb.PeerAccessRequest_DENYLIST,
+		Entries: []*ethpb.PeerAccessEntry{{Target: pid.String()}},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*ethpb.PeerAccessEntry{{Target: pid.String()}}, res.Denylist)
+	assert.DeepEqual(t, []string{pid.String()}, res.DisconnectedPeers)
+
+	res, err = ns.RemovePeerAccess(context.Background(), &ethpb.PeerAccessRequest{
+		List:    ethpb.PeerAccessRequest_DENYLIST,
+		Entries: []*ethpb.PeerAccessEntry{{Target: pid.String()}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Denylist))
+
+	res, err = ns.ListPeerAccess(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(res.Allowlist))
+	assert.Equal(t, 0, len(res.Denylist))
+}
+
+func TestNodeServer_AddPeerAccess_InvalidEntry(t *testing.T) {
+	ns := &Server{PeerAccessManager: &mockPeerAccessManager{err: p2p.ErrInvalidAccessEntry}}
+	_, err := ns.AddPeerAccess(context.Background(), &ethpb.PeerAccessRequest{
+		Entries: []*ethpb.PeerAccessEntry{{Target: "foo"}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
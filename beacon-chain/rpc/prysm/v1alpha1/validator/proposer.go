@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	emptypb "github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
+	builderapi "github.com/prysmaticlabs/prysm/api/client/builder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
@@ -23,6 +24,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -194,14 +196,20 @@ func (vs *Server) SubmitValidatorRegistration(ctx context.Context, reg *ethpb.Si
 	return vs.SubmitValidatorRegistrations(ctx, &ethpb.SignedValidatorRegistrationsV1{Messages: []*ethpb.SignedValidatorRegistrationV1{reg}})
 }
 
-// SubmitValidatorRegistrations submits validator registrations.
+// SubmitValidatorRegistrations submits validator registrations. The validators are registered with the relays listed
+// in the request metadata, which the validator client takes from their proposer settings, or with the default relays
+// of the node if there are none.
 func (vs *Server) SubmitValidatorRegistrations(ctx context.Context, reg *ethpb.SignedValidatorRegistrationsV1) (*emptypb.Empty, error) {
 	// No-op is the builder is nil / not configured. The node should still function without a builder.
 	if vs.BlockBuilder == nil || !vs.BlockBuilder.Configured() {
 		return &emptypb.Empty{}, nil
 	}
 
-	if err := vs.BlockBuilder.RegisterValidator(ctx, reg.Messages); err != nil {
+	var relays []string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		relays = md.Get(builderapi.RelaysMetadataKey)
+	}
+	if err := vs.BlockBuilder.RegisterValidator(ctx, reg.Messages, relays); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Could not register block builder: %v", err)
 	}
 
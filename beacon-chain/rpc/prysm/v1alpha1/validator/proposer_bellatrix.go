@@ -3,8 +3,11 @@ package validator
 import (
 	"context"
 	"fmt"
+	"math"
+	"math/big"
 	"time"
 
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -23,11 +26,38 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-// builderGetPayloadMissCount tracks the number of misses when validator tries to get a payload from builder
-var builderGetPayloadMissCount = promauto.NewCounter(prometheus.CounterOpts{
-	Name: "builder_get_payload_miss_count",
-	Help: "The number of get payload misses for validator requests to builder",
-})
+const (
+	payloadSourceBuilder = "builder"
+	payloadSourceLocal   = "local"
+)
+
+var (
+	// builderGetPayloadMissCount tracks the number of misses when validator tries to get a payload from builder
+	builderGetPayloadMissCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "builder_get_payload_miss_count",
+		Help: "The number of get payload misses for validator requests to builder",
+	})
+	// builderCircuitBreakerCount tracks the number of proposals for which the builder circuit breaker was active.
+	builderCircuitBreakerCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "builder_circuit_breaker_count",
+		Help: "The number of block proposals which fell back to local execution due to the builder circuit breaker",
+	})
+	// payloadSourceCount tracks whether proposed blocks used the builder or the local execution payload.
+	payloadSourceCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "proposer_payload_source_count",
+		Help: "The number of proposed blocks by execution payload source (builder or local)",
+	}, []string{"source"})
+	// builderBidValueGwei tracks the value of the last builder bid compared against a local payload.
+	builderBidValueGwei = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "proposer_builder_bid_value_gwei",
+		Help: "The value of the last builder bid compared against the local payload, in gwei",
+	})
+	// localPayloadValueGwei tracks the estimated value of the last local payload compared against a builder bid.
+	localPayloadValueGwei = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "proposer_local_payload_value_gwei",
+		Help: "The estimated value of the last local payload compared against a builder bid, in gwei",
+	})
+)
 
 // blockBuilderTimeout is the maximum amount of time allowed for a block builder to respond to a
 // block request. This value is known as `BUILDER_PROPOSAL_DELAY_TOLERANCE` in builder spec.
@@ -39,16 +69,19 @@ func (vs *Server) getBellatrixBeaconBlock(ctx context.Context, req *ethpb.BlockR
 		return nil, err
 	}
 
+	var builderBlk *ethpb.GenericBeaconBlock
+	var builderValue *big.Int
 	registered, err := vs.validatorRegistered(ctx, altairBlk.ProposerIndex)
 	if registered && err == nil {
-		builderReady, b, err := vs.getAndBuildHeaderBlock(ctx, altairBlk)
+		builderReady, b, value, err := vs.getAndBuildHeaderBlock(ctx, altairBlk)
 		if err != nil {
 			// In the event of an error, the node should fall back to default execution engine for building block.
 			log.WithError(err).Error("Failed to build a block from external builder, falling " +
 				"back to local execution client")
 			builderGetPayloadMissCount.Inc()
 		} else if builderReady {
-			return b, nil
+			builderBlk = b
+			builderValue = value
 		}
 	} else if err != nil {
 		log.WithFields(logrus.Fields{
@@ -58,8 +91,25 @@ func (vs *Server) getBellatrixBeaconBlock(ctx context.Context, req *ethpb.BlockR
 	}
 	payload, err := vs.getExecutionPayload(ctx, req.Slot, altairBlk.ProposerIndex)
 	if err != nil {
+		if builderBlk != nil {
+			log.WithError(err).Error("Could not get payload from local execution client, using builder block")
+			payloadSourceCount.WithLabelValues(payloadSourceBuilder).Inc()
+			return builderBlk, nil
+		}
 		return nil, err
 	}
+	if builderBlk != nil {
+		useBuilder, err := builderBidBeatsLocalPayload(req.Slot, builderValue, payload)
+		if err != nil {
+			log.WithError(err).Error("Could not compare builder bid with local payload, using builder block")
+			useBuilder = true
+		}
+		if useBuilder {
+			payloadSourceCount.WithLabelValues(payloadSourceBuilder).Inc()
+			return builderBlk, nil
+		}
+	}
+	payloadSourceCount.WithLabelValues(payloadSourceLocal).Inc()
 
 	blk := &ethpb.BeaconBlockBellatrix{
 		Slot:          altairBlk.Slot,
@@ -95,9 +145,9 @@ func (vs *Server) getBellatrixBeaconBlock(ctx context.Context, req *ethpb.BlockR
 	return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Bellatrix{Bellatrix: blk}}, nil
 }
 
-// This function retrieves the payload header given the slot number and the validator index.
+// This function retrieves the builder bid given the slot number and the validator index.
 // It's a no-op if the latest head block is not versioned bellatrix.
-func (vs *Server) getPayloadHeader(ctx context.Context, slot types.Slot, idx types.ValidatorIndex) (*enginev1.ExecutionPayloadHeader, error) {
+func (vs *Server) getBuilderBid(ctx context.Context, slot types.Slot, idx types.ValidatorIndex) (*ethpb.BuilderBid, error) {
 	b, err := vs.HeadFetcher.HeadBlock(ctx)
 	if err != nil {
 		return nil, err
@@ -117,12 +167,15 @@ func (vs *Server) getPayloadHeader(ctx context.Context, slot types.Slot, idx typ
 	if err != nil {
 		return nil, err
 	}
+	if bid == nil || bid.Message == nil || bid.Message.Header == nil {
+		return nil, errors.New("nil builder bid")
+	}
 	log.WithFields(logrus.Fields{
-		"bid":           bytesutil.BytesToUint64BigEndian(bid.Message.Value),
+		"bidGwei":       weiToGwei(bytesutil.LittleEndianBytesToBigInt(bid.Message.Value)),
 		"builderPubKey": fmt.Sprintf("%#x", bid.Message.Pubkey),
 		"blockHash":     fmt.Sprintf("%#x", bid.Message.Header.BlockHash),
 	}).Info("Received header with bid")
-	return bid.Message.Header, nil
+	return bid.Message, nil
 }
 
 // This function constructs the builder block given the input altair block and the header. It returns a generic beacon block for signing
@@ -276,28 +329,40 @@ func (vs *Server) readyForBuilder(ctx context.Context) (bool, error) {
 	return blocks.IsExecutionBlock(b.Block().Body())
 }
 
-// Get and builder header block. Returns a boolean status, built block and error.
+// Get and builder header block. Returns a boolean status, built block, the value of the builder bid in wei and error.
 // If the status is false that means builder the header block is disallowed.
 // This routine is time limited by `blockBuilderTimeout`.
-func (vs *Server) getAndBuildHeaderBlock(ctx context.Context, b *ethpb.BeaconBlockAltair) (bool, *ethpb.GenericBeaconBlock, error) {
+func (vs *Server) getAndBuildHeaderBlock(ctx context.Context, b *ethpb.BeaconBlockAltair) (bool, *ethpb.GenericBeaconBlock, *big.Int, error) {
 	// No op. Builder is not defined. User did not specify a user URL. We should use local EE.
 	if vs.BlockBuilder == nil || !vs.BlockBuilder.Configured() {
-		return false, nil, nil
+		return false, nil, nil, nil
 	}
 	ctx, cancel := context.WithTimeout(ctx, blockBuilderTimeout)
 	defer cancel()
 	// Does the protocol allow for builder at this current moment. Builder is only allowed post merge after finalization.
 	ready, err := vs.readyForBuilder(ctx)
 	if err != nil {
-		return false, nil, errors.Wrap(err, "could not determine if builder is ready")
+		return false, nil, nil, errors.Wrap(err, "could not determine if builder is ready")
 	}
 	if !ready {
-		return false, nil, nil
+		return false, nil, nil, nil
+	}
+	circuitBreak, err := vs.circuitBreakBuilder(b.Slot)
+	if err != nil {
+		return false, nil, nil, errors.Wrap(err, "could not determine if builder circuit breaker condition is met")
+	}
+	if circuitBreak {
+		builderCircuitBreakerCount.Inc()
+		return false, nil, nil, nil
 	}
-	h, err := vs.getPayloadHeader(ctx, b.Slot, b.ProposerIndex)
+	bid, err := vs.getBuilderBid(ctx, b.Slot, b.ProposerIndex)
 	if err != nil {
-		return false, nil, errors.Wrap(err, "could not get payload header")
+		return false, nil, nil, errors.Wrap(err, "could not get payload header")
 	}
+	if bid == nil {
+		return false, nil, nil, nil
+	}
+	h := bid.Header
 	log.WithFields(logrus.Fields{
 		"blockHash":    fmt.Sprintf("%#x", h.BlockHash),
 		"feeRecipient": fmt.Sprintf("%#x", h.FeeRecipient),
@@ -306,9 +371,121 @@ func (vs *Server) getAndBuildHeaderBlock(ctx context.Context, b *ethpb.BeaconBlo
 	}).Info("Retrieved header from builder")
 	gb, err := vs.buildHeaderBlock(ctx, b, h)
 	if err != nil {
-		return false, nil, errors.Wrap(err, "could not combine altair block with payload header")
+		return false, nil, nil, errors.Wrap(err, "could not combine altair block with payload header")
+	}
+	return true, gb, bytesutil.LittleEndianBytesToBigInt(bid.Value), nil
+}
+
+// circuitBreakBuilder returns true if the builder must not be used to build the block at slot `s`. This is the case
+// when fork choice has not received a block for `MaxBuilderConsecutiveMissedSlots` consecutive slots, or has missed
+// `MaxBuilderEpochMissedSlots` slots within the last epoch. Local payloads are used until the chain recovers.
+func (vs *Server) circuitBreakBuilder(s types.Slot) (bool, error) {
+	if vs.ForkFetcher == nil || vs.ForkFetcher.ForkChoicer() == nil {
+		return true, errors.New("no fork choicer configured")
+	}
+
+	// Circuit breaker is active if the number of consecutive missed slots reaches `MaxBuilderConsecutiveMissedSlots`.
+	highestReceivedSlot := vs.ForkFetcher.ForkChoicer().HighestReceivedBlockSlot()
+	if highestReceivedSlot < s {
+		missed := s - highestReceivedSlot - 1
+		if missed >= params.BeaconConfig().MaxBuilderConsecutiveMissedSlots {
+			log.WithFields(logrus.Fields{
+				"currentSlot":         s,
+				"highestReceivedSlot": highestReceivedSlot,
+				"maxMissedSlots":      params.BeaconConfig().MaxBuilderConsecutiveMissedSlots,
+			}).Warn("Builder circuit breaker activated due to missing consecutive slots")
+			return true, nil
+		}
+	}
+
+	// There is no full epoch to look back on before the first epoch has passed.
+	if s < params.BeaconConfig().SlotsPerEpoch {
+		return false, nil
+	}
+
+	// Circuit breaker is active if the number of missed slots in the last epoch reaches `MaxBuilderEpochMissedSlots`.
+	receivedCount, err := vs.ForkFetcher.ForkChoicer().ReceivedBlocksLastEpoch()
+	if err != nil {
+		return true, err
+	}
+	missed, err := params.BeaconConfig().SlotsPerEpoch.SafeSub(receivedCount)
+	if err != nil {
+		return true, err
+	}
+	if missed >= params.BeaconConfig().MaxBuilderEpochMissedSlots {
+		log.WithFields(logrus.Fields{
+			"totalMissed":    missed,
+			"maxMissedSlots": params.BeaconConfig().MaxBuilderEpochMissedSlots,
+		}).Warn("Builder circuit breaker activated due to missing enough slots last epoch")
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// builderBidBeatsLocalPayload returns true if the builder bid should be used over the local payload.
+// The builder payload is only used if:
+//
+//	builder_bid_value * 100 > local_block_value * (local-block-value-boost + 100)
+func builderBidBeatsLocalPayload(slot types.Slot, builderValue *big.Int, local *enginev1.ExecutionPayload) (bool, error) {
+	localValue, err := estimateLocalPayloadValue(local)
+	if err != nil {
+		return false, err
+	}
+	boost := params.BeaconConfig().LocalBlockValueBoost
+	lhs := new(big.Int).Mul(builderValue, big.NewInt(100))
+	rhs := new(big.Int).Mul(localValue, new(big.Int).SetUint64(100+boost))
+	useBuilder := lhs.Cmp(rhs) > 0
+
+	builderBidValueGwei.Set(float64(weiToGwei(builderValue)))
+	localPayloadValueGwei.Set(float64(weiToGwei(localValue)))
+	log.WithFields(logrus.Fields{
+		"slot":             slot,
+		"builderValueGwei": weiToGwei(builderValue),
+		"localValueGwei":   weiToGwei(localValue),
+		"localValueBoost":  boost,
+		"useBuilder":       useBuilder,
+	}).Info("Compared builder bid with local payload")
+	return useBuilder, nil
+}
+
+// estimateLocalPayloadValue estimates the value in wei a payload pays to its fee recipient. The engine API does
+// not report the gas used by individual transactions, so the priority fee of each transaction is weighted by its
+// gas limit and the sum is scaled down to the gas used by the whole payload.
+func estimateLocalPayloadValue(payload *enginev1.ExecutionPayload) (*big.Int, error) {
+	if payload == nil {
+		return nil, errors.New("nil execution payload")
+	}
+	baseFee := bytesutil.LittleEndianBytesToBigInt(payload.BaseFeePerGas)
+	value := big.NewInt(0)
+	totalGas := big.NewInt(0)
+	for i, raw := range payload.Transactions {
+		tx := &gethtypes.Transaction{}
+		if err := tx.UnmarshalBinary(raw); err != nil {
+			return nil, errors.Wrapf(err, "could not decode transaction %d", i)
+		}
+		tip, err := tx.EffectiveGasTip(baseFee)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not compute tip of transaction %d", i)
+		}
+		gas := new(big.Int).SetUint64(tx.Gas())
+		value.Add(value, tip.Mul(tip, gas))
+		totalGas.Add(totalGas, gas)
+	}
+	if totalGas.Sign() == 0 {
+		return value, nil
+	}
+	value.Mul(value, new(big.Int).SetUint64(payload.GasUsed))
+	return value.Div(value, totalGas), nil
+}
+
+// weiToGwei converts a wei amount to gwei, saturating at the maximum uint64.
+func weiToGwei(v *big.Int) uint64 {
+	gwei := new(big.Int).Div(v, new(big.Int).SetUint64(params.BeaconConfig().GweiPerEth))
+	if !gwei.IsUint64() {
+		return math.MaxUint64
 	}
-	return true, gb, nil
+	return gwei.Uint64()
 }
 
 // validatorRegistered returns true if validator with index `id` was previously registered in the database.
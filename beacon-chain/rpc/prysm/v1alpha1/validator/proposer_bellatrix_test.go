@@ -2,10 +2,12 @@ package validator
 
 import (
 	"context"
+	"math/big"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-bitfield"
 	blockchainTest "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
@@ -16,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	prysmtime "github.com/prysmaticlabs/prysm/beacon-chain/core/time"
 	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/doubly-linked-tree"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
@@ -89,7 +92,7 @@ func TestServer_buildHeaderBlock(t *testing.T) {
 	require.ErrorContains(t, "nil header", err)
 }
 
-func TestServer_getPayloadHeader(t *testing.T) {
+func TestServer_getBuilderBid(t *testing.T) {
 	tests := []struct {
 		name           string
 		head           interfaces.SignedBeaconBlock
@@ -150,11 +153,11 @@ func TestServer_getPayloadHeader(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			vs := &Server{BlockBuilder: tc.mock, HeadFetcher: tc.fetcher}
-			h, err := vs.getPayloadHeader(context.Background(), 0, 0)
+			bid, err := vs.getBuilderBid(context.Background(), 0, 0)
 			if err != nil {
 				require.ErrorContains(t, tc.err, err)
-			} else {
-				require.DeepEqual(t, tc.returnedHeader, h)
+			} else if tc.returnedHeader != nil {
+				require.DeepEqual(t, tc.returnedHeader, bid.Header)
 			}
 		})
 	}
@@ -300,24 +303,25 @@ func TestServer_getAndBuildHeaderBlock(t *testing.T) {
 	vs := &Server{}
 
 	// Nil builder
-	ready, _, err := vs.getAndBuildHeaderBlock(ctx, nil)
+	ready, _, _, err := vs.getAndBuildHeaderBlock(ctx, nil)
 	require.NoError(t, err)
 	require.Equal(t, false, ready)
 
 	// Not configured
 	vs.BlockBuilder = &builderTest.MockBuilderService{}
-	ready, _, err = vs.getAndBuildHeaderBlock(ctx, nil)
+	ready, _, _, err = vs.getAndBuildHeaderBlock(ctx, nil)
 	require.NoError(t, err)
 	require.Equal(t, false, ready)
 
 	// Block is not ready
 	vs.BlockBuilder = &builderTest.MockBuilderService{HasConfigured: true}
 	vs.FinalizationFetcher = &blockchainTest.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{}}
-	ready, _, err = vs.getAndBuildHeaderBlock(ctx, nil)
+	ready, _, _, err = vs.getAndBuildHeaderBlock(ctx, nil)
 	require.NoError(t, err)
 	require.Equal(t, false, ready)
 
 	// Failed to get header
+	vs.ForkFetcher = &blockchainTest.ChainService{ForkChoiceStore: doublylinkedtree.New()}
 	b1 := util.NewBeaconBlockBellatrix()
 	b1.Block.Body.ExecutionPayload.BlockNumber = 1 // Execution enabled.
 	wb1, err := wrapper.WrappedSignedBeaconBlock(b1)
@@ -329,7 +333,7 @@ func TestServer_getAndBuildHeaderBlock(t *testing.T) {
 	vs.FinalizationFetcher = &blockchainTest.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Root: wbr1[:]}}
 	vs.HeadFetcher = &blockchainTest.ChainService{Block: wb1}
 	vs.BlockBuilder = &builderTest.MockBuilderService{HasConfigured: true, ErrGetHeader: errors.New("could not get payload")}
-	ready, _, err = vs.getAndBuildHeaderBlock(ctx, &ethpb.BeaconBlockAltair{})
+	ready, _, _, err = vs.getAndBuildHeaderBlock(ctx, &ethpb.BeaconBlockAltair{})
 	require.ErrorContains(t, "could not get payload", err)
 	require.Equal(t, false, ready)
 
@@ -368,11 +372,61 @@ func TestServer_getAndBuildHeaderBlock(t *testing.T) {
 	}
 
 	vs.StateGen = stategen.New(vs.BeaconDB)
-	vs.BlockBuilder = &builderTest.MockBuilderService{HasConfigured: true, Bid: &ethpb.SignedBuilderBid{Message: &ethpb.BuilderBid{Header: h}}}
-	ready, builtBlk, err := vs.getAndBuildHeaderBlock(ctx, altairBlk.Block)
+	vs.BlockBuilder = &builderTest.MockBuilderService{HasConfigured: true, Bid: &ethpb.SignedBuilderBid{Message: &ethpb.BuilderBid{Header: h, Value: bytesutil.PadTo([]byte{1}, 32)}}}
+	ready, builtBlk, value, err := vs.getAndBuildHeaderBlock(ctx, altairBlk.Block)
 	require.NoError(t, err)
 	require.Equal(t, true, ready)
 	require.DeepEqual(t, h, builtBlk.GetBlindedBellatrix().Body.ExecutionPayloadHeader)
+	require.Equal(t, int64(1), value.Int64())
+}
+
+func TestServer_circuitBreakBuilder(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	vs := &Server{}
+	_, err := vs.circuitBreakBuilder(0)
+	require.ErrorContains(t, "no fork choicer configured", err)
+
+	fc := doublylinkedtree.New()
+	vs.ForkFetcher = &blockchainTest.ChainService{ForkChoiceStore: fc}
+	currentSlot := 2 * params.BeaconConfig().SlotsPerEpoch
+	fc.SetGenesisTime(uint64(time.Now().Unix()) - uint64(currentSlot)*params.BeaconConfig().SecondsPerSlot)
+
+	// Consecutive missed slots.
+	parent := insertForkchoiceNode(t, ctx, fc, 1, [32]byte{'a'}, [32]byte{})
+	b, err := vs.circuitBreakBuilder(2)
+	require.NoError(t, err)
+	require.Equal(t, false, b)
+	b, err = vs.circuitBreakBuilder(params.BeaconConfig().MaxBuilderConsecutiveMissedSlots + 2)
+	require.NoError(t, err)
+	require.Equal(t, true, b)
+	require.LogsContain(t, hook, "Builder circuit breaker activated due to missing consecutive slots")
+
+	// Missed slots in the last epoch.
+	missed := params.BeaconConfig().MaxBuilderEpochMissedSlots
+	for slot := currentSlot - params.BeaconConfig().SlotsPerEpoch + missed; slot < currentSlot; slot++ {
+		parent = insertForkchoiceNode(t, ctx, fc, slot, [32]byte{'b', byte(slot)}, parent)
+	}
+	b, err = vs.circuitBreakBuilder(currentSlot)
+	require.NoError(t, err)
+	require.Equal(t, true, b)
+	require.LogsContain(t, hook, "Builder circuit breaker activated due to missing enough slots last epoch")
+
+	insertForkchoiceNode(t, ctx, fc, currentSlot-params.BeaconConfig().SlotsPerEpoch, [32]byte{'c'}, [32]byte{'a'})
+	b, err = vs.circuitBreakBuilder(currentSlot)
+	require.NoError(t, err)
+	require.Equal(t, false, b)
+}
+
+func insertForkchoiceNode(t *testing.T, ctx context.Context, fc *doublylinkedtree.ForkChoice, slot types.Slot, root, parent [32]byte) [32]byte {
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, st.SetLatestBlockHeader(util.HydrateBeaconHeader(&ethpb.BeaconBlockHeader{Slot: slot, ParentRoot: parent[:]})))
+	require.NoError(t, fc.InsertNode(ctx, st, root))
+	return root
 }
 
 func TestServer_GetBellatrixBeaconBlock_HappyCase(t *testing.T) {
@@ -586,9 +640,19 @@ func TestServer_GetBellatrixBeaconBlock_BuilderCase(t *testing.T) {
 			PayloadIDBytes:   &v1.PayloadIDBytes{1},
 			ExecutionPayload: emptyPayload,
 		},
-		BeaconDB:     db,
-		BlockBuilder: &builderTest.MockBuilderService{HasConfigured: true, Bid: &ethpb.SignedBuilderBid{Message: &ethpb.BuilderBid{Header: h}}},
+		BeaconDB:               db,
+		ProposerSlotIndexCache: cache.NewProposerPayloadIDsCache(),
+		BlockBuilder:           &builderTest.MockBuilderService{HasConfigured: true, Bid: &ethpb.SignedBuilderBid{Message: &ethpb.BuilderBid{Header: h, Value: bytesutil.PadTo([]byte{1}, 32)}}},
+	}
+
+	// Blocks were received for the whole last epoch, so the builder circuit breaker is not active.
+	fc := doublylinkedtree.New()
+	fc.SetGenesisTime(uint64(time.Now().Unix()) - uint64(bellatrixSlot+1)*params.BeaconConfig().SecondsPerSlot)
+	fcParent := [32]byte{}
+	for slot := bellatrixSlot + 1 - params.BeaconConfig().SlotsPerEpoch; slot <= bellatrixSlot; slot++ {
+		fcParent = insertForkchoiceNode(t, ctx, fc, slot, [32]byte{byte(slot)}, fcParent)
 	}
+	proposerServer.ForkFetcher = &blockchainTest.ChainService{ForkChoiceStore: fc}
 
 	randaoReveal, err := util.RandaoReveal(beaconState, 0, privKeys)
 	require.NoError(t, err)
@@ -606,6 +670,75 @@ func TestServer_GetBellatrixBeaconBlock_BuilderCase(t *testing.T) {
 	require.DeepEqual(t, h, bellatrixBlk.BlindedBellatrix.Body.ExecutionPayloadHeader) // Payload header should equal.
 }
 
+func TestServer_estimateLocalPayloadValue(t *testing.T) {
+	_, err := estimateLocalPayloadValue(nil)
+	require.ErrorContains(t, "nil execution payload", err)
+
+	gwei := big.NewInt(1e9)
+	payload := &v1.ExecutionPayload{
+		BaseFeePerGas: bytesutil.PadTo(bytesutil.ReverseByteOrder(new(big.Int).Mul(big.NewInt(10), gwei).Bytes()), 32),
+		GasUsed:       21000,
+	}
+	v, err := estimateLocalPayloadValue(payload)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), v.Int64())
+
+	tx, err := gethtypes.NewTx(&gethtypes.DynamicFeeTx{
+		GasTipCap: new(big.Int).Mul(big.NewInt(2), gwei),
+		GasFeeCap: new(big.Int).Mul(big.NewInt(100), gwei),
+		Gas:       42000,
+	}).MarshalBinary()
+	require.NoError(t, err)
+	payload.Transactions = [][]byte{tx}
+	v, err = estimateLocalPayloadValue(payload)
+	require.NoError(t, err)
+	// The tip of 2 gwei is scaled down to the 21000 gas used by the payload.
+	require.Equal(t, int64(42000), new(big.Int).Div(v, gwei).Int64())
+
+	payload.Transactions = [][]byte{{0xFF}}
+	_, err = estimateLocalPayloadValue(payload)
+	require.ErrorContains(t, "could not decode transaction 0", err)
+}
+
+func TestServer_builderBidBeatsLocalPayload(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	gwei := big.NewInt(1e9)
+	tx, err := gethtypes.NewTx(&gethtypes.DynamicFeeTx{
+		GasTipCap: gwei,
+		GasFeeCap: new(big.Int).Mul(big.NewInt(100), gwei),
+		Gas:       100,
+	}).MarshalBinary()
+	require.NoError(t, err)
+	// Local payload is worth 100 gwei.
+	local := &v1.ExecutionPayload{
+		BaseFeePerGas: make([]byte, fieldparams.RootLength),
+		GasUsed:       100,
+		Transactions:  [][]byte{tx},
+	}
+
+	tests := []struct {
+		name       string
+		boost      uint64
+		bidGwei    int64
+		useBuilder bool
+	}{
+		{name: "higher bid, no boost", boost: 0, bidGwei: 101, useBuilder: true},
+		{name: "equal bid, no boost", boost: 0, bidGwei: 100, useBuilder: false},
+		{name: "higher bid within boost", boost: 10, bidGwei: 110, useBuilder: false},
+		{name: "higher bid beyond boost", boost: 10, bidGwei: 111, useBuilder: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := params.BeaconConfig().Copy()
+			cfg.LocalBlockValueBoost = tc.boost
+			params.OverrideBeaconConfig(cfg)
+			useBuilder, err := builderBidBeatsLocalPayload(1, new(big.Int).Mul(big.NewInt(tc.bidGwei), gwei), local)
+			require.NoError(t, err)
+			require.Equal(t, tc.useBuilder, useBuilder)
+		})
+	}
+}
+
 func TestServer_validatorRegistered(t *testing.T) {
 	proposerServer := &Server{}
 	ctx := context.Background()
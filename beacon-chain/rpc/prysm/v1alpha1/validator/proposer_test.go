@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-bitfield"
+	builderapi "github.com/prysmaticlabs/prysm/api/client/builder"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	builderTest "github.com/prysmaticlabs/prysm/beacon-chain/builder/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
@@ -45,6 +46,7 @@ import (
 	"github.com/prysmaticlabs/prysm/time/slots"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
@@ -2521,6 +2523,14 @@ func TestProposer_SubmitValidatorRegistrations(t *testing.T) {
 	proposerServer = &Server{BlockBuilder: &builderTest.MockBuilderService{HasConfigured: true, ErrRegisterValidator: errors.New("bad")}}
 	_, err = proposerServer.SubmitValidatorRegistrations(ctx, reg)
 	require.ErrorContains(t, "bad", err)
+
+	builder := &builderTest.MockBuilderService{HasConfigured: true}
+	proposerServer = &Server{BlockBuilder: builder}
+	relays := []string{"https://0x01@relay1", "https://0x02@relay2"}
+	md := metadata.Pairs(builderapi.RelaysMetadataKey, relays[0], builderapi.RelaysMetadataKey, relays[1])
+	_, err = proposerServer.SubmitValidatorRegistrations(metadata.NewIncomingContext(ctx, md), reg)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][]string{relays}, builder.Relays)
 }
 
 func majorityVoteBoundaryTime(slot types.Slot) (uint64, uint64) {
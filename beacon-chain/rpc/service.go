@@ -103,6 +103,7 @@ type Config struct {
 	Broadcaster                   p2p.Broadcaster
 	PeersFetcher                  p2p.PeersProvider
 	PeerManager                   p2p.PeerManager
+	PeerAccessManager             p2p.PeerAccessManager
 	MetadataProvider              p2p.MetadataProvider
 	DepositFetcher                depositcache.DepositFetcher
 	PendingDepositFetcher         depositcache.PendingDepositsFetcher
@@ -186,7 +187,8 @@ func (s *Service) Start() {
 		stateCache = s.cfg.StateGen.CombinedCache()
 	}
 	withCache := stategen.WithCache(stateCache)
-	ch := stategen.NewCanonicalHistory(s.cfg.BeaconDB, s.cfg.ChainInfoFetcher, s.cfg.ChainInfoFetcher, withCache)
+	withArchive := stategen.WithArchivedStates(s.cfg.BeaconDB)
+	ch := stategen.NewCanonicalHistory(s.cfg.BeaconDB, s.cfg.ChainInfoFetcher, s.cfg.ChainInfoFetcher, withCache, withArchive)
 
 	validatorServer := &validatorv1alpha1.Server{
 		Ctx:                    s.ctx,
@@ -223,6 +225,7 @@ func (s *Service) Start() {
 	}
 	validatorServerV1 := &validator.Server{
 		HeadFetcher:           s.cfg.HeadFetcher,
+		CanonicalFetcher:      s.cfg.CanonicalFetcher,
 		HeadUpdater:           s.cfg.HeadUpdater,
 		TimeFetcher:           s.cfg.GenesisTimeFetcher,
 		SyncChecker:           s.cfg.SyncService,
@@ -231,6 +234,7 @@ func (s *Service) Start() {
 		PeerManager:           s.cfg.PeerManager,
 		Broadcaster:           s.cfg.Broadcaster,
 		V1Alpha1Server:        validatorServer,
+		BeaconDB:              s.cfg.BeaconDB,
 		StateFetcher: &statefetcher.StateProvider{
 			BeaconDB:           s.cfg.BeaconDB,
 			ChainInfoFetcher:   s.cfg.ChainInfoFetcher,
@@ -239,6 +243,7 @@ func (s *Service) Start() {
 			ReplayerBuilder:    ch,
 		},
 		SyncCommitteePool: s.cfg.SyncCommitteeObjectPool,
+		BlockBuilder:      s.cfg.BlockBuilder,
 	}
 
 	nodeServer := &nodev1alpha1.Server{
@@ -250,6 +255,7 @@ func (s *Service) Start() {
 		GenesisTimeFetcher:   s.cfg.GenesisTimeFetcher,
 		PeersFetcher:         s.cfg.PeersFetcher,
 		PeerManager:          s.cfg.PeerManager,
+		PeerAccessManager:    s.cfg.PeerAccessManager,
 		GenesisFetcher:       s.cfg.GenesisFetcher,
 		POWChainInfoFetcher:  s.cfg.POWChainInfoFetcher,
 		BeaconMonitoringHost: s.cfg.BeaconMonitoringHost,
@@ -353,6 +359,8 @@ func (s *Service) Start() {
 			OptimisticModeFetcher: s.cfg.OptimisticModeFetcher,
 		}
 		ethpbv1alpha1.RegisterDebugServer(s.grpcServer, debugServer)
+		ethpbv1alpha1.RegisterPeerScoresServer(s.grpcServer, debugServer)
+		ethpbv1alpha1.RegisterPeerAccessServer(s.grpcServer, nodeServer)
 		ethpbservice.RegisterBeaconDebugServer(s.grpcServer, debugServerV1)
 	}
 	ethpbv1alpha1.RegisterBeaconNodeValidatorServer(s.grpcServer, validatorServer)
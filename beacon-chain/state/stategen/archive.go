@@ -0,0 +1,167 @@
+package stategen
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// archiveBatchSlots is the number of slots of finalized blocks loaded at once while archiving states.
+var archiveBatchSlots types.Slot = 256
+
+// WithArchiveMode enables archive mode, where the state of every finalized slot is saved to the database. A full
+// snapshot of the state is saved every snapshotInterval slots, and at each fork, while the states in between are
+// saved as diffs against the state of the previous slot.
+// Archiving runs in the background, see RunArchiver.
+func WithArchiveMode(snapshotInterval types.Slot) StateGenOption {
+	return func(sg *State) {
+		sg.archiveInterval = snapshotInterval
+		sg.archiveWake = make(chan struct{}, 1)
+	}
+}
+
+// RunArchiver archives the finalized states in the background until the context is canceled. It is a no-op when
+// archive mode is disabled. MigrateToCold only signals the new finalized slots, the archiver catches up with the
+// latest one from the highest archived state, so that archiving never delays block processing.
+func (s *State) RunArchiver(ctx context.Context) {
+	if s.archiveInterval == 0 {
+		return
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.archiveWake:
+		}
+		s.archiveLock.Lock()
+		target := s.archiveTarget
+		s.archiveLock.Unlock()
+		// Failures are retried from the highest archived state on the next finalized slot.
+		if err := s.archiveFinalized(ctx, target); err != nil {
+			log.WithError(err).Error("Could not archive finalized states")
+		}
+	}
+}
+
+// signalArchive records the new finalized slot as the target of the archiver, and wakes the archiver up if it is
+// idle.
+func (s *State) signalArchive(fSlot types.Slot) {
+	if s.archiveInterval == 0 {
+		return
+	}
+	s.archiveLock.Lock()
+	if fSlot > s.archiveTarget {
+		s.archiveTarget = fSlot
+	}
+	s.archiveLock.Unlock()
+	select {
+	case s.archiveWake <- struct{}{}:
+	default:
+	}
+}
+
+// archiveFinalized archives the states of the slots following the highest archived state, up to the given finalized
+// slot, by replaying the finalized blocks. It must only be called by the archiver, which owns the highest archived
+// state.
+func (s *State) archiveFinalized(ctx context.Context, fSlot types.Slot) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.archiveFinalized")
+	defer span.End()
+
+	last, err := s.lastArchivedState(ctx)
+	if err != nil {
+		return err
+	}
+	start := last.Slot()
+	for last.Slot() < fSlot {
+		end := last.Slot() + archiveBatchSlots
+		if end > fSlot {
+			end = fSlot
+		}
+		// The blocks are returned in decreasing slot order.
+		blks, err := s.loadFinalizedBlocks(ctx, last.Slot()+1, end)
+		if err != nil {
+			return errors.Wrap(err, "could not load finalized blocks")
+		}
+		for slot := last.Slot() + 1; slot <= end; slot++ {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			next := last.Copy()
+			if len(blks) > 0 && blks[len(blks)-1].Block().Slot() == slot {
+				next, err = executeStateTransitionStateGen(ctx, next, blks[len(blks)-1])
+				blks = blks[:len(blks)-1]
+			} else {
+				next, err = ReplayProcessSlots(ctx, next, slot)
+			}
+			if err != nil {
+				return errors.Wrapf(err, "could not replay state at slot %d", slot)
+			}
+			if err := s.saveArchivedState(ctx, last, next); err != nil {
+				return err
+			}
+			last = next
+			s.archived = last
+		}
+	}
+	if last.Slot() > start {
+		log.WithFields(logrus.Fields{
+			"startSlot": start + 1,
+			"endSlot":   last.Slot(),
+		}).Debug("Archived finalized states")
+	}
+	return nil
+}
+
+// lastArchivedState returns the highest archived state. When nothing was archived yet, the archive starts with a
+// snapshot of the genesis state, which requires the full chain history to be in the database.
+func (s *State) lastArchivedState(ctx context.Context) (state.BeaconState, error) {
+	if s.archived != nil {
+		return s.archived, nil
+	}
+	highest, ok, err := s.beaconDB.HighestArchivedSlot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		st, err := s.beaconDB.ArchivedState(ctx, highest)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not load archived state at slot %d", highest)
+		}
+		s.archived = st
+		return st, nil
+	}
+	if s.backfillStatus != nil && (!s.backfillStatus.Complete() || s.backfillStatus.PrunedSlot() > 0) {
+		return nil, errors.New("archive mode requires the chain history since genesis, which is missing or pruned")
+	}
+	gs, err := s.beaconDB.GenesisState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if gs == nil || gs.IsNil() {
+		return nil, errUnknownState
+	}
+	if err := s.beaconDB.SaveArchivedSnapshot(ctx, gs); err != nil {
+		return nil, errors.Wrap(err, "could not archive genesis state")
+	}
+	s.archived = gs
+	return gs, nil
+}
+
+// saveArchivedState saves the state as a snapshot on the snapshot interval, or when the fork of the state differs
+// from the previous one, and as a diff against the previous state otherwise.
+func (s *State) saveArchivedState(ctx context.Context, prev, st state.BeaconState) error {
+	if st.Slot()%s.archiveInterval == 0 || st.Version() != prev.Version() {
+		if err := s.beaconDB.SaveArchivedSnapshot(ctx, st); err != nil {
+			return errors.Wrapf(err, "could not archive snapshot at slot %d", st.Slot())
+		}
+		return nil
+	}
+	if err := s.beaconDB.SaveArchivedDiff(ctx, prev, st); err != nil {
+		return errors.Wrapf(err, "could not archive diff at slot %d", st.Slot())
+	}
+	return nil
+}
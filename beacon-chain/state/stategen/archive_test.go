@@ -0,0 +1,98 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestArchiveFinalized_SkipSlots(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	genesis, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, beaconDB.SaveGenesisData(ctx, genesis))
+
+	service := New(beaconDB, WithArchiveMode(16))
+	fSlot := params.BeaconConfig().SlotsPerEpoch + 3
+	require.NoError(t, service.archiveFinalized(ctx, fSlot))
+
+	highest, ok, err := beaconDB.HighestArchivedSlot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	assert.Equal(t, fSlot, highest)
+
+	for _, slot := range []types.Slot{0, 5, 16, params.BeaconConfig().SlotsPerEpoch, fSlot} {
+		want, err := ReplayProcessSlots(ctx, genesis.Copy(), slot)
+		require.NoError(t, err)
+		got, err := beaconDB.ArchivedState(ctx, slot)
+		require.NoError(t, err)
+		require.DeepSSZEqual(t, want.InnerStateUnsafe(), got.InnerStateUnsafe(), "archived state at slot %d does not match", slot)
+	}
+
+	// Archiving resumes from the highest archived state, which is read from the database after a restart.
+	service = New(beaconDB, WithArchiveMode(16))
+	require.NoError(t, service.archiveFinalized(ctx, fSlot+2))
+	want, err := ReplayProcessSlots(ctx, genesis.Copy(), fSlot+2)
+	require.NoError(t, err)
+	got, err := beaconDB.ArchivedState(ctx, fSlot+2)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, want.InnerStateUnsafe(), got.InnerStateUnsafe())
+}
+
+func TestArchiveFinalized_PrunedHistory(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	genesis, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, beaconDB.SaveGenesisData(ctx, genesis))
+
+	bfs := backfill.NewStatus(beaconDB)
+	require.NoError(t, bfs.Reload(ctx))
+	bfs.MarkPruned(64)
+	service := New(beaconDB, WithArchiveMode(16), WithBackfillStatus(bfs))
+	require.ErrorContains(t, "requires the chain history since genesis", service.archiveFinalized(ctx, 100))
+
+	_, ok, err := beaconDB.HighestArchivedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+}
+
+func TestRunArchiver(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	beaconDB := testDB.SetupDB(t)
+	genesis, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, beaconDB.SaveGenesisData(ctx, genesis))
+
+	service := New(beaconDB, WithArchiveMode(16))
+	done := make(chan struct{})
+	go func() {
+		service.RunArchiver(ctx)
+		close(done)
+	}()
+	// Only the latest finalized slot matters, signals do not block while the archiver is busy.
+	for _, slot := range []types.Slot{4, 9, 3} {
+		service.signalArchive(slot)
+	}
+
+	var highest types.Slot
+	for i := 0; i < 1000 && highest < 9; i++ {
+		time.Sleep(10 * time.Millisecond)
+		slot, ok, err := beaconDB.HighestArchivedSlot(ctx)
+		require.NoError(t, err)
+		if ok {
+			highest = slot
+		}
+	}
+	assert.Equal(t, types.Slot(9), highest)
+
+	cancel()
+	<-done
+}
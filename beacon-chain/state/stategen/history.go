@@ -21,6 +21,14 @@ func WithCache(c CachedGetter) CanonicalHistoryOption {
 	}
 }
 
+// WithArchivedStates uses the states saved by archive mode, when one is available for the target slot, rather than
+// replaying blocks on top of the nearest saved state.
+func WithArchivedStates(a ArchiveAccessor) CanonicalHistoryOption {
+	return func(h *CanonicalHistory) {
+		h.archive = a
+	}
+}
+
 type CanonicalHistoryOption func(*CanonicalHistory)
 
 func NewCanonicalHistory(h HistoryAccessor, cc CanonicalChecker, cs CurrentSlotter, opts ...CanonicalHistoryOption) *CanonicalHistory {
@@ -36,10 +44,11 @@ func NewCanonicalHistory(h HistoryAccessor, cc CanonicalChecker, cs CurrentSlott
 }
 
 type CanonicalHistory struct {
-	h     HistoryAccessor
-	cc    CanonicalChecker
-	cs    CurrentSlotter
-	cache CachedGetter
+	h       HistoryAccessor
+	cc      CanonicalChecker
+	cs      CurrentSlotter
+	cache   CachedGetter
+	archive ArchiveAccessor
 }
 
 func (c *CanonicalHistory) ReplayerForSlot(target types.Slot) Replayer {
@@ -107,6 +116,15 @@ func (c *CanonicalHistory) bestForSlot(ctx context.Context, roots [][32]byte) ([
 func (c *CanonicalHistory) chainForSlot(ctx context.Context, target types.Slot) (state.BeaconState, []interfaces.SignedBeaconBlock, error) {
 	ctx, span := trace.StartSpan(ctx, "canonicalChainer.chainForSlot")
 	defer span.End()
+	if c.archive != nil {
+		st, err := c.archive.ArchivedState(ctx, target)
+		if err == nil {
+			return st, nil, nil
+		}
+		if !errors.Is(err, db.ErrNotFoundState) {
+			return nil, nil, errors.Wrapf(err, "unable to retrieve archived state for slot=%d", target)
+		}
+	}
 	r, err := c.BlockRootForSlot(ctx, target)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "no canonical block root found below slot=%d", target)
@@ -13,6 +13,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
 )
 
 func TestBlockForSlotFuture(t *testing.T) {
@@ -446,6 +447,33 @@ func TestChainForSlot(t *testing.T) {
 	}
 }
 
+func TestChainForSlotArchived(t *testing.T) {
+	ctx := context.Background()
+	var zero, one, two types.Slot = 50, 51, 52
+	specs := []mockHistorySpec{
+		{slot: zero, canonicalBlock: true, savedState: true},
+		{slot: one, canonicalBlock: true},
+		{slot: two, canonicalBlock: true},
+	}
+	hist := newMockHistory(t, specs, two+10)
+	archived, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, archived.SetSlot(one))
+	archive := &mockArchive{states: map[types.Slot]state.BeaconState{one: archived}}
+	ch := NewCanonicalHistory(hist, hist, hist, WithArchivedStates(archive))
+
+	st, blocks, err := ch.chainForSlot(ctx, one)
+	require.NoError(t, err)
+	require.Equal(t, archived, st)
+	require.Equal(t, 0, len(blocks))
+
+	// Slots which were not archived fall back to replaying blocks from the nearest saved state.
+	st, blocks, err = ch.chainForSlot(ctx, two)
+	require.NoError(t, err)
+	require.Equal(t, zero, st.Slot())
+	require.Equal(t, 2, len(blocks))
+}
+
 func TestAncestorChainOrdering(t *testing.T) {
 	ctx := context.Background()
 	var zero, one, two, three, four, five types.Slot = 50, 51, 150, 151, 152, 200
@@ -103,6 +103,9 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 		}
 	}
 
+	// Archive mode replays the newly finalized blocks in the background.
+	s.signalArchive(fSlot)
+
 	// Update finalized info in memory.
 	fInfo, ok, err := s.epochBoundaryStateCache.getByBlockRoot(fRoot)
 	if err != nil {
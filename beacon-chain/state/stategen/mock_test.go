@@ -280,3 +280,17 @@ func (m mockCachedGetter) ByBlockRoot(root [32]byte) (state.BeaconState, error)
 }
 
 var _ CachedGetter = &mockCachedGetter{}
+
+type mockArchive struct {
+	states map[types.Slot]state.BeaconState
+}
+
+func (m *mockArchive) ArchivedState(_ context.Context, slot types.Slot) (state.BeaconState, error) {
+	st, ok := m.states[slot]
+	if !ok {
+		return nil, db.ErrNotFoundState
+	}
+	return st, nil
+}
+
+var _ ArchiveAccessor = &mockArchive{}
@@ -32,6 +32,11 @@ type HistoryAccessor interface {
 	StateOrError(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error)
 }
 
+// ArchiveAccessor describes the database method used to read the states saved by archive mode.
+type ArchiveAccessor interface {
+	ArchivedState(ctx context.Context, slot types.Slot) (state.BeaconState, error)
+}
+
 // CanonicalChecker determines whether the given block root is canonical.
 // In practice this should be satisfied by a type that uses the fork choice store.
 type CanonicalChecker interface {
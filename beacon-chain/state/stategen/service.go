@@ -51,6 +51,11 @@ type State struct {
 	epochBoundaryStateCache *epochBoundaryState
 	saveHotStateDB          *saveHotStateDbConfig
 	backfillStatus          *backfill.Status
+	archiveInterval         types.Slot
+	archived                state.BeaconState
+	archiveLock             sync.Mutex
+	archiveTarget           types.Slot
+	archiveWake             chan struct{}
 }
 
 // This tracks the config in the event of long non-finality,
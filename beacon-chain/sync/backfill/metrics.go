@@ -0,0 +1,17 @@
+package backfill
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	backfilledBlocksCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "backfill_blocks_count",
+		Help: "Count the number of blocks saved by backfill.",
+	})
+	backfillLowestSlot = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "backfill_lowest_slot",
+		Help: "Slot of the lowest block saved by backfill.",
+	})
+)
@@ -0,0 +1,64 @@
+package backfill
+
+import (
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+)
+
+type Option func(s *Service) error
+
+// WithStatus sets the backfill status, which tracks the part of the history that is missing.
+func WithStatus(st *Status) Option {
+	return func(s *Service) error {
+		s.status = st
+		return nil
+	}
+}
+
+func WithDatabase(db db.HeadAccessDatabase) Option {
+	return func(s *Service) error {
+		s.cfg.db = db
+		return nil
+	}
+}
+
+func WithP2P(p2p p2p.P2P) Option {
+	return func(s *Service) error {
+		s.cfg.p2p = p2p
+		return nil
+	}
+}
+
+// WithBlocksByRangeRequester sets the function used to request blocks from peers.
+func WithBlocksByRangeRequester(r BlocksByRangeRequester) Option {
+	return func(s *Service) error {
+		s.cfg.requester = r
+		return nil
+	}
+}
+
+// WithInitialSync pauses backfill while initial sync is running.
+func WithInitialSync(is InitialSyncChecker) Option {
+	return func(s *Service) error {
+		s.cfg.initialSync = is
+		return nil
+	}
+}
+
+// WithBatchSize sets the number of slots requested from a peer at once.
+func WithBatchSize(n uint64) Option {
+	return func(s *Service) error {
+		s.cfg.batchSize = n
+		return nil
+	}
+}
+
+// WithBatchInterval sets the minimum amount of time between two batches.
+func WithBatchInterval(d time.Duration) Option {
+	return func(s *Service) error {
+		s.cfg.batchInterval = d
+		return nil
+	}
+}
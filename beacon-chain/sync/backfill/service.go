@@ -0,0 +1,314 @@
+package backfill
+
+import (
+	"context"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultBatchSize is the default number of slots requested from a peer at once.
+	DefaultBatchSize = 64
+	// DefaultBatchInterval is the default minimum amount of time between two batches, which limits the bandwidth used
+	// by backfill so that it does not starve gossip.
+	DefaultBatchInterval = time.Second
+)
+
+var (
+	errNoPeers         = errors.New("no peers available to backfill from")
+	errUnlinkedBatch   = errors.New("blocks do not link to the lowest backfilled block")
+	errMissingRequired = errors.New("backfill service is missing a required dependency")
+)
+
+var _ runtime.Service = (*Service)(nil)
+
+// BlocksByRangeRequester sends a BeaconBlocksByRange request to the given peer and returns the blocks of the
+// response, which are checked to match the request.
+type BlocksByRangeRequester func(ctx context.Context, pid peer.ID, req *ethpb.BeaconBlocksByRangeRequest) ([]interfaces.SignedBeaconBlock, error)
+
+// InitialSyncChecker reports whether the node is still performing initial sync.
+type InitialSyncChecker interface {
+	Syncing() bool
+}
+
+// config defines a config struct for dependencies into the service.
+type config struct {
+	db            db.HeadAccessDatabase
+	p2p           p2p.P2P
+	requester     BlocksByRangeRequester
+	initialSync   InitialSyncChecker
+	batchSize     uint64
+	batchInterval time.Duration
+}
+
+// Service downloads the blocks missing below the origin block of a node initialized via checkpoint sync, so that
+// the node can serve the full history of the chain. Blocks are requested backwards from the lowest backfilled block,
+// one batch at a time. A batch is only saved once the blocks are found to form a chain ending at the parent of the
+// lowest backfilled block and their proposer signatures are valid.
+type Service struct {
+	cfg      *config
+	ctx      context.Context
+	cancel   context.CancelFunc
+	status   *Status
+	verifier *verifier
+
+	genesisRoot [32]byte
+	originRoot  [32]byte
+	// lowestRoot is the root of the lowest backfilled block, or of the origin block before anything was backfilled.
+	lowestRoot [32]byte
+	// parentRoot is the parent root of the lowest backfilled block, which the highest block of the next batch must
+	// have as its root.
+	parentRoot [32]byte
+	// nextEnd is the exclusive upper bound of the next batch. It is lower than the backfill position when peers
+	// returned no blocks for the slots right below it.
+	nextEnd types.Slot
+	// nextPeer is used to spread the requests over the available peers.
+	nextPeer int
+}
+
+// NewService initializes the backfill service.
+func NewService(ctx context.Context, opts ...Option) (*Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg: &config{
+			batchSize:     DefaultBatchSize,
+			batchInterval: DefaultBatchInterval,
+		},
+	}
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	if s.status == nil || s.cfg.db == nil || s.cfg.p2p == nil || s.cfg.requester == nil {
+		cancel()
+		return nil, errMissingRequired
+	}
+	if s.cfg.batchSize == 0 || s.cfg.batchSize > params.BeaconNetworkConfig().MaxRequestBlocks {
+		cancel()
+		return nil, errors.Errorf("backfill batch size must be between 1 and %d", params.BeaconNetworkConfig().MaxRequestBlocks)
+	}
+	return s, nil
+}
+
+// Start backfills the missing history until it is complete or the service is stopped.
+func (s *Service) Start() {
+	if s.status.Complete() {
+		log.Debug("No history to backfill")
+		return
+	}
+	if err := s.initialize(s.ctx); err != nil {
+		log.WithError(err).Error("Could not initialize backfill")
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"startSlot": s.status.StartGap(),
+		"endSlot":   s.status.EndGap(),
+	}).Info("Backfilling history below the checkpoint sync origin")
+
+	ticker := time.NewTicker(s.cfg.batchInterval)
+	defer ticker.Stop()
+	for !s.status.Complete() {
+		select {
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting backfill")
+			return
+		case <-ticker.C:
+		}
+		// Leave the bandwidth to initial sync, which has to complete for the node to follow the chain.
+		if s.cfg.initialSync != nil && s.cfg.initialSync.Syncing() {
+			continue
+		}
+		if err := s.backfillBatch(s.ctx); err != nil {
+			if errors.Is(err, errNoPeers) {
+				log.Debug("No peers available to backfill from, waiting")
+				continue
+			}
+			log.WithError(err).Debug("Could not backfill batch")
+		}
+	}
+	log.Info("Backfill complete")
+}
+
+// Stop the backfill service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the backfill service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// initialize loads the data needed to verify the backfilled blocks.
+func (s *Service) initialize(ctx context.Context) error {
+	genesisRoot, err := s.cfg.db.GenesisBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis block root")
+	}
+	s.genesisRoot = genesisRoot
+	s.originRoot, err = s.cfg.db.OriginCheckpointBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get origin checkpoint block root")
+	}
+	originState, err := s.cfg.db.StateOrError(ctx, s.originRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get origin checkpoint state")
+	}
+	s.verifier, err = newVerifier(originState)
+	if err != nil {
+		return err
+	}
+	return s.resetPosition(ctx)
+}
+
+// resetPosition sets the next batch to end right below the lowest backfilled block.
+func (s *Service) resetPosition(ctx context.Context) error {
+	bfRoot, err := s.cfg.db.BackfillBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get backfill block root")
+	}
+	if bfRoot == s.genesisRoot {
+		// Checkpoint sync saves the genesis root as the initial backfill position, nothing was backfilled yet, see
+		// Status.Reload.
+		bfRoot = s.originRoot
+	}
+	bfBlock, err := s.cfg.db.Block(ctx, bfRoot)
+	if err != nil {
+		return errors.Wrapf(err, "could not get backfill block with root %#x", bfRoot)
+	}
+	if err := wrapper.BeaconBlockIsNil(bfBlock); err != nil {
+		return err
+	}
+	s.lowestRoot = bfRoot
+	s.parentRoot = bytesutil.ToBytes32(bfBlock.Block().ParentRoot())
+	s.nextEnd = bfBlock.Block().Slot()
+	return nil
+}
+
+// backfillBatch requests the next batch of blocks from a peer, and saves them if they are valid.
+func (s *Service) backfillBatch(ctx context.Context) error {
+	// The genesis block is part of the database, the history is complete once the lowest backfilled block is its child.
+	if s.parentRoot == s.genesisRoot {
+		return s.status.Advance(ctx, s.status.StartGap(), s.genesisRoot)
+	}
+	if s.nextEnd <= s.status.StartGap()+1 {
+		if s.status.PrunedSlot() > 0 {
+			// The history is complete down to the pruned slot, the blocks below it are not needed.
+			return s.status.Advance(ctx, s.status.StartGap(), s.lowestRoot)
+		}
+		// Peers claimed there are no blocks between genesis and the lowest backfilled block, which contradicts its
+		// parent root. Start over from the lowest backfilled block.
+		if err := s.resetPosition(ctx); err != nil {
+			return err
+		}
+		return errUnlinkedBatch
+	}
+
+	pid, err := s.selectPeer()
+	if err != nil {
+		return err
+	}
+	start := s.status.StartGap() + 1
+	if s.nextEnd > start+types.Slot(s.cfg.batchSize) {
+		start = s.nextEnd - types.Slot(s.cfg.batchSize)
+	}
+	req := &ethpb.BeaconBlocksByRangeRequest{
+		StartSlot: start,
+		Count:     uint64(s.nextEnd - start),
+		Step:      1,
+	}
+	blocks, err := s.cfg.requester(ctx, pid, req)
+	if err != nil {
+		s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Increment(pid)
+		return errors.Wrapf(err, "could not request blocks from peer %s", pid)
+	}
+	if len(blocks) == 0 {
+		// Either the slots are empty or the peer withholds blocks, which is detected once the next blocks fail to
+		// link to the lowest backfilled block.
+		s.nextEnd = start
+		return nil
+	}
+
+	lowestRoot, err := s.verifyBatch(blocks)
+	if err != nil {
+		s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Increment(pid)
+		if errors.Is(err, errUnlinkedBatch) {
+			if err := s.resetPosition(ctx); err != nil {
+				return err
+			}
+		}
+		return errors.Wrapf(err, "invalid blocks from peer %s", pid)
+	}
+
+	if err := s.cfg.db.SaveFinalizedBlocks(ctx, blocks, s.lowestRoot); err != nil {
+		return errors.Wrap(err, "could not save backfilled blocks")
+	}
+	lowest := blocks[0].Block()
+	if err := s.status.Advance(ctx, lowest.Slot(), lowestRoot); err != nil {
+		return errors.Wrap(err, "could not update backfill status")
+	}
+	s.lowestRoot = lowestRoot
+	s.parentRoot = bytesutil.ToBytes32(lowest.ParentRoot())
+	s.nextEnd = lowest.Slot()
+
+	backfilledBlocksCount.Add(float64(len(blocks)))
+	backfillLowestSlot.Set(float64(lowest.Slot()))
+	log.WithFields(logrus.Fields{
+		"blocks":  len(blocks),
+		"slot":    lowest.Slot(),
+		"endSlot": s.status.EndGap(),
+	}).Debug("Backfilled batch")
+	return nil
+}
+
+// verifyBatch checks that the blocks, sorted by slot, form a chain ending at the parent of the lowest backfilled
+// block, and that their proposer signatures are valid. It returns the root of the lowest block.
+func (s *Service) verifyBatch(blocks []interfaces.SignedBeaconBlock) ([32]byte, error) {
+	expected := s.parentRoot
+	for i := len(blocks) - 1; i >= 0; i-- {
+		if err := wrapper.BeaconBlockIsNil(blocks[i]); err != nil {
+			return [32]byte{}, err
+		}
+		root, err := blocks[i].Block().HashTreeRoot()
+		if err != nil {
+			return [32]byte{}, err
+		}
+		if root != expected {
+			return [32]byte{}, errors.Wrapf(errUnlinkedBatch, "block at slot %d has root %#x, expected %#x",
+				blocks[i].Block().Slot(), root, expected)
+		}
+		expected = bytesutil.ToBytes32(blocks[i].Block().ParentRoot())
+	}
+	if err := s.verifier.verifySignatures(blocks); err != nil {
+		return [32]byte{}, err
+	}
+	return blocks[0].Block().HashTreeRoot()
+}
+
+// selectPeer returns the next peer to request blocks from, among the peers which finalized the origin block.
+func (s *Service) selectPeer() (peer.ID, error) {
+	_, pids := s.cfg.p2p.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, slots.ToEpoch(s.status.EndGap()))
+	if len(pids) == 0 {
+		return "", errNoPeers
+	}
+	s.nextPeer = (s.nextPeer + 1) % len(pids)
+	return pids[s.nextPeer], nil
+}
@@ -0,0 +1,185 @@
+package backfill
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+type testChain struct {
+	genesisRoot [32]byte
+	originRoot  [32]byte
+	// blocks holds the blocks between genesis and the origin block, sorted by slot.
+	blocks []interfaces.SignedBeaconBlock
+}
+
+// setupCheckpointSyncedDB creates a chain with a block at every slot of blockSlots, and initializes the database
+// the way checkpoint sync does, with the genesis block and the last block of the chain as origin block.
+func setupCheckpointSyncedDB(t *testing.T, blockSlots []types.Slot) (db.Database, *testChain) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	st, keys := util.DeterministicGenesisState(t, 64)
+
+	genesis := util.NewBeaconBlock()
+	wsb, err := wrapper.WrappedSignedBeaconBlock(genesis)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, wsb))
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	chain := &testChain{genesisRoot: genesisRoot}
+	parentRoot := genesisRoot
+	var origin interfaces.SignedBeaconBlock
+	for i, slot := range blockSlots {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ProposerIndex = types.ValidatorIndex(uint64(slot) % 64)
+		b.Block.ParentRoot = bytesutil.SafeCopyBytes(parentRoot[:])
+		b.Signature, err = signing.ComputeDomainAndSign(st, 0, b.Block, params.BeaconConfig().DomainBeaconProposer, keys[b.Block.ProposerIndex])
+		require.NoError(t, err)
+		parentRoot, err = b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		wsb, err := wrapper.WrappedSignedBeaconBlock(b)
+		require.NoError(t, err)
+		if i == len(blockSlots)-1 {
+			origin = wsb
+			continue
+		}
+		chain.blocks = append(chain.blocks, wsb)
+	}
+	chain.originRoot = parentRoot
+	serState, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	serBlock, err := origin.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveOrigin(ctx, serState, serBlock))
+	return beaconDB, chain
+}
+
+// requester serves the blocks of the chain, optionally passing them through tamper first.
+func (c *testChain) requester(tamper func([]interfaces.SignedBeaconBlock) []interfaces.SignedBeaconBlock) BlocksByRangeRequester {
+	return func(_ context.Context, _ peer.ID, req *ethpb.BeaconBlocksByRangeRequest) ([]interfaces.SignedBeaconBlock, error) {
+		blocks := make([]interfaces.SignedBeaconBlock, 0)
+		for _, b := range c.blocks {
+			if b.Block().Slot() >= req.StartSlot && b.Block().Slot() < req.StartSlot.Add(req.Count) {
+				blocks = append(blocks, b)
+			}
+		}
+		if tamper != nil {
+			return tamper(blocks), nil
+		}
+		return blocks, nil
+	}
+}
+
+func setupService(t *testing.T, beaconDB db.Database, requester BlocksByRangeRequester) (*Service, peer.ID) {
+	ctx := context.Background()
+	p := p2ptest.NewTestP2P(t)
+	pid := peer.ID("backfill-peer")
+	p.Peers().Add(nil, pid, nil, network.DirOutbound)
+	p.Peers().SetConnectionState(pid, peers.PeerConnected)
+	p.Peers().SetChainState(pid, &ethpb.Status{FinalizedEpoch: 10})
+
+	status := NewStatus(beaconDB)
+	require.NoError(t, status.Reload(ctx))
+	s, err := NewService(ctx,
+		WithStatus(status),
+		WithDatabase(beaconDB),
+		WithP2P(p),
+		WithBlocksByRangeRequester(requester),
+		WithBatchSize(4),
+		WithBatchInterval(time.Millisecond),
+	)
+	require.NoError(t, err)
+	return s, pid
+}
+
+func TestNewService_MissingRequired(t *testing.T) {
+	_, err := NewService(context.Background(), WithBatchSize(4))
+	require.ErrorIs(t, err, errMissingRequired)
+}
+
+func TestService_Backfill(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, chain := setupCheckpointSyncedDB(t, []types.Slot{1, 2, 3, 5, 6, 9, 10, 11, 12, 17, 18, 20})
+	s, _ := setupService(t, beaconDB, chain.requester(nil))
+
+	s.Start()
+	require.Equal(t, true, s.status.Complete())
+	for _, b := range chain.blocks {
+		root, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, true, beaconDB.HasBlock(ctx, root))
+		require.Equal(t, true, beaconDB.IsFinalizedBlock(ctx, root))
+	}
+	bfRoot, err := beaconDB.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, chain.genesisRoot, bfRoot)
+
+	// A restart picks up the completed backfill.
+	status := NewStatus(beaconDB)
+	require.NoError(t, status.Reload(ctx))
+	require.Equal(t, true, status.Complete())
+}
+
+func TestService_BackfillBatch_InvalidBlocks(t *testing.T) {
+	ctx := context.Background()
+	slotList := []types.Slot{1, 2, 3, 4, 5, 6}
+
+	t.Run("invalid signature", func(t *testing.T) {
+		beaconDB, chain := setupCheckpointSyncedDB(t, slotList)
+		s, pid := setupService(t, beaconDB, chain.requester(func(blocks []interfaces.SignedBeaconBlock) []interfaces.SignedBeaconBlock {
+			// Give the first block the signature of the second one, which keeps the roots linked.
+			first, err := blocks[0].PbPhase0Block()
+			require.NoError(t, err)
+			second, err := blocks[1].PbPhase0Block()
+			require.NoError(t, err)
+			wsb, err := wrapper.WrappedSignedBeaconBlock(&ethpb.SignedBeaconBlock{Block: first.Block, Signature: second.Signature})
+			require.NoError(t, err)
+			return append([]interfaces.SignedBeaconBlock{wsb}, blocks[1:]...)
+		}))
+		require.NoError(t, s.initialize(ctx))
+		err := s.backfillBatch(ctx)
+		require.ErrorIs(t, err, errInvalidBatchSignature)
+		assert.Equal(t, types.Slot(6), s.status.EndGap())
+		count, err := s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Count(pid)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+	t.Run("unlinked blocks", func(t *testing.T) {
+		beaconDB, chain := setupCheckpointSyncedDB(t, slotList)
+		s, pid := setupService(t, beaconDB, chain.requester(func(blocks []interfaces.SignedBeaconBlock) []interfaces.SignedBeaconBlock {
+			// Drop the highest block, so that the rest does not link to the origin block.
+			return blocks[:len(blocks)-1]
+		}))
+		require.NoError(t, s.initialize(ctx))
+		err := s.backfillBatch(ctx)
+		require.ErrorIs(t, err, errUnlinkedBatch)
+		assert.Equal(t, types.Slot(6), s.status.EndGap())
+		assert.Equal(t, types.Slot(6), s.nextEnd)
+		count, err := s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Count(pid)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		root, err := chain.blocks[0].Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, false, beaconDB.HasBlock(ctx, root))
+	})
+}
@@ -2,12 +2,14 @@ package backfill
 
 import (
 	"context"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 )
 
 // NewStatus correctly initializes a Status value with the required database value.
@@ -19,55 +21,118 @@ func NewStatus(store BackfillDB) *Status {
 
 // Status provides a way to update and query the status of a backfill process that may be necessary to track when
 // a node was initialized via checkpoint sync. With checkpoint sync, there will be a gap in node history from genesis
-// until the checkpoint sync origin block. Status provides the means to update the value keeping track of the lower
-// end of the missing block range via the Advance() method, to check whether a Slot is missing from the database
-// via the SlotCovered() method, and to see the current StartGap() and EndGap().
+// until the checkpoint sync origin block. History is backfilled backwards from the origin block, following the parent
+// roots of the blocks, so that every backfilled block is known to be an ancestor of the origin. Status provides the
+// means to update the value keeping track of the upper end of the missing block range via the Advance() method, to
+// check whether a Slot is missing from the database via the SlotCovered() method, and to see the current StartGap()
+// and EndGap().
+// When the history of the node is pruned, the blocks below the pruned slot are not covered anymore, and they do not
+// need to be backfilled either. MarkPruned() keeps track of the pruned slot.
 type Status struct {
+	lock        sync.RWMutex
 	start       types.Slot
 	end         types.Slot
+	pruned      types.Slot
 	store       BackfillDB
 	genesisSync bool
 }
 
 // SlotCovered uses StartGap() and EndGap() to determine if the given slot is covered by the current chain history.
 // If the slot is <= StartGap(), or >= EndGap(), the result is true.
-// If the slot is between StartGap() and EndGap(), the result is false.
+// If the slot is between StartGap() and EndGap(), or was pruned, the result is false.
 func (s *Status) SlotCovered(sl types.Slot) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	// the genesis block and state are never pruned
+	if s.start < sl && sl < s.pruned {
+		return false
+	}
 	// short circuit if the node was synced from genesis
 	if s.genesisSync {
 		return true
 	}
-	if s.StartGap() < sl && sl < s.EndGap() {
+	if s.start < sl && sl < s.end {
 		return false
 	}
 	return true
 }
 
-// StartGap returns the slot at the beginning of the range that needs to be backfilled.
+// StartGap returns the slot at the beginning of the range that needs to be backfilled. The range starts right after
+// this slot, which is the genesis slot, or the slot below the pruned slot when the history was pruned.
 func (s *Status) StartGap() types.Slot {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.startGap()
+}
+
+func (s *Status) startGap() types.Slot {
+	if s.pruned > s.start+1 {
+		return s.pruned - 1
+	}
 	return s.start
 }
 
-// EndGap returns the slot at the end of the range that needs to be backfilled.
+// PrunedSlot returns the slot below which the blocks and states were pruned, or zero if the history was not pruned.
+func (s *Status) PrunedSlot() types.Slot {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.pruned
+}
+
+// MarkPruned records that the blocks and states below the given slot, except for the genesis ones, were deleted
+// from the database.
+func (s *Status) MarkPruned(slot types.Slot) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if slot > s.pruned {
+		s.pruned = slot
+	}
+}
+
+// EndGap returns the slot at the end of the range that needs to be backfilled, which is the slot of the lowest block
+// backfilled so far, or the slot of the origin block before anything was backfilled.
 func (s *Status) EndGap() types.Slot {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
 	return s.end
 }
 
+// Complete returns true if there is nothing to backfill, either because the node was synced from genesis or because
+// the gap between genesis, or the pruned slot, and the origin block has been filled.
+func (s *Status) Complete() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.genesisSync || s.end <= s.startGap()
+}
+
 var ErrAdvancePastOrigin = errors.New("cannot advance backfill Status beyond the origin checkpoint slot")
 
-// Advance advances the backfill position to the given slot & root.
+// Advance advances the backfill position down to the given slot & root, which identify the lowest block of the chain
+// history connected to the origin block.
 // It updates the backfill block root entry in the database,
 // and also updates the Status value's copy of the backfill position slot.
 func (s *Status) Advance(ctx context.Context, upTo types.Slot, root [32]byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
 	if upTo > s.end {
 		return errors.Wrapf(ErrAdvancePastOrigin, "advance slot=%d, origin slot=%d", upTo, s.end)
 	}
-	s.start = upTo
-	return s.store.SaveBackfillBlockRoot(ctx, root)
+	if err := s.store.SaveBackfillBlockRoot(ctx, root); err != nil {
+		return err
+	}
+	s.end = upTo
+	return nil
 }
 
 // Reload queries the database for backfill status, initializing the internal data and validating the database state.
 func (s *Status) Reload(ctx context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	pruned, err := s.store.PrunedHistorySlot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error retrieving pruned history slot")
+	}
+	s.pruned = pruned
 	cpRoot, err := s.store.OriginCheckpointBlockRoot(ctx)
 	if err != nil {
 		// mark genesis sync and short circuit further lookups
@@ -84,15 +149,22 @@ func (s *Status) Reload(ctx context.Context) error {
 	if err := wrapper.BeaconBlockIsNil(cpBlock); err != nil {
 		return err
 	}
-	s.end = cpBlock.Block().Slot()
 
-	_, err = s.store.GenesisBlockRoot(ctx)
+	genesisRoot, err := s.store.GenesisBlockRoot(ctx)
 	if err != nil {
 		if errors.Is(err, db.ErrNotFoundGenesisBlockRoot) {
 			return errors.Wrap(err, "genesis block root required for checkpoint sync")
 		}
 		return err
 	}
+	genesisBlock, err := s.store.Block(ctx, genesisRoot)
+	if err != nil {
+		return errors.Wrapf(err, "error retrieving block for genesis root=%#x", genesisRoot)
+	}
+	if err := wrapper.BeaconBlockIsNil(genesisBlock); err != nil {
+		return err
+	}
+	s.start = genesisBlock.Block().Slot()
 
 	bfRoot, err := s.store.BackfillBlockRoot(ctx)
 	if err != nil {
@@ -101,14 +173,31 @@ func (s *Status) Reload(ctx context.Context) error {
 		}
 		return err
 	}
+	if bfRoot == genesisRoot {
+		// Checkpoint sync saves the genesis root as the initial backfill position, which is also the final position
+		// once the history is backfilled. The backfill is only complete if the parent of the origin block is known.
+		parent, err := s.store.Block(ctx, bytesutil.ToBytes32(cpBlock.Block().ParentRoot()))
+		if err != nil {
+			return errors.Wrapf(err, "error retrieving parent block of origin checkpoint root=%#x", cpRoot)
+		}
+		if parent == nil || parent.IsNil() {
+			s.end = cpBlock.Block().Slot()
+			return nil
+		}
+	}
 	bfBlock, err := s.store.Block(ctx, bfRoot)
 	if err != nil {
 		return errors.Wrapf(err, "error retrieving block for backfill root=%#x", bfRoot)
 	}
+	if (bfBlock == nil || bfBlock.IsNil()) && s.pruned > 0 {
+		// The lowest backfilled block was pruned, history was backfilled down to the pruned slot.
+		s.end = s.startGap()
+		return nil
+	}
 	if err := wrapper.BeaconBlockIsNil(bfBlock); err != nil {
 		return err
 	}
-	s.start = bfBlock.Block().Slot()
+	s.end = bfBlock.Block().Slot()
 	return nil
 }
 
@@ -118,5 +207,6 @@ type BackfillDB interface {
 	GenesisBlockRoot(ctx context.Context) ([32]byte, error)
 	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
 	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
+	PrunedHistorySlot(ctx context.Context) (types.Slot, error)
 	Block(ctx context.Context, blockRoot [32]byte) (interfaces.SignedBeaconBlock, error)
 }
@@ -22,6 +22,7 @@ type mockBackfillDB struct {
 	genesisBlockRoot          func(ctx context.Context) ([32]byte, error)
 	originCheckpointBlockRoot func(ctx context.Context) ([32]byte, error)
 	backfillBlockRoot         func(ctx context.Context) ([32]byte, error)
+	prunedHistorySlot         func(ctx context.Context) (types.Slot, error)
 	block                     func(ctx context.Context, blockRoot [32]byte) (interfaces.SignedBeaconBlock, error)
 }
 
@@ -55,6 +56,14 @@ func (db *mockBackfillDB) BackfillBlockRoot(ctx context.Context) ([32]byte, erro
 	return [32]byte{}, errEmptyMockDBMethod
 }
 
+func (db *mockBackfillDB) PrunedHistorySlot(ctx context.Context) (types.Slot, error) {
+	if db.prunedHistorySlot != nil {
+		return db.prunedHistorySlot(ctx)
+	}
+	// the history of most test databases is not pruned
+	return 0, nil
+}
+
 func (db *mockBackfillDB) Block(ctx context.Context, blockRoot [32]byte) (interfaces.SignedBeaconBlock, error) {
 	if db.block != nil {
 		return db.block(ctx, blockRoot)
@@ -105,6 +114,24 @@ func TestSlotCovered(t *testing.T) {
 			slot:   100,
 			result: true,
 		},
+		{
+			name:   "pruned false",
+			status: &Status{genesisSync: true, pruned: 10},
+			slot:   9,
+			result: false,
+		},
+		{
+			name:   "equal pruned true",
+			status: &Status{genesisSync: true, pruned: 10},
+			slot:   10,
+			result: true,
+		},
+		{
+			name:   "genesis never pruned",
+			status: &Status{pruned: 10},
+			slot:   0,
+			result: true,
+		},
 	}
 	for _, c := range cases {
 		result := c.status.SlotCovered(c.slot)
@@ -126,8 +153,8 @@ func TestAdvance(t *testing.T) {
 	copy(root[:], []byte{0x23, 0x23})
 	require.NoError(t, s.Advance(ctx, 90, root))
 	require.Equal(t, root, saveBackfillBuf[0])
-	not := s.SlotCovered(95)
-	require.Equal(t, false, not)
+	require.Equal(t, true, s.SlotCovered(95))
+	require.Equal(t, false, s.SlotCovered(85))
 
 	// this should still be len 1 after failing to advance
 	require.Equal(t, 1, len(saveBackfillBuf))
@@ -136,6 +163,28 @@ func TestAdvance(t *testing.T) {
 	require.Equal(t, 1, len(saveBackfillBuf))
 }
 
+func TestMarkPruned(t *testing.T) {
+	s := &Status{start: 0, end: 100}
+	require.Equal(t, false, s.Complete())
+	require.Equal(t, types.Slot(0), s.StartGap())
+
+	s.MarkPruned(50)
+	require.Equal(t, types.Slot(50), s.PrunedSlot())
+	require.Equal(t, types.Slot(49), s.StartGap())
+	require.Equal(t, false, s.Complete())
+	require.Equal(t, false, s.SlotCovered(49))
+	require.Equal(t, false, s.SlotCovered(50))
+
+	// The pruned slot never goes backwards.
+	s.MarkPruned(40)
+	require.Equal(t, types.Slot(50), s.PrunedSlot())
+
+	// History below the origin block is not needed anymore once it is pruned.
+	s.MarkPruned(101)
+	require.Equal(t, true, s.Complete())
+	require.Equal(t, true, s.SlotCovered(101))
+}
+
 func goodBlockRoot(root [32]byte) func(ctx context.Context) ([32]byte, error) {
 	return func(ctx context.Context) ([32]byte, error) {
 		return root, nil
@@ -160,13 +209,18 @@ func TestReload(t *testing.T) {
 	copy(originRoot[:], []byte{0x01})
 	originBlock, err := setupTestBlock(originSlot)
 	require.NoError(t, err)
+	require.NoError(t, wrapper.SetBlockParentRoot(originBlock, [32]byte{0x03}))
 
 	backfillSlot := types.Slot(50)
 	var backfillRoot [32]byte
-	copy(originRoot[:], []byte{0x02})
+	copy(backfillRoot[:], []byte{0x02})
 	backfillBlock, err := setupTestBlock(backfillSlot)
 	require.NoError(t, err)
 
+	genesisRoot := params.BeaconConfig().ZeroHash
+	genesisBlock, err := setupTestBlock(0)
+	require.NoError(t, err)
+
 	cases := []struct {
 		name     string
 		db       BackfillDB
@@ -270,6 +324,8 @@ func TestReload(t *testing.T) {
 					switch root {
 					case originRoot:
 						return originBlock, nil
+					case genesisRoot:
+						return genesisBlock, nil
 					case backfillRoot:
 						return nil, nil
 					}
@@ -290,6 +346,8 @@ func TestReload(t *testing.T) {
 					switch root {
 					case originRoot:
 						return originBlock, nil
+					case genesisRoot:
+						return genesisBlock, nil
 					case backfillRoot:
 						return nil, nil
 					}
@@ -308,6 +366,8 @@ func TestReload(t *testing.T) {
 					switch root {
 					case originRoot:
 						return originBlock, nil
+					case genesisRoot:
+						return genesisBlock, nil
 					case backfillRoot:
 						return nil, derp
 					}
@@ -326,6 +386,8 @@ func TestReload(t *testing.T) {
 					switch root {
 					case originRoot:
 						return originBlock, nil
+					case genesisRoot:
+						return genesisBlock, nil
 					case backfillRoot:
 						return backfillBlock, nil
 					}
@@ -334,7 +396,74 @@ func TestReload(t *testing.T) {
 				backfillBlockRoot: goodBlockRoot(backfillRoot),
 			},
 			err:      derp,
-			expected: &Status{genesisSync: false, start: backfillSlot, end: originSlot},
+			expected: &Status{genesisSync: false, start: 0, end: backfillSlot},
+		},
+		{
+			name: "genesis backfill root, origin parent missing",
+			db: &mockBackfillDB{
+				genesisBlockRoot:          goodBlockRoot(genesisRoot),
+				originCheckpointBlockRoot: goodBlockRoot(originRoot),
+				block: func(ctx context.Context, root [32]byte) (interfaces.SignedBeaconBlock, error) {
+					switch root {
+					case originRoot:
+						return originBlock, nil
+					case genesisRoot:
+						return genesisBlock, nil
+					}
+					return nil, nil
+				},
+				backfillBlockRoot: goodBlockRoot(genesisRoot),
+			},
+			expected: &Status{genesisSync: false, start: 0, end: originSlot},
+		},
+		{
+			name: "genesis backfill root, backfill complete",
+			db: &mockBackfillDB{
+				genesisBlockRoot:          goodBlockRoot(genesisRoot),
+				originCheckpointBlockRoot: goodBlockRoot(originRoot),
+				block: func(ctx context.Context, root [32]byte) (interfaces.SignedBeaconBlock, error) {
+					switch root {
+					case originRoot:
+						return originBlock, nil
+					case genesisRoot:
+						return genesisBlock, nil
+					}
+					// The parent of the origin block.
+					return backfillBlock, nil
+				},
+				backfillBlockRoot: goodBlockRoot(genesisRoot),
+			},
+			expected: &Status{genesisSync: false, start: 0, end: 0},
+		},
+		{
+			name: "backfill block pruned",
+			db: &mockBackfillDB{
+				genesisBlockRoot:          goodBlockRoot(genesisRoot),
+				originCheckpointBlockRoot: goodBlockRoot(originRoot),
+				prunedHistorySlot: func(ctx context.Context) (types.Slot, error) {
+					return 60, nil
+				},
+				block: func(ctx context.Context, root [32]byte) (interfaces.SignedBeaconBlock, error) {
+					switch root {
+					case originRoot:
+						return originBlock, nil
+					case genesisRoot:
+						return genesisBlock, nil
+					}
+					return nil, nil
+				},
+				backfillBlockRoot: goodBlockRoot(backfillRoot),
+			},
+			expected: &Status{genesisSync: false, start: 0, end: 59, pruned: 60},
+		},
+		{
+			name: "pruned history error",
+			db: &mockBackfillDB{
+				prunedHistorySlot: func(ctx context.Context) (types.Slot, error) {
+					return 0, derp
+				},
+			},
+			err: derp,
 		},
 	}
 
@@ -354,5 +483,6 @@ func TestReload(t *testing.T) {
 		require.Equal(t, c.expected.genesisSync, s.genesisSync)
 		require.Equal(t, c.expected.start, s.start)
 		require.Equal(t, c.expected.end, s.end)
+		require.Equal(t, c.expected.pruned, s.pruned)
 	}
 }
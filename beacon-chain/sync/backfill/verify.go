@@ -0,0 +1,73 @@
+package backfill
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/network/forks"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+var errInvalidBatchSignature = errors.New("invalid proposer signature in backfilled blocks")
+
+// verifier checks the proposer signatures of backfilled blocks against the validator registry of the origin state.
+// Validators are never removed from the registry, so the origin state knows the proposer of every earlier block.
+type verifier struct {
+	st                    state.ReadOnlyBeaconState
+	schedule              forks.OrderedSchedule
+	genesisValidatorsRoot []byte
+}
+
+func newVerifier(st state.BeaconState) (*verifier, error) {
+	if st == nil || st.IsNil() {
+		return nil, errors.New("nil origin state")
+	}
+	return &verifier{
+		st:                    st,
+		schedule:              forks.NewOrderedSchedule(params.BeaconConfig()),
+		genesisValidatorsRoot: st.GenesisValidatorsRoot(),
+	}, nil
+}
+
+// verifySignatures verifies the proposer signatures of the blocks as a single signature batch.
+func (v *verifier) verifySignatures(blocks []interfaces.SignedBeaconBlock) error {
+	var set *bls.SignatureBatch
+	for _, b := range blocks {
+		blk := b.Block()
+		if uint64(blk.ProposerIndex()) >= uint64(v.st.NumValidators()) {
+			return errors.Errorf("block at slot %d has unknown proposer index %d", blk.Slot(), blk.ProposerIndex())
+		}
+		pub := v.st.PubkeyAtIndex(blk.ProposerIndex())
+		version, err := v.schedule.VersionForEpoch(slots.ToEpoch(blk.Slot()))
+		if err != nil {
+			return errors.Wrapf(err, "could not determine fork version of block at slot %d", blk.Slot())
+		}
+		domain, err := signing.ComputeDomain(params.BeaconConfig().DomainBeaconProposer, version[:], v.genesisValidatorsRoot)
+		if err != nil {
+			return err
+		}
+		batch, err := signing.BlockSignatureBatch(pub[:], b.Signature(), domain, blk.HashTreeRoot)
+		if err != nil {
+			return errors.Wrapf(err, "could not build signature batch for block at slot %d", blk.Slot())
+		}
+		if set == nil {
+			set = batch
+			continue
+		}
+		set = set.Join(batch)
+	}
+	if set == nil {
+		return nil
+	}
+	valid, err := set.Verify()
+	if err != nil {
+		return errors.Wrap(err, "could not verify signatures of backfilled blocks")
+	}
+	if !valid {
+		return errInvalidBatchSignature
+	}
+	return nil
+}
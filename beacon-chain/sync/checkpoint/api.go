@@ -10,20 +10,41 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// quorumAttempts bounds the number of times the providers are polled for the finalized root, as the finalized
+// checkpoint can move between the poll and the download of the checkpoint data.
+const quorumAttempts = 3
+
+var errQuorumNotReached = errors.New("checkpoint sync providers did not reach quorum on the finalized block root")
+
 // APIInitializer manages initializing the beacon node using checkpoint sync, retrieving the checkpoint state and root
-// from the remote beacon node api.
+// from the remote beacon node api. When several beacon nodes are used, the checkpoint is only trusted once a quorum of
+// them agree on the finalized block root.
 type APIInitializer struct {
-	c *beacon.Client
+	clients []*beacon.Client
+	quorum  int
 }
 
 // NewAPIInitializer creates an APIInitializer, handling the set up of a beacon node api client
-// using the provided host string.
-func NewAPIInitializer(beaconNodeHost string) (*APIInitializer, error) {
-	c, err := beacon.NewClient(beaconNodeHost)
-	if err != nil {
-		return nil, errors.Wrapf(err, "unable to parse beacon node url or hostname - %s", beaconNodeHost)
+// for each of the provided host strings. A quorum of 0 requires a majority of the beacon nodes to agree.
+func NewAPIInitializer(beaconNodeHosts []string, quorum int) (*APIInitializer, error) {
+	if len(beaconNodeHosts) == 0 {
+		return nil, errors.New("at least one beacon node is required for checkpoint sync")
+	}
+	if quorum == 0 {
+		quorum = len(beaconNodeHosts)/2 + 1
+	}
+	if quorum < 0 || quorum > len(beaconNodeHosts) {
+		return nil, errors.Errorf("checkpoint sync quorum must be between 1 and the number of beacon nodes (%d), got %d", len(beaconNodeHosts), quorum)
 	}
-	return &APIInitializer{c: c}, nil
+	clients := make([]*beacon.Client, len(beaconNodeHosts))
+	for i, host := range beaconNodeHosts {
+		c, err := beacon.NewClient(host)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to parse beacon node url or hostname - %s", host)
+		}
+		clients[i] = c
+	}
+	return &APIInitializer{clients: clients, quorum: quorum}, nil
 }
 
 // Initialize downloads origin state and block for checkpoint sync and initializes database records to
@@ -38,9 +59,69 @@ func (dl *APIInitializer) Initialize(ctx context.Context, d db.Database) error {
 			return errors.Wrap(err, "error while checking database for origin root")
 		}
 	}
-	od, err := beacon.DownloadFinalizedData(ctx, dl.c)
+	od, err := dl.downloadAgreedFinalizedData(ctx)
 	if err != nil {
 		return errors.Wrap(err, "Error retrieving checkpoint origin state and block")
 	}
 	return d.SaveOrigin(ctx, od.StateBytes(), od.BlockBytes())
 }
+
+// downloadAgreedFinalizedData downloads the finalized state and block from one of the beacon nodes agreeing on the
+// finalized block root, and checks that the downloaded block is the agreed one.
+func (dl *APIInitializer) downloadAgreedFinalizedData(ctx context.Context) (*beacon.OriginData, error) {
+	for i := 0; i < quorumAttempts; i++ {
+		root, agreeing, err := dl.finalizedRootQuorum(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range agreeing {
+			od, err := beacon.DownloadFinalizedData(ctx, c)
+			if err != nil {
+				log.WithError(err).Warnf("unable to download checkpoint data from %s", c.NodeURL())
+				continue
+			}
+			if err := od.VerifyBlock(); err != nil {
+				log.WithError(err).Warnf("invalid checkpoint data from %s", c.NodeURL())
+				continue
+			}
+			if od.BlockRoot() != root {
+				log.Warnf("finalized block root of %s changed from %#x to %#x, polling beacon nodes again", c.NodeURL(), root, od.BlockRoot())
+				break
+			}
+			return od, nil
+		}
+	}
+	return nil, errors.Errorf("unable to download checkpoint data agreed upon by %d beacon nodes", dl.quorum)
+}
+
+// finalizedRootQuorum requests the finalized block root from every beacon node, and returns the root reported by at
+// least a quorum of them, along with the clients of the beacon nodes reporting it.
+func (dl *APIInitializer) finalizedRootQuorum(ctx context.Context) ([32]byte, []*beacon.Client, error) {
+	votes := make(map[[32]byte][]*beacon.Client)
+	for _, c := range dl.clients {
+		r, err := c.GetBlockRoot(ctx, beacon.IdFinalized)
+		if err != nil {
+			log.WithError(err).Warnf("unable to get finalized block root from %s", c.NodeURL())
+			continue
+		}
+		log.Infof("%s reports finalized block root %#x", c.NodeURL(), r)
+		votes[r] = append(votes[r], c)
+	}
+	var root [32]byte
+	var agreed []*beacon.Client
+	for r, agreeing := range votes {
+		if len(agreeing) < dl.quorum {
+			continue
+		}
+		// With a quorum lower than a majority, conflicting roots can both reach it.
+		if agreed != nil {
+			return [32]byte{}, nil, errors.Wrapf(errQuorumNotReached, "both %#x and %#x reached the quorum of %d beacon nodes", root, r, dl.quorum)
+		}
+		root, agreed = r, agreeing
+	}
+	if agreed == nil {
+		return [32]byte{}, nil, errors.Wrapf(errQuorumNotReached, "%d of %d beacon nodes must agree, got %d distinct roots",
+			dl.quorum, len(dl.clients), len(votes))
+	}
+	return root, agreed, nil
+}
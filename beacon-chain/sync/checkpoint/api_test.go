@@ -0,0 +1,113 @@
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func finalizedRootServer(t *testing.T, root [32]byte) string {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/eth/v1/beacon/blocks/finalized/root" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, err := fmt.Fprintf(w, `{"data":{"root":"%#x"}}`, root)
+		require.NoError(t, err)
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+func failingServer(t *testing.T) string {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+func TestNewAPIInitializer_Quorum(t *testing.T) {
+	hosts := []string{"http://localhost:3500", "http://localhost:3501", "http://localhost:3502"}
+	dl, err := NewAPIInitializer(hosts, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, dl.quorum)
+	dl, err = NewAPIInitializer(hosts[:1], 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, dl.quorum)
+	dl, err = NewAPIInitializer(hosts, 3)
+	require.NoError(t, err)
+	require.Equal(t, 3, dl.quorum)
+
+	_, err = NewAPIInitializer(hosts, 4)
+	require.ErrorContains(t, "quorum must be between 1 and the number of beacon nodes", err)
+	_, err = NewAPIInitializer(hosts, -1)
+	require.ErrorContains(t, "quorum must be between 1 and the number of beacon nodes", err)
+	_, err = NewAPIInitializer(nil, 0)
+	require.ErrorContains(t, "at least one beacon node is required", err)
+}
+
+func TestAPIInitializer_FinalizedRootQuorum(t *testing.T) {
+	ctx := context.Background()
+	rootA := [32]byte{0x0a}
+	rootB := [32]byte{0x0b}
+	rootC := [32]byte{0x0c}
+
+	cases := []struct {
+		name   string
+		hosts  []string
+		quorum int
+		root   [32]byte
+		agreed int
+		err    error
+	}{
+		{
+			name:   "majority agrees",
+			hosts:  []string{finalizedRootServer(t, rootA), finalizedRootServer(t, rootB), finalizedRootServer(t, rootA)},
+			root:   rootA,
+			agreed: 2,
+		},
+		{
+			name:  "no majority",
+			hosts: []string{finalizedRootServer(t, rootA), finalizedRootServer(t, rootB), finalizedRootServer(t, rootC)},
+			err:   errQuorumNotReached,
+		},
+		{
+			name:   "unreachable beacon node does not count",
+			hosts:  []string{finalizedRootServer(t, rootA), failingServer(t), finalizedRootServer(t, rootA)},
+			quorum: 2,
+			root:   rootA,
+			agreed: 2,
+		},
+		{
+			name:   "unreachable beacon node prevents unanimity",
+			hosts:  []string{finalizedRootServer(t, rootA), failingServer(t), finalizedRootServer(t, rootA)},
+			quorum: 3,
+			err:    errQuorumNotReached,
+		},
+		{
+			name:   "conflicting roots both reach a low quorum",
+			hosts:  []string{finalizedRootServer(t, rootA), finalizedRootServer(t, rootB)},
+			quorum: 1,
+			err:    errQuorumNotReached,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			dl, err := NewAPIInitializer(c.hosts, c.quorum)
+			require.NoError(t, err)
+			root, agreed, err := dl.finalizedRootQuorum(ctx)
+			if c.err != nil {
+				require.ErrorIs(t, err, c.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.root, root)
+			require.Equal(t, c.agreed, len(agreed))
+		})
+	}
+}
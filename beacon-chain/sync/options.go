@@ -6,6 +6,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/lightclient"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
@@ -128,3 +129,11 @@ func WithExecutionPayloadReconstructor(r powchain.ExecutionPayloadReconstructor)
 		return nil
 	}
 }
+
+// WithLightClientUpdates sets the source of the locally computed light client updates, which enables gossiping them.
+func WithLightClientUpdates(u lightclient.UpdatesFetcher) Option {
+	return func(s *Service) error {
+		s.cfg.lightClientUpdates = u
+		return nil
+	}
+}
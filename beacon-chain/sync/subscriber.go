@@ -117,6 +117,21 @@ func (s *Service) registerSubscribers(epoch types.Epoch, digest [4]byte) {
 				digest,
 			)
 		}
+		// Light client updates are only served by the nodes running the light client server.
+		if s.cfg.lightClientUpdates != nil {
+			s.subscribe(
+				p2p.LightClientFinalityUpdateTopicFormat,
+				s.validateLightClientFinalityUpdate,
+				s.lightClientUpdateSubscriber,
+				digest,
+			)
+			s.subscribe(
+				p2p.LightClientOptimisticUpdateTopicFormat,
+				s.validateLightClientOptimisticUpdate,
+				s.lightClientUpdateSubscriber,
+				digest,
+			)
+		}
 	}
 }
 
@@ -0,0 +1,13 @@
+package sync
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// lightClientUpdateSubscriber does nothing with the incoming light client updates, as only the updates matching the
+// ones computed locally are accepted.
+func (_ *Service) lightClientUpdateSubscriber(_ context.Context, _ proto.Message) error {
+	return nil
+}
@@ -130,11 +130,12 @@ func TestSubscribe_ReceivesAttesterSlashing(t *testing.T) {
 	r := Service{
 		ctx: ctx,
 		cfg: &config{
-			p2p:          p2pService,
-			initialSync:  &mockSync.Sync{IsSyncing: false},
-			slashingPool: slashings.NewPool(),
-			chain:        chainService,
-			beaconDB:     d,
+			p2p:               p2pService,
+			initialSync:       &mockSync.Sync{IsSyncing: false},
+			slashingPool:      slashings.NewPool(),
+			chain:             chainService,
+			beaconDB:          d,
+			operationNotifier: chainService.OperationNotifier(),
 		},
 		seenAttesterSlashingCache: make(map[uint64]bool),
 		chainStarted:              abool.New(),
@@ -182,11 +183,12 @@ func TestSubscribe_ReceivesProposerSlashing(t *testing.T) {
 	r := Service{
 		ctx: ctx,
 		cfg: &config{
-			p2p:          p2pService,
-			initialSync:  &mockSync.Sync{IsSyncing: false},
-			slashingPool: slashings.NewPool(),
-			chain:        chainService,
-			beaconDB:     d,
+			p2p:               p2pService,
+			initialSync:       &mockSync.Sync{IsSyncing: false},
+			slashingPool:      slashings.NewPool(),
+			chain:             chainService,
+			beaconDB:          d,
+			operationNotifier: chainService.OperationNotifier(),
 		},
 		seenProposerSlashingCache: lruwrpr.New(10),
 		chainStarted:              abool.New(),
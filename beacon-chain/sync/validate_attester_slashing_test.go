@@ -11,6 +11,8 @@ import (
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
@@ -81,9 +83,10 @@ func TestValidateAttesterSlashing_ValidSlashing(t *testing.T) {
 
 	r := &Service{
 		cfg: &config{
-			p2p:         p,
-			chain:       &mock.ChainService{State: s, Genesis: time.Now()},
-			initialSync: &mockSync.Sync{IsSyncing: false},
+			p2p:               p,
+			chain:             &mock.ChainService{State: s, Genesis: time.Now()},
+			initialSync:       &mockSync.Sync{IsSyncing: false},
+			operationNotifier: (&mock.ChainService{}).OperationNotifier(),
 		},
 		seenAttesterSlashingCache: make(map[uint64]bool),
 		subHandler:                newSubTopicHandler(),
@@ -103,12 +106,27 @@ func TestValidateAttesterSlashing_ValidSlashing(t *testing.T) {
 			Topic: &topic,
 		},
 	}
+	// Subscribe to operation notifications.
+	opChannel := make(chan *feed.Event, 1)
+	opSub := r.cfg.operationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+
 	res, err := r.validateAttesterSlashing(ctx, "foobar", msg)
 	assert.NoError(t, err)
 	valid := res == pubsub.ValidationAccept
 
 	assert.Equal(t, true, valid, "Failed Validation")
 	assert.NotNil(t, msg.ValidatorData, "Decoded message was not set on the message validator data")
+
+	// Ensure the operation notification was broadcast.
+	select {
+	case event := <-opChannel:
+		assert.Equal(t, opfeed.AttesterSlashingReceived, int(event.Type))
+		_, ok := event.Data.(*opfeed.AttesterSlashingReceivedData)
+		assert.Equal(t, true, ok, "Entity is not of type *opfeed.AttesterSlashingReceivedData")
+	default:
+		t.Error("No operation notification was broadcast")
+	}
 }
 
 func TestValidateAttesterSlashing_CanFilter(t *testing.T) {
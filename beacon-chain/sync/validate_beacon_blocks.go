@@ -198,6 +198,15 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		"proposerIndex":      blk.Block().ProposerIndex(),
 		"graffiti":           string(blk.Block().Body().Graffiti()),
 	}).Debug("Received block")
+
+	// Notify other services in the beacon node of a valid block received on gossip, before it is imported.
+	s.cfg.blockNotifier.BlockFeed().Send(&feed.Event{
+		Type: blockfeed.ReceivedGossipBlock,
+		Data: &blockfeed.ReceivedGossipBlockData{
+			SignedBlock: blk,
+			BlockRoot:   blockRoot,
+		},
+	})
 	return pubsub.ValidationAccept, nil
 }
 
@@ -0,0 +1,101 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/prysmaticlabs/prysm/beacon-chain/lightclient"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/monitoring/tracing"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	prysmTime "github.com/prysmaticlabs/prysm/time"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// validateLightClientFinalityUpdate only forwards the light client finality update computed locally from the
+// processed blocks, once the block of its signature slot had enough time to propagate through the network.
+func (s *Service) validateLightClientFinalityUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	ctx, span := trace.StartSpan(ctx, "sync.validateLightClientFinalityUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+	update, ok := m.(*ethpb.LightClientFinalityUpdate)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+	if update.AttestedHeader == nil || update.FinalizedHeader == nil || update.SyncAggregate == nil {
+		return pubsub.ValidationReject, errNilMessage
+	}
+	if s.receivedBeforePropagation(update.SignatureSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+	latest, err := s.cfg.lightClientUpdates.FinalityUpdate()
+	if err != nil || !proto.Equal(update, latest) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	msg.ValidatorData = update // Used in downstream subscriber
+	return pubsub.ValidationAccept, nil
+}
+
+// validateLightClientOptimisticUpdate only forwards the light client optimistic update computed locally from the
+// processed blocks, once the block of its signature slot had enough time to propagate through the network.
+func (s *Service) validateLightClientOptimisticUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	ctx, span := trace.StartSpan(ctx, "sync.validateLightClientOptimisticUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+	update, ok := m.(*ethpb.LightClientOptimisticUpdate)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+	if update.AttestedHeader == nil || update.SyncAggregate == nil {
+		return pubsub.ValidationReject, errNilMessage
+	}
+	if s.receivedBeforePropagation(update.SignatureSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+	latest, err := s.cfg.lightClientUpdates.OptimisticUpdate()
+	if err != nil || !proto.Equal(update, latest) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	msg.ValidatorData = update // Used in downstream subscriber
+	return pubsub.ValidationAccept, nil
+}
+
+// receivedBeforePropagation returns true if a light client update signed in the given slot is received before the
+// block of the slot had enough time to propagate, allowing for the maximum gossip clock disparity.
+func (s *Service) receivedBeforePropagation(signatureSlot types.Slot) bool {
+	propagation := lightclient.PropagationTime(uint64(s.cfg.chain.GenesisTime().Unix()), signatureSlot)
+	return prysmTime.Now().Add(params.BeaconNetworkConfig().MaximumGossipClockDisparity).Before(propagation)
+}
@@ -0,0 +1,133 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/lightclient"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+type mockLightClientUpdates struct {
+	finality   *ethpb.LightClientFinalityUpdate
+	optimistic *ethpb.LightClientOptimisticUpdate
+}
+
+func (m *mockLightClientUpdates) FinalityUpdate() (*ethpb.LightClientFinalityUpdate, error) {
+	if m.finality == nil {
+		return nil, lightclient.ErrNotFound
+	}
+	return m.finality, nil
+}
+
+func (m *mockLightClientUpdates) OptimisticUpdate() (*ethpb.LightClientOptimisticUpdate, error) {
+	if m.optimistic == nil {
+		return nil, lightclient.ErrNotFound
+	}
+	return m.optimistic, nil
+}
+
+func testOptimisticUpdate(slot types.Slot) *ethpb.LightClientOptimisticUpdate {
+	return &ethpb.LightClientOptimisticUpdate{
+		AttestedHeader: &ethpb.BeaconBlockHeader{
+			Slot:       slot - 1,
+			ParentRoot: make([]byte, fieldparams.RootLength),
+			StateRoot:  make([]byte, fieldparams.RootLength),
+			BodyRoot:   make([]byte, fieldparams.RootLength),
+		},
+		SyncAggregate: &ethpb.SyncAggregate{
+			SyncCommitteeBits:      make([]byte, fieldparams.SyncCommitteeLength/8),
+			SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
+		},
+		SignatureSlot: slot,
+	}
+}
+
+func TestValidateLightClientOptimisticUpdate(t *testing.T) {
+	ctx := context.Background()
+	const currentSlot = types.Slot(100)
+	genesis := time.Now().Add(-time.Duration(uint64(currentSlot)*params.BeaconConfig().SecondsPerSlot) * time.Second)
+
+	tests := []struct {
+		name     string
+		local    *ethpb.LightClientOptimisticUpdate
+		received *ethpb.LightClientOptimisticUpdate
+		syncing  bool
+		want     pubsub.ValidationResult
+	}{
+		{
+			name:     "matches local update",
+			local:    testOptimisticUpdate(currentSlot - 1),
+			received: testOptimisticUpdate(currentSlot - 1),
+			want:     pubsub.ValidationAccept,
+		},
+		{
+			name:     "no local update",
+			received: testOptimisticUpdate(currentSlot - 1),
+			want:     pubsub.ValidationIgnore,
+		},
+		{
+			name:     "differs from local update",
+			local:    testOptimisticUpdate(currentSlot - 2),
+			received: testOptimisticUpdate(currentSlot - 1),
+			want:     pubsub.ValidationIgnore,
+		},
+		{
+			name:     "received before propagation",
+			local:    testOptimisticUpdate(currentSlot),
+			received: testOptimisticUpdate(currentSlot),
+			want:     pubsub.ValidationIgnore,
+		},
+		{
+			name:     "syncing",
+			local:    testOptimisticUpdate(currentSlot - 1),
+			received: testOptimisticUpdate(currentSlot - 1),
+			syncing:  true,
+			want:     pubsub.ValidationIgnore,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := p2ptest.NewTestP2P(t)
+			r := &Service{
+				cfg: &config{
+					p2p:                p,
+					chain:              &mock.ChainService{Genesis: genesis, ValidatorsRoot: [32]byte{'A'}},
+					initialSync:        &mockSync.Sync{IsSyncing: tt.syncing},
+					lightClientUpdates: &mockLightClientUpdates{optimistic: tt.local},
+				},
+			}
+
+			buf := new(bytes.Buffer)
+			_, err := p.Encoding().EncodeGossip(buf, tt.received)
+			require.NoError(t, err)
+			topic := p2p.GossipTypeMapping[reflect.TypeOf(tt.received)]
+			d, err := r.currentForkDigest()
+			require.NoError(t, err)
+			topic = r.addDigestToTopic(topic, d)
+			m := &pubsub.Message{
+				Message: &pubsubpb.Message{
+					Data:  buf.Bytes(),
+					Topic: &topic,
+				},
+			}
+			res, err := r.validateLightClientOptimisticUpdate(ctx, "", m)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, res)
+		})
+	}
+}
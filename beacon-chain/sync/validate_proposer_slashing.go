@@ -6,6 +6,8 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/monitoring/tracing"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -66,6 +68,16 @@ func (s *Service) validateProposerSlashing(ctx context.Context, pid peer.ID, msg
 	}
 
 	msg.ValidatorData = slashing // Used in downstream subscriber
+
+	// Broadcast the proposer slashing on a feed to notify other services in the beacon node
+	// of a received proposer slashing.
+	s.cfg.operationNotifier.OperationFeed().Send(&feed.Event{
+		Type: opfeed.ProposerSlashingReceived,
+		Data: &opfeed.ProposerSlashingReceivedData{
+			ProposerSlashing: slashing,
+		},
+	})
+
 	return pubsub.ValidationAccept, nil
 }
 
@@ -12,6 +12,8 @@ import (
 	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
 	"github.com/prysmaticlabs/go-bitfield"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
 	coreTime "github.com/prysmaticlabs/prysm/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
@@ -115,9 +117,10 @@ func TestValidateProposerSlashing_ValidSlashing(t *testing.T) {
 
 	r := &Service{
 		cfg: &config{
-			p2p:         p,
-			chain:       &mock.ChainService{State: s, Genesis: time.Now()},
-			initialSync: &mockSync.Sync{IsSyncing: false},
+			p2p:               p,
+			chain:             &mock.ChainService{State: s, Genesis: time.Now()},
+			initialSync:       &mockSync.Sync{IsSyncing: false},
+			operationNotifier: (&mock.ChainService{}).OperationNotifier(),
 		},
 		seenProposerSlashingCache: lruwrpr.New(10),
 	}
@@ -136,11 +139,26 @@ func TestValidateProposerSlashing_ValidSlashing(t *testing.T) {
 		},
 	}
 
+	// Subscribe to operation notifications.
+	opChannel := make(chan *feed.Event, 1)
+	opSub := r.cfg.operationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+
 	res, err := r.validateProposerSlashing(ctx, "", m)
 	assert.NoError(t, err)
 	valid := res == pubsub.ValidationAccept
 	assert.Equal(t, true, valid, "Failed validation")
 	assert.NotNil(t, m.ValidatorData, "Decoded message was not set on the message validator data")
+
+	// Ensure the operation notification was broadcast.
+	select {
+	case event := <-opChannel:
+		assert.Equal(t, opfeed.ProposerSlashingReceived, int(event.Type))
+		_, ok := event.Data.(*opfeed.ProposerSlashingReceivedData)
+		assert.Equal(t, true, ok, "Entity is not of type *opfeed.ProposerSlashingReceivedData")
+	default:
+		t.Error("No operation notification was broadcast")
+	}
 }
 
 func TestValidateProposerSlashing_ContextTimeout(t *testing.T) {
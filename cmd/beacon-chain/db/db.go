@@ -3,6 +3,7 @@ package db
 import (
 	beacondb "github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/runtime/tos"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
@@ -31,5 +32,92 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "compact",
+			Description: `rewrites the database file so that it only holds the live data. The beacon node must be stopped`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.Compact(cliCtx); err != nil {
+					log.Fatalf("Could not compact database: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "stats",
+			Description: `prints the number of keys and the size of every bucket of the database. The beacon node must be stopped`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.BucketStats(cliCtx); err != nil {
+					log.Fatalf("Could not compute database statistics: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "migrate",
+			Usage: "defines commands for the migrations of the database schema",
+			Subcommands: []*cli.Command{
+				{
+					Name: "run",
+					Description: `runs the pending migrations of the database without starting the beacon node. ` +
+						`The beacon node must be stopped`,
+					Flags: cmd.WrapFlags(append([]cli.Flag{
+						cmd.DataDirFlag,
+					}, features.BeaconChainFlags...)),
+					Before: migrateBefore,
+					Action: func(cliCtx *cli.Context) error {
+						if err := beacondb.Migrate(cliCtx); err != nil {
+							log.Fatalf("Could not migrate database: %v", err)
+						}
+						return nil
+					},
+				},
+				{
+					Name:        "verify",
+					Description: `fails if the database has pending migrations. The beacon node must be stopped`,
+					Flags: cmd.WrapFlags(append([]cli.Flag{
+						cmd.DataDirFlag,
+					}, features.BeaconChainFlags...)),
+					Before: migrateBefore,
+					Action: func(cliCtx *cli.Context) error {
+						if err := beacondb.VerifyMigrations(cliCtx); err != nil {
+							log.Fatalf("Could not verify database migrations: %v", err)
+						}
+						return nil
+					},
+				},
+			},
+		},
+		{
+			Name: "rebuild-indices",
+			Description: `recreates the block slot and parent root indices from the blocks saved in the database. ` +
+				`The beacon node must be stopped`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.RebuildIndices(cliCtx); err != nil {
+					log.Fatalf("Could not rebuild database indices: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
+
+// migrateBefore configures the feature flags which select the migrations applying to the database, the same way
+// the beacon node does.
+func migrateBefore(cliCtx *cli.Context) error {
+	if err := tos.VerifyTosAcceptedOrPrompt(cliCtx); err != nil {
+		return err
+	}
+	return features.ConfigureBeaconChain(cliCtx)
+}
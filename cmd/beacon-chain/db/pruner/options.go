@@ -0,0 +1,45 @@
+package pruner
+
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/pruner"
+	"github.com/prysmaticlabs/prysm/beacon-chain/node"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// EnableHistoryPruning enables the deletion of the finalized blocks and states older than the retention window.
+	EnableHistoryPruning = &cli.BoolFlag{
+		Name: "enable-history-pruning",
+		Usage: "Delete the finalized blocks and states which are older than --history-retention-epochs from the " +
+			"database, so that the database does not keep growing. The node cannot serve the pruned history to peers " +
+			"and API clients.",
+	}
+	// HistoryRetentionEpochs sets the number of epochs of history kept below the finalized checkpoint.
+	HistoryRetentionEpochs = &cli.Uint64Flag{
+		Name: "history-retention-epochs",
+		Usage: "Number of epochs of history kept below the finalized checkpoint when history pruning is enabled. " +
+			"The history is kept down to the nearest saved state below the window, so that it can be replayed. " +
+			"Defaults to the weak subjectivity period.",
+		Value: uint64(params.BeaconConfig().WeakSubjectivityPeriod),
+	}
+	// HistoryPruneBatchSize sets the maximum number of slots pruned in a single database transaction.
+	HistoryPruneBatchSize = &cli.IntFlag{
+		Name:  "history-prune-batch-size",
+		Usage: "Maximum number of slots pruned from the database in a single transaction.",
+		Value: pruner.DefaultBatchSize,
+	}
+)
+
+// BeaconNodeOptions enables the pruner service when --enable-history-pruning is set, configured with the pruning
+// flags.
+func BeaconNodeOptions(c *cli.Context) (node.Option, error) {
+	if !c.Bool(EnableHistoryPruning.Name) {
+		return nil, nil
+	}
+	return node.WithPrunerOptions([]pruner.Option{
+		pruner.WithRetentionEpochs(types.Epoch(c.Uint64(HistoryRetentionEpochs.Name))),
+		pruner.WithBatchSize(c.Int(HistoryPruneBatchSize.Name)),
+	}), nil
+}
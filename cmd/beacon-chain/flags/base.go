@@ -16,6 +16,28 @@ var (
 		Usage: "A MEV builder relay string http endpoint, this wil be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder",
 		Value: "",
 	}
+	// LocalBlockValueBoost sets the percentage by which a builder bid must beat the local payload to be used.
+	LocalBlockValueBoost = &cli.Uint64Flag{
+		Name: "local-block-value-boost",
+		Usage: "A percentage boost applied to the value of the locally built execution payload when comparing it " +
+			"against the builder bid. The builder payload is only used if builder_bid_value * 100 > " +
+			"local_block_value * (local-block-value-boost + 100).",
+		Value: 0,
+	}
+	// MaxBuilderConsecutiveMissedSlots sets the number of consecutive missed slots after which the builder is bypassed.
+	MaxBuilderConsecutiveMissedSlots = &cli.IntFlag{
+		Name: "max-builder-consecutive-missed-slots",
+		Usage: "Number of consecutive skip slots to fallback from using relay/builder to local execution engine " +
+			"for block construction",
+		Value: 3,
+	}
+	// MaxBuilderEpochMissedSlots sets the number of missed slots in the last epoch after which the builder is bypassed.
+	MaxBuilderEpochMissedSlots = &cli.IntFlag{
+		Name: "max-builder-epoch-missed-slots",
+		Usage: "Number of total skip slots in the last epoch rolling window to fallback from using relay/builder " +
+			"to local execution engine for block construction",
+		Value: 8,
+	}
 	// HTTPWeb3ProviderFlag provides an HTTP access endpoint to an ETH 1.0 RPC.
 	HTTPWeb3ProviderFlag = &cli.StringFlag{
 		Name:  "http-web3provider",
@@ -142,11 +164,30 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the beaconDB.",
 		Value: 2048,
 	}
+	// ArchiveMode saves the state of every finalized slot, to serve historical states without replaying blocks.
+	ArchiveMode = &cli.BoolFlag{
+		Name: "archive-mode",
+		Usage: "Saves the state of every finalized slot in the beaconDB, as periodic snapshots and per-slot diffs, " +
+			"to serve historical states without replaying blocks. Requires the chain history since genesis. " +
+			"Results in additional storage usage",
+	}
+	// ArchiveSnapshotInterval specifies the number of slots between the full state snapshots saved in archive mode.
+	ArchiveSnapshotInterval = &cli.Uint64Flag{
+		Name:  "archive-snapshot-interval",
+		Usage: "The number of slots between the full state snapshots saved in archive mode. Lower values use more storage and serve historical states faster.",
+		Value: 2048,
+	}
 	// DisableDiscv5 disables running discv5.
 	DisableDiscv5 = &cli.BoolFlag{
 		Name:  "disable-discv5",
 		Usage: "Does not run the discoveryV5 dht.",
 	}
+	// PeerScoreMetrics exports the score breakdown of every peer as prometheus metrics.
+	PeerScoreMetrics = &cli.BoolFlag{
+		Name: "peer-score-metrics",
+		Usage: "Exports the score of every peer, broken down by scorer and gossip topic, as prometheus metrics. " +
+			"This creates metrics series per peer and per topic.",
+	}
 	// BlockBatchLimit specifies the requested block batch size.
 	BlockBatchLimit = &cli.IntFlag{
 		Name:  "block-batch-limit",
@@ -167,8 +208,15 @@ var (
 	}
 	// EnableDebugRPCEndpoints as /v1/beacon/state.
 	EnableDebugRPCEndpoints = &cli.BoolFlag{
-		Name:  "enable-debug-rpc-endpoints",
-		Usage: "Enables the debug rpc service, containing utility endpoints such as /eth/v1alpha1/beacon/state.",
+		Name: "enable-debug-rpc-endpoints",
+		Usage: "Enables the debug rpc service, containing utility endpoints such as /eth/v1alpha1/beacon/state " +
+			"and the peer access admin endpoints.",
+	}
+	// EnableCheckpointSyncServing enables the endpoints serving the finalized state and block for checkpoint sync.
+	EnableCheckpointSyncServing = &cli.BoolFlag{
+		Name: "enable-checkpoint-sync-serving",
+		Usage: "Serves the ssz-encoded finalized state and block on the gateway at /prysm/checkpoint/finalized/state " +
+			"and /prysm/checkpoint/finalized/block, so that other beacon nodes can use this node for checkpoint sync.",
 	}
 	// SubscribeToAllSubnets defines a flag to specify whether to subscribe to all possible attestation/sync subnets or not.
 	SubscribeToAllSubnets = &cli.BoolFlag{
@@ -16,9 +16,11 @@ import (
 	"github.com/prysmaticlabs/prysm/cmd"
 	blockchaincmd "github.com/prysmaticlabs/prysm/cmd/beacon-chain/blockchain"
 	dbcommands "github.com/prysmaticlabs/prysm/cmd/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/db/pruner"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	jwtcommands "github.com/prysmaticlabs/prysm/cmd/beacon-chain/jwt"
 	powchaincmd "github.com/prysmaticlabs/prysm/cmd/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/sync/backfill"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/sync/checkpoint"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/sync/genesis"
 	"github.com/prysmaticlabs/prysm/config/features"
@@ -55,6 +57,7 @@ var appFlags = []cli.Flag{
 	flags.HeadSync,
 	flags.DisableSync,
 	flags.DisableDiscv5,
+	flags.PeerScoreMetrics,
 	flags.BlockBatchLimit,
 	flags.BlockBatchLimitBurstFactor,
 	flags.InteropMockEth1DataVotesFlag,
@@ -62,7 +65,10 @@ var appFlags = []cli.Flag{
 	flags.InteropNumValidatorsFlag,
 	flags.InteropGenesisTimeFlag,
 	flags.SlotsPerArchivedPoint,
+	flags.ArchiveMode,
+	flags.ArchiveSnapshotInterval,
 	flags.EnableDebugRPCEndpoints,
+	flags.EnableCheckpointSyncServing,
 	flags.SubscribeToAllSubnets,
 	flags.HistoricalSlasherNode,
 	flags.ChainID,
@@ -75,6 +81,9 @@ var appFlags = []cli.Flag{
 	flags.TerminalBlockHashOverride,
 	flags.TerminalBlockHashActivationEpochOverride,
 	flags.MevRelayEndpoint,
+	flags.LocalBlockValueBoost,
+	flags.MaxBuilderConsecutiveMissedSlots,
+	flags.MaxBuilderEpochMissedSlots,
 	cmd.EnableBackupWebhookFlag,
 	cmd.BackupWebhookOutputDir,
 	cmd.MinimalConfigFlag,
@@ -129,8 +138,15 @@ var appFlags = []cli.Flag{
 	checkpoint.BlockPath,
 	checkpoint.StatePath,
 	checkpoint.RemoteURL,
+	checkpoint.Quorum,
 	genesis.StatePath,
 	genesis.BeaconAPIURL,
+	backfill.EnableBackfill,
+	backfill.BackfillBatchSize,
+	backfill.BackfillBatchInterval,
+	pruner.EnableHistoryPruning,
+	pruner.HistoryRetentionEpochs,
+	pruner.HistoryPruneBatchSize,
 }
 
 func init() {
@@ -268,6 +284,8 @@ func startNode(ctx *cli.Context) error {
 	optFuncs := []func(*cli.Context) (node.Option, error){
 		genesis.BeaconNodeOptions,
 		checkpoint.BeaconNodeOptions,
+		backfill.BeaconNodeOptions,
+		pruner.BeaconNodeOptions,
 	}
 	for _, of := range optFuncs {
 		ofo, err := of(ctx)
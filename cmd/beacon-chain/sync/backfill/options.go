@@ -0,0 +1,40 @@
+package backfill
+
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/node"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// EnableBackfill enables the download of the blocks missing below the checkpoint sync origin.
+	EnableBackfill = &cli.BoolFlag{
+		Name: "enable-backfill",
+		Usage: "Download the blocks from genesis up to the origin block of a node initialized via checkpoint sync, " +
+			"so that the node can serve the full history of the chain to peers and API clients.",
+	}
+	// BackfillBatchSize sets the number of slots requested from a peer at once by backfill.
+	BackfillBatchSize = &cli.Uint64Flag{
+		Name:  "backfill-batch-size",
+		Usage: "Number of slots requested from a peer at once when backfilling blocks.",
+		Value: backfill.DefaultBatchSize,
+	}
+	// BackfillBatchInterval sets the minimum amount of time between two backfill batches.
+	BackfillBatchInterval = &cli.DurationFlag{
+		Name: "backfill-batch-interval",
+		Usage: "Minimum amount of time between two backfill batches. Increasing it reduces the bandwidth used by " +
+			"backfill, which leaves more of it to gossip.",
+		Value: backfill.DefaultBatchInterval,
+	}
+)
+
+// BeaconNodeOptions enables the backfill service when --enable-backfill is set, configured with the backfill flags.
+func BeaconNodeOptions(c *cli.Context) (node.Option, error) {
+	if !c.Bool(EnableBackfill.Name) {
+		return nil, nil
+	}
+	return node.WithBackfillOptions([]backfill.Option{
+		backfill.WithBatchSize(c.Uint64(BackfillBatchSize.Name)),
+		backfill.WithBatchInterval(c.Duration(BackfillBatchInterval.Name)),
+	}), nil
+}
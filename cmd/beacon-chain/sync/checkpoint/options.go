@@ -22,12 +22,19 @@ var (
 		Usage: "Rather than syncing from genesis, you can start processing from a ssz-serialized BeaconState+Block." +
 			" This flag allows you to specify a local file containing the checkpoint Block to load.",
 	}
-	RemoteURL = &cli.StringFlag{
+	RemoteURL = &cli.StringSliceFlag{
 		Name: "checkpoint-sync-url",
 		Usage: "URL of a synced beacon node to trust in obtaining checkpoint sync data. " +
+			"Can be used multiple times, in which case the finalized block root must be agreed upon by a quorum of the beacon nodes. " +
 			"As an additional safety measure, it is strongly recommended to only use this option in conjunction with " +
 			"--weak-subjectivity-checkpoint flag",
 	}
+	// Quorum is the number of checkpoint sync beacon nodes which must agree on the finalized block root.
+	Quorum = &cli.IntFlag{
+		Name: "checkpoint-sync-quorum",
+		Usage: "Number of --checkpoint-sync-url beacon nodes which must agree on the finalized block root before " +
+			"checkpoint sync data is downloaded. Defaults to a majority of the beacon nodes.",
+	}
 )
 
 // BeaconNodeOptions is responsible for determining if the checkpoint sync options have been used, and if so,
@@ -36,11 +43,11 @@ var (
 func BeaconNodeOptions(c *cli.Context) (node.Option, error) {
 	blockPath := c.Path(BlockPath.Name)
 	statePath := c.Path(StatePath.Name)
-	remoteURL := c.String(RemoteURL.Name)
-	if remoteURL != "" {
+	remoteURLs := c.StringSlice(RemoteURL.Name)
+	if len(remoteURLs) > 0 {
 		return func(node *node.BeaconNode) error {
 			var err error
-			node.CheckpointInitializer, err = checkpoint.NewAPIInitializer(remoteURL)
+			node.CheckpointInitializer, err = checkpoint.NewAPIInitializer(remoteURLs, c.Int(Quorum.Name))
 			if err != nil {
 				return errors.Wrap(err, "error while constructing beacon node api client for checkpoint sync")
 			}
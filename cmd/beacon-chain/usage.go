@@ -6,7 +6,9 @@ import (
 	"sort"
 
 	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/db/pruner"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/sync/backfill"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/sync/checkpoint"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/sync/genesis"
 	"github.com/prysmaticlabs/prysm/config/features"
@@ -115,10 +117,14 @@ var appHelpFlagGroups = []flagGroup{
 			flags.HeadSync,
 			flags.DisableSync,
 			flags.SlotsPerArchivedPoint,
+			flags.ArchiveMode,
+			flags.ArchiveSnapshotInterval,
 			flags.DisableDiscv5,
+			flags.PeerScoreMetrics,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
 			flags.EnableDebugRPCEndpoints,
+			flags.EnableCheckpointSyncServing,
 			flags.SubscribeToAllSubnets,
 			flags.HistoricalSlasherNode,
 			flags.ChainID,
@@ -127,11 +133,21 @@ var appHelpFlagGroups = []flagGroup{
 			flags.Eth1HeaderReqLimit,
 			flags.MinPeersPerSubnet,
 			flags.MevRelayEndpoint,
+			flags.LocalBlockValueBoost,
+			flags.MaxBuilderConsecutiveMissedSlots,
+			flags.MaxBuilderEpochMissedSlots,
 			checkpoint.BlockPath,
 			checkpoint.StatePath,
 			checkpoint.RemoteURL,
+			checkpoint.Quorum,
 			genesis.StatePath,
 			genesis.BeaconAPIURL,
+			backfill.EnableBackfill,
+			backfill.BackfillBatchSize,
+			backfill.BackfillBatchInterval,
+			pruner.EnableHistoryPruning,
+			pruner.HistoryRetentionEpochs,
+			pruner.HistoryPruneBatchSize,
 		},
 	},
 	{
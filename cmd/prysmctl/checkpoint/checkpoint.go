@@ -10,6 +10,7 @@ var Commands = []*cli.Command{
 		Subcommands: []*cli.Command{
 			latestCmd,
 			saveCmd,
+			verifyCmd,
 		},
 	},
 }
@@ -0,0 +1,168 @@
+package checkpoint
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/io/file"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var verifyFlags = struct {
+	BlockPath       string
+	StatePath       string
+	BeaconNodeHosts *cli.StringSlice
+	Network         string
+	GenesisRoot     string
+	Timeout         time.Duration
+	Repair          bool
+}{
+	BeaconNodeHosts: cli.NewStringSlice(),
+}
+
+var verifyCmd = &cli.Command{
+	Name: "verify",
+	Usage: "Verify a checkpoint block and state pair saved to disk before using it for checkpoint sync, " +
+		"optionally comparing it against trusted beacon nodes.",
+	Action: cliActionVerify,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "block",
+			Usage:       "path to the ssz-encoded checkpoint block",
+			Destination: &verifyFlags.BlockPath,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "state",
+			Usage:       "path to the ssz-encoded checkpoint state",
+			Destination: &verifyFlags.StatePath,
+			Required:    true,
+		},
+		&cli.StringSliceFlag{
+			Name:        "beacon-node-host",
+			Usage:       "host:port of a trusted beacon node to compare the checkpoint block root against. Can be used multiple times",
+			Destination: verifyFlags.BeaconNodeHosts,
+		},
+		&cli.StringFlag{
+			Name:        "network",
+			Usage:       "name of the network the checkpoint is expected to belong to (mainnet, prater, ropsten, sepolia)",
+			Destination: &verifyFlags.Network,
+			Value:       params.MainnetName,
+		},
+		&cli.StringFlag{
+			Name: "genesis-validators-root",
+			Usage: "hex-encoded genesis validators root the checkpoint state is expected to have. " +
+				"defaults to the genesis validators root of the public network given by --network",
+			Destination: &verifyFlags.GenesisRoot,
+		},
+		&cli.DurationFlag{
+			Name:        "http-timeout",
+			Usage:       "timeout for http requests made to beacon-node-host (uses duration format, ex: 2m31s). default: 2m",
+			Destination: &verifyFlags.Timeout,
+			Value:       time.Minute * 2,
+		},
+		&cli.BoolFlag{
+			Name: "repair",
+			Usage: "if the block does not match the state, download the block integrated by the state from the first " +
+				"beacon-node-host and save it next to the given block file",
+			Destination: &verifyFlags.Repair,
+		},
+	},
+}
+
+func cliActionVerify(_ *cli.Context) error {
+	ctx := context.Background()
+	f := verifyFlags
+
+	cfg, err := params.ByName(f.Network)
+	if err != nil {
+		return errors.Wrapf(err, "unable to find config for network %s", f.Network)
+	}
+	gvr, err := expectedGenesisValidatorsRoot(f.GenesisRoot, cfg.ConfigName)
+	if err != nil {
+		return err
+	}
+	sb, err := file.ReadFileAsBytes(f.StatePath)
+	if err != nil {
+		return errors.Wrapf(err, "error reading checkpoint state from %s", f.StatePath)
+	}
+	bb, err := file.ReadFileAsBytes(f.BlockPath)
+	if err != nil {
+		return errors.Wrapf(err, "error reading checkpoint block from %s", f.BlockPath)
+	}
+	od, err := beacon.OriginDataFromBytes(ctx, sb, bb)
+	if err != nil {
+		return err
+	}
+
+	clients := make([]*beacon.Client, 0, len(f.BeaconNodeHosts.Value()))
+	for _, host := range f.BeaconNodeHosts.Value() {
+		c, err := beacon.NewClient(host, beacon.WithTimeout(f.Timeout))
+		if err != nil {
+			return err
+		}
+		clients = append(clients, c)
+	}
+
+	if err := od.VerifyBlock(); err != nil {
+		if !f.Repair || len(clients) == 0 {
+			return err
+		}
+		log.WithError(err).Warn("repairing checkpoint block")
+		if err := od.ReplaceBlock(ctx, clients[0]); err != nil {
+			return errors.Wrap(err, "unable to repair checkpoint block")
+		}
+		blockPath, err := od.SaveBlock(filepath.Dir(f.BlockPath))
+		if err != nil {
+			return err
+		}
+		log.Printf("saved repaired ssz-encoded block to %s", blockPath)
+	}
+	log.Printf("block root=%#x matches the latest block header of the state", od.BlockRoot())
+
+	if err := od.VerifyFork(cfg); err != nil {
+		return err
+	}
+	log.Printf("state fork version matches the %s fork schedule", cfg.ConfigName)
+
+	if err := od.VerifyGenesisValidatorsRoot(gvr); err != nil {
+		return err
+	}
+	log.Printf("state genesis_validators_root=%#x matches the %s network", gvr, cfg.ConfigName)
+
+	if err := od.VerifyWeakSubjectivityPeriod(ctx, od.CurrentEpoch(), cfg); err != nil {
+		return err
+	}
+	log.Printf("state is within the weak subjectivity period at current epoch=%d", od.CurrentEpoch())
+
+	for _, c := range clients {
+		if err := od.VerifyWithClient(ctx, c); err != nil {
+			return err
+		}
+		log.Printf("block root matches the canonical block of %s", c.NodeURL())
+	}
+	log.Print("checkpoint block and state verified")
+	return nil
+}
+
+func expectedGenesisValidatorsRoot(h, configName string) ([32]byte, error) {
+	if h == "" {
+		gvr, err := beacon.GenesisValidatorsRoot(configName)
+		if err != nil {
+			return [32]byte{}, errors.Wrap(err, "use --genesis-validators-root to set the genesis validators root of the network")
+		}
+		return gvr, nil
+	}
+	b, err := hexutil.Decode(h)
+	if err != nil || len(b) != 32 {
+		return [32]byte{}, errors.Errorf("invalid --genesis-validators-root %s, expected a 0x-prefixed 32 byte hex string", h)
+	}
+	return bytesutil.ToBytes32(b), nil
+}
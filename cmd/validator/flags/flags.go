@@ -29,8 +29,10 @@ var (
 	}
 	// BeaconRPCProviderFlag defines a beacon node RPC endpoint.
 	BeaconRPCProviderFlag = &cli.StringFlag{
-		Name:  "beacon-rpc-provider",
-		Usage: "Beacon node RPC provider endpoint",
+		Name: "beacon-rpc-provider",
+		Usage: "Beacon node RPC provider endpoint. Several comma separated endpoints can be provided, in which " +
+			"case the validator client probes their health every slot, uses the healthiest beacon node and submits " +
+			"signed blocks and attestations to every healthy beacon node",
 		Value: "127.0.0.1:4000",
 	}
 	// BeaconRPCGatewayProviderFlag defines a beacon node JSON-RPC endpoint.
@@ -39,6 +41,12 @@ var (
 		Usage: "Beacon node RPC gateway provider endpoint",
 		Value: "127.0.0.1:3500",
 	}
+	// BeaconRESTApiProviderFlag defines a beacon node REST API endpoint.
+	BeaconRESTApiProviderFlag = &cli.StringFlag{
+		Name: "beacon-rest-api-provider",
+		Usage: "Beacon node REST API provider endpoint (e.g. http://127.0.0.1:3500). When set, the validator " +
+			"communicates with the beacon node over the standard Beacon API instead of gRPC",
+	}
 	// CertFlag defines a flag for the node's TLS certificate.
 	CertFlag = &cli.StringFlag{
 		Name:  "tls-cert",
@@ -73,6 +81,47 @@ var (
 		Name:  "slasher-tls-cert",
 		Usage: "Certificate for secure slasher gRPC. Pass this and the tls-key flag in order to use gRPC securely.",
 	}
+	// SlashingProtectionProviderFlag defines a remote slashing protection server endpoint.
+	SlashingProtectionProviderFlag = &cli.StringFlag{
+		Name: "slashing-protection-provider",
+		Usage: "Remote slashing protection server endpoint, consulted before signing in addition to the local " +
+			"slashing protection history. Validator clients running the same keys never both sign for a key " +
+			"when they share a remote slashing protection server",
+	}
+	// SlashingProtectionCertFlag defines a flag for the remote slashing protection server's TLS certificate.
+	SlashingProtectionCertFlag = &cli.StringFlag{
+		Name:  "slashing-protection-tls-cert",
+		Usage: "Certificate for secure gRPC with the remote slashing protection server",
+	}
+	// SlashingProtectionKeyFlag defines a flag for the remote slashing protection server's TLS key.
+	SlashingProtectionKeyFlag = &cli.StringFlag{
+		Name:  "slashing-protection-tls-key",
+		Usage: "Key for secure gRPC of the remote slashing protection server. Pass this and the slashing-protection-tls-cert flag to serve gRPC securely",
+	}
+	// SlashingProtectionHolderFlag defines the name under which the validator client leases its public keys.
+	SlashingProtectionHolderFlag = &cli.StringFlag{
+		Name: "slashing-protection-holder",
+		Usage: "Name under which the validator client leases its public keys from the remote slashing protection " +
+			"server. Defaults to the host name and process ID of the validator client",
+	}
+	// SlashingProtectionHostFlag defines the host on which the remote slashing protection server listens.
+	SlashingProtectionHostFlag = &cli.StringFlag{
+		Name:  "slashing-protection-host",
+		Usage: "Host on which the remote slashing protection server listens",
+		Value: "127.0.0.1",
+	}
+	// SlashingProtectionPortFlag defines the port on which the remote slashing protection server listens.
+	SlashingProtectionPortFlag = &cli.IntFlag{
+		Name:  "slashing-protection-port",
+		Usage: "Port on which the remote slashing protection server listens",
+		Value: 7600,
+	}
+	// SlashingProtectionLeaseDurationFlag defines how long public keys stay leased to a validator client.
+	SlashingProtectionLeaseDurationFlag = &cli.DurationFlag{
+		Name: "slashing-protection-lease-duration",
+		Usage: "How long a public key stays leased to a validator client after it last signed for it. Another " +
+			"validator client can only sign for the public key once the lease expires or is released. Defaults to two epochs",
+	}
 	// DisablePenaltyRewardLogFlag defines the ability to not log reward/penalty information during deployment
 	DisablePenaltyRewardLogFlag = &cli.BoolFlag{
 		Name:  "disable-rewards-penalties-logging",
@@ -83,6 +132,14 @@ var (
 		Name:  "graffiti",
 		Usage: "String to include in proposed blocks",
 	}
+	// BroadcastToBeaconNodesFlag submits signed aggregates and sync committee messages to every healthy beacon node
+	// of the beacon-rpc-provider flag.
+	BroadcastToBeaconNodesFlag = &cli.BoolFlag{
+		Name: "broadcast-to-beacon-nodes",
+		Usage: "Submits signed aggregates and sync committee messages concurrently to every healthy beacon node of " +
+			"--beacon-rpc-provider, as is always done for blocks and attestations, to improve inclusion when a " +
+			"beacon node is poorly peered",
+	}
 	// GrpcRetriesFlag defines the number of times to retry a failed gRPC request.
 	GrpcRetriesFlag = &cli.UintFlag{
 		Name:  "grpc-retries",
@@ -285,6 +342,39 @@ var (
 		Name:  "validators-external-signer-public-keys",
 		Usage: "comma separated list of public keys OR an external url endpoint for the validator to retrieve public keys from for usage with web3signer",
 	}
+	// Web3SignerPublicKeysRefreshIntervalFlag defines how often the public keys are fetched again from the external url
+	// of --validators-external-signer-public-keys.
+	Web3SignerPublicKeysRefreshIntervalFlag = &cli.DurationFlag{
+		Name: "validators-external-signer-public-keys-refresh-interval",
+		Usage: "Interval at which the public keys are fetched again from the external url given to " +
+			"--validators-external-signer-public-keys, to pick up the keys added to or removed from web3signer. " +
+			"Disabled when set to 0",
+		Value: 0,
+	}
+	// Web3SignerClientCertFlag defines the client certificate used for mutual TLS with web3signer.
+	Web3SignerClientCertFlag = &cli.StringFlag{
+		Name:  "validators-external-signer-client-cert",
+		Usage: "/path/to/client.crt for establishing a mutual TLS connection to web3signer",
+		Value: "",
+	}
+	// Web3SignerClientKeyFlag defines the client key used for mutual TLS with web3signer.
+	Web3SignerClientKeyFlag = &cli.StringFlag{
+		Name:  "validators-external-signer-client-key",
+		Usage: "/path/to/client.key for establishing a mutual TLS connection to web3signer",
+		Value: "",
+	}
+	// Web3SignerCACertFlag defines the CA certificate used to authenticate web3signer.
+	Web3SignerCACertFlag = &cli.StringFlag{
+		Name:  "validators-external-signer-ca-cert",
+		Usage: "/path/to/ca.crt for authenticating web3signer over TLS",
+		Value: "",
+	}
+	// Web3SignerRequestTimeoutFlag defines the timeout of each request to web3signer.
+	Web3SignerRequestTimeoutFlag = &cli.DurationFlag{
+		Name:  "validators-external-signer-request-timeout",
+		Usage: "Timeout of each request to web3signer",
+		Value: 10 * time.Second,
+	}
 
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
@@ -311,6 +401,22 @@ var (
 		Usage: "Allows users to specify the output directory to export their slashing protection EIP-3076 standard JSON File",
 		Value: "",
 	}
+	// SlashingProtectionSinceEpochFlag restricts a slashing protection history export to the data signed since an epoch.
+	SlashingProtectionSinceEpochFlag = &cli.Uint64Flag{
+		Name: "slashing-protection-since-epoch",
+		Usage: "Only exports the attestations with a target epoch and the blocks with a slot no older than this epoch, " +
+			"for an incremental export of the slashing protection history",
+	}
+	// SlashingProtectionJSONFilesFlag is used to enter the file paths of slashing protection JSONs to merge.
+	SlashingProtectionJSONFilesFlag = &cli.StringSliceFlag{
+		Name:  "slashing-protection-json-files",
+		Usage: "Comma-separated paths to EIP-3076 compliant JSON files containing slashing protection histories to merge",
+	}
+	// GenesisValidatorsRootFlag defines the genesis validators root of the chain a slashing protection JSON is checked against.
+	GenesisValidatorsRootFlag = &cli.StringFlag{
+		Name:  "genesis-validators-root",
+		Usage: "Hex encoded genesis validators root of the chain the slashing protection JSON file is verified against",
+	}
 	// GraffitiFileFlag specifies the file path to load graffiti values.
 	GraffitiFileFlag = &cli.StringFlag{
 		Name:  "graffiti-file",
@@ -326,13 +432,13 @@ var (
 	// ProposerSettingsFlag defines the path or URL to a file with proposer config.
 	ProposerSettingsFlag = &cli.StringFlag{
 		Name:  "proposer-settings-file",
-		Usage: "Set path to a YAML or JSON file containing validator settings used when proposing blocks such as (fee recipient and gas limit) (i.e. --proposer-settings-file=/path/to/proposer.json). File format found in docs",
+		Usage: "Set path to a YAML or JSON file containing validator settings used when proposing blocks such as (fee recipient and gas limit) (i.e. --proposer-settings-file=/path/to/proposer.json). File format found in docs. Settings edited through the keymanager API take precedence over the settings of the file",
 		Value: "",
 	}
 	// ProposerSettingsURLFlag defines the path or URL to a file with proposer config.
 	ProposerSettingsURLFlag = &cli.StringFlag{
 		Name:  "proposer-settings-url",
-		Usage: "Set URL to a REST endpoint containing validator settings used when proposing blocks such as (fee recipient) (i.e. --proposer-settings-url=https://example.com/api/getConfig). File format found in docs",
+		Usage: "Set URL to a REST endpoint containing validator settings used when proposing blocks such as (fee recipient) (i.e. --proposer-settings-url=https://example.com/api/getConfig). File format found in docs. Settings edited through the keymanager API take precedence over the settings of the URL",
 		Value: "",
 	}
 
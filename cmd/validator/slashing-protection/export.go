@@ -8,10 +8,12 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/cmd"
 	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/io/file"
 	"github.com/prysmaticlabs/prysm/validator/accounts/userprompt"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	slashingprotection "github.com/prysmaticlabs/prysm/validator/slashing-protection-history"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection-history/format"
 	"github.com/urfave/cli/v2"
 )
 
@@ -63,9 +65,21 @@ func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 			log.WithError(err).Errorf("Could not close validator DB")
 		}
 	}()
-	eipJSON, err := slashingprotection.ExportStandardProtectionJSON(cliCtx.Context, validatorDB)
-	if err != nil {
-		return errors.Wrap(err, "could not export slashing protection history")
+	var eipJSON *format.EIPSlashingProtectionFormat
+	if cliCtx.IsSet(flags.SlashingProtectionSinceEpochFlag.Name) {
+		sinceEpoch := types.Epoch(cliCtx.Uint64(flags.SlashingProtectionSinceEpochFlag.Name))
+		eipJSON, err = slashingprotection.ExportStandardProtectionJSONSince(cliCtx.Context, validatorDB, sinceEpoch)
+		if err != nil {
+			return errors.Wrap(err, "could not export slashing protection history")
+		}
+		if len(eipJSON.Data) == 0 {
+			return fmt.Errorf("no slashing protection data was signed since epoch %d, so nothing to export", sinceEpoch)
+		}
+	} else {
+		eipJSON, err = slashingprotection.ExportStandardProtectionJSON(cliCtx.Context, validatorDB)
+		if err != nil {
+			return errors.Wrap(err, "could not export slashing protection history")
+		}
 	}
 
 	// Check if JSON data is empty and issue a warning about common problems to the user.
@@ -78,40 +92,49 @@ func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 				"directory is in and you should obtain your slashing protection history",
 		)
 	}
+	outputFilePath, err := writeSlashingProtectionJSON(cliCtx, eipJSON)
+	if err != nil {
+		return err
+	}
+	log.Infof(
+		"Successfully wrote %s. You can import this file using Prysm's "+
+			"validator slashing-protection-history import command in another machine",
+		outputFilePath,
+	)
+	return nil
+}
 
+// Writes an EIP-3076 standard JSON file to the output directory specified
+// by the user, creating the directory if needed, and returns the file path.
+func writeSlashingProtectionJSON(cliCtx *cli.Context, eipJSON *format.EIPSlashingProtectionFormat) (string, error) {
 	outputDir, err := userprompt.InputDirectory(
 		cliCtx,
 		"Enter your desired output directory for your slashing protection history file",
 		flags.SlashingProtectionExportDirFlag,
 	)
 	if err != nil {
-		return errors.Wrap(err, "could not get slashing protection json file")
+		return "", errors.Wrap(err, "could not get slashing protection json file")
 	}
 	if outputDir == "" {
-		return errors.New("output directory not specified")
+		return "", errors.New("output directory not specified")
 	}
 	exists, err := file.HasDir(outputDir)
 	if err != nil {
-		return errors.Wrapf(err, "could not check if output directory %s already exists", outputDir)
+		return "", errors.Wrapf(err, "could not check if output directory %s already exists", outputDir)
 	}
 	if !exists {
 		if err := file.MkdirAll(outputDir); err != nil {
-			return errors.Wrapf(err, "could not create output directory %s", outputDir)
+			return "", errors.Wrapf(err, "could not create output directory %s", outputDir)
 		}
 	}
 	outputFilePath := filepath.Join(outputDir, jsonExportFileName)
 	log.Infof("Writing slashing protection export JSON file to %s", outputFilePath)
 	encoded, err := json.MarshalIndent(eipJSON, "", "\t")
 	if err != nil {
-		return errors.Wrap(err, "could not JSON marshal slashing protection history")
+		return "", errors.Wrap(err, "could not JSON marshal slashing protection history")
 	}
 	if err := file.WriteFile(outputFilePath, encoded); err != nil {
-		return errors.Wrapf(err, "could not write file to path %s", outputFilePath)
+		return "", errors.Wrapf(err, "could not write file to path %s", outputFilePath)
 	}
-	log.Infof(
-		"Successfully wrote %s. You can import this file using Prysm's "+
-			"validator slashing-protection-history import command in another machine",
-		outputFilePath,
-	)
-	return nil
+	return outputFilePath, nil
 }
@@ -3,6 +3,7 @@ package historycmd
 import (
 	"encoding/json"
 	"flag"
+	"fmt"
 	"path/filepath"
 	"testing"
 
@@ -176,3 +177,50 @@ func TestImportExportSlashingProtectionCli_EmptyData(t *testing.T) {
 		require.DeepEqual(t, make([]*format.SignedAttestation, 0), item.SignedAttestations)
 	}
 }
+
+func TestMergeVerifySlashingProtectionCli(t *testing.T) {
+	numValidators := 4
+	outputPath := filepath.Join(t.TempDir(), "slashing-exports")
+	require.NoError(t, file.MkdirAll(outputPath))
+
+	// Create two mock slashing protection JSON files with different public keys.
+	pubKeys, err := mocks.CreateRandomPubKeys(numValidators)
+	require.NoError(t, err)
+	attestingHistory, proposalHistory := mocks.MockAttestingAndProposalHistories(pubKeys)
+	protectionFilePaths := make([]string, 2)
+	for i := range protectionFilePaths {
+		start, end := i*numValidators/2, (i+1)*numValidators/2
+		mockJSON, err := mocks.MockSlashingProtectionJSON(
+			pubKeys[start:end], attestingHistory[start:end], proposalHistory[start:end],
+		)
+		require.NoError(t, err)
+		encoded, err := json.Marshal(mockJSON)
+		require.NoError(t, err)
+		protectionFilePaths[i] = filepath.Join(outputPath, fmt.Sprintf("slashing_history_%d.json", i))
+		require.NoError(t, file.WriteFile(protectionFilePaths[i], encoded))
+	}
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.Var(cli.NewStringSlice(protectionFilePaths...), flags.SlashingProtectionJSONFilesFlag.Name, "")
+	set.String(flags.SlashingProtectionExportDirFlag.Name, outputPath, "")
+	require.NoError(t, set.Set(flags.SlashingProtectionExportDirFlag.Name, outputPath))
+	require.NoError(t, mergeSlashingProtectionJSON(cli.NewContext(&app, set, nil)))
+
+	enc, err := file.ReadFileAsBytes(filepath.Join(outputPath, jsonExportFileName))
+	require.NoError(t, err)
+	receivedJSON := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal(enc, receivedJSON))
+	require.Equal(t, numValidators, len(receivedJSON.Data))
+
+	// We verify the merged file against its chain and another chain.
+	set = flag.NewFlagSet("test", 0)
+	set.String(flags.SlashingProtectionJSONFileFlag.Name, filepath.Join(outputPath, jsonExportFileName), "")
+	set.String(flags.GenesisValidatorsRootFlag.Name, receivedJSON.Metadata.GenesisValidatorsRoot, "")
+	require.NoError(t, set.Set(flags.SlashingProtectionJSONFileFlag.Name, filepath.Join(outputPath, jsonExportFileName)))
+	require.NoError(t, set.Set(flags.GenesisValidatorsRootFlag.Name, receivedJSON.Metadata.GenesisValidatorsRoot))
+	require.NoError(t, verifySlashingProtectionJSON(cli.NewContext(&app, set, nil)))
+
+	require.NoError(t, set.Set(flags.GenesisValidatorsRootFlag.Name, fmt.Sprintf("%#x", [32]byte{1})))
+	require.ErrorContains(t, "does not match", verifySlashingProtectionJSON(cli.NewContext(&app, set, nil)))
+}
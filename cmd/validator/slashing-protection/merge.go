@@ -0,0 +1,56 @@
+package historycmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/io/file"
+	slashingprotection "github.com/prysmaticlabs/prysm/validator/slashing-protection-history"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection-history/format"
+	"github.com/urfave/cli/v2"
+)
+
+// Merges several slashing protection EIP-3076 standard JSON files of
+// the same chain, such as the exports of several machines, into a
+// single file written to a user's specified output directory.
+func mergeSlashingProtectionJSON(cliCtx *cli.Context) error {
+	protectionFilePaths := cliCtx.StringSlice(flags.SlashingProtectionJSONFilesFlag.Name)
+	if len(protectionFilePaths) < 2 {
+		return fmt.Errorf(
+			"at least two slashing protection JSON files must be specified with the %s flag",
+			flags.SlashingProtectionJSONFilesFlag.Name,
+		)
+	}
+	eipJSONs := make([]*format.EIPSlashingProtectionFormat, len(protectionFilePaths))
+	for i, protectionFilePath := range protectionFilePaths {
+		eipJSON, err := readSlashingProtectionJSON(protectionFilePath)
+		if err != nil {
+			return err
+		}
+		eipJSONs[i] = eipJSON
+	}
+	merged, err := slashingprotection.MergeStandardProtectionJSON(cliCtx.Context, eipJSONs...)
+	if err != nil {
+		return errors.Wrap(err, "could not merge slashing protection histories")
+	}
+	outputFilePath, err := writeSlashingProtectionJSON(cliCtx, merged)
+	if err != nil {
+		return err
+	}
+	log.Infof("Successfully merged %d slashing protection JSON files into %s", len(protectionFilePaths), outputFilePath)
+	return nil
+}
+
+func readSlashingProtectionJSON(protectionFilePath string) (*format.EIPSlashingProtectionFormat, error) {
+	enc, err := file.ReadFileAsBytes(protectionFilePath)
+	if err != nil {
+		return nil, err
+	}
+	eipJSON := &format.EIPSlashingProtectionFormat{}
+	if err := json.Unmarshal(enc, eipJSON); err != nil {
+		return nil, errors.Wrapf(err, "could not unmarshal slashing protection JSON file %s", protectionFilePath)
+	}
+	return eipJSON, nil
+}
@@ -0,0 +1,52 @@
+package historycmd
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	slashingprotection "github.com/prysmaticlabs/prysm/validator/slashing-protection-server"
+	"github.com/urfave/cli/v2"
+)
+
+// Serves the slashing protection history of the validator database in the data directory to validator clients,
+// until interrupted. A slashing protection history can be imported beforehand with the import command.
+func serveSlashingProtection(cliCtx *cli.Context) error {
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	valDB, err := kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{})
+	if err != nil {
+		return errors.Wrapf(err, "could not access validator database at path: %s", dataDir)
+	}
+	defer func() {
+		if err := valDB.Close(); err != nil {
+			log.WithError(err).Errorf("Could not close validator DB")
+		}
+	}()
+
+	server := slashingprotection.NewServer(cliCtx.Context, &slashingprotection.Config{
+		Host:          cliCtx.String(flags.SlashingProtectionHostFlag.Name),
+		Port:          cliCtx.Int(flags.SlashingProtectionPortFlag.Name),
+		CertFlag:      cliCtx.String(flags.SlashingProtectionCertFlag.Name),
+		KeyFlag:       cliCtx.String(flags.SlashingProtectionKeyFlag.Name),
+		ValDB:         valDB,
+		LeaseDuration: cliCtx.Duration(flags.SlashingProtectionLeaseDurationFlag.Name),
+	})
+	server.Start()
+	if err := server.Status(); err != nil {
+		return errors.Wrap(err, "could not start slashing protection server")
+	}
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigc)
+	select {
+	case <-sigc:
+		log.Info("Got interrupt, shutting down...")
+	case <-cliCtx.Context.Done():
+	}
+	return server.Stop()
+}
@@ -21,6 +21,7 @@ var Commands = &cli.Command{
 			Flags: cmd.WrapFlags([]cli.Flag{
 				cmd.DataDirFlag,
 				flags.SlashingProtectionExportDirFlag,
+				flags.SlashingProtectionSinceEpochFlag,
 				features.Mainnet,
 				features.PraterTestnet,
 				features.RopstenTestnet,
@@ -72,5 +73,82 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "merge",
+			Description: `merges EIP-3076 compliant slashing protection JSONs of the same chain into a single one, ` +
+				`keeping the minimal history of the public keys whose histories conflict`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.SlashingProtectionJSONFilesFlag,
+				flags.SlashingProtectionExportDirFlag,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := mergeSlashingProtectionJSON(cliCtx); err != nil {
+					logrus.Fatalf("Could not merge slashing protection files: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "verify",
+			Description: `verifies an EIP-3076 compliant slashing protection JSON against a genesis validators root, ` +
+				`and reports the double proposals, double votes and surround votes within it`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.SlashingProtectionJSONFileFlag,
+				flags.GenesisValidatorsRootFlag,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := verifySlashingProtectionJSON(cliCtx); err != nil {
+					logrus.Fatalf("Could not verify slashing protection file: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "serve",
+			Description: `serves the slashing protection history of the validator database to validator clients, ` +
+				`which consult it before signing and lease their public keys from it`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.SlashingProtectionHostFlag,
+				flags.SlashingProtectionPortFlag,
+				flags.SlashingProtectionCertFlag,
+				flags.SlashingProtectionKeyFlag,
+				flags.SlashingProtectionLeaseDurationFlag,
+				features.Mainnet,
+				features.PraterTestnet,
+				features.RopstenTestnet,
+				features.SepoliaTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := features.ConfigureValidator(cliCtx); err != nil {
+					return err
+				}
+				if err := serveSlashingProtection(cliCtx); err != nil {
+					logrus.Fatalf("Could not serve slashing protection history: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
@@ -0,0 +1,56 @@
+package historycmd
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/validator/accounts/userprompt"
+	slashingprotection "github.com/prysmaticlabs/prysm/validator/slashing-protection-history"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Checks that a slashing protection EIP-3076 standard JSON file is well
+// formed and belongs to the chain of the genesis validators root specified
+// by the user, and reports the double proposals, double votes and surround
+// votes found within it.
+func verifySlashingProtectionJSON(cliCtx *cli.Context) error {
+	if !cliCtx.IsSet(flags.GenesisValidatorsRootFlag.Name) {
+		return fmt.Errorf("the genesis validators root must be specified with the %s flag", flags.GenesisValidatorsRootFlag.Name)
+	}
+	genesisValidatorsRoot, err := slashingprotection.RootFromHex(cliCtx.String(flags.GenesisValidatorsRootFlag.Name))
+	if err != nil {
+		return errors.Wrap(err, "could not parse genesis validators root")
+	}
+	protectionFilePath, err := userprompt.InputDirectory(cliCtx, userprompt.SlashingProtectionJSONPromptText, flags.SlashingProtectionJSONFileFlag)
+	if err != nil {
+		return errors.Wrap(err, "could not get slashing protection json file")
+	}
+	if protectionFilePath == "" {
+		return fmt.Errorf(
+			"no path to a slashing_protection.json file specified, please retry or "+
+				"you can also specify it with the %s flag",
+			flags.SlashingProtectionJSONFileFlag.Name,
+		)
+	}
+	eipJSON, err := readSlashingProtectionJSON(protectionFilePath)
+	if err != nil {
+		return err
+	}
+	violations, err := slashingprotection.VerifyStandardProtectionJSON(cliCtx.Context, eipJSON, genesisValidatorsRoot)
+	if err != nil {
+		return errors.Wrapf(err, "slashing protection JSON file %s is invalid", protectionFilePath)
+	}
+	for _, violation := range violations {
+		log.WithFields(logrus.Fields{
+			"publicKey": fmt.Sprintf("%#x", violation.PubKey),
+			"kind":      violation.Kind,
+		}).Warn(violation.Description)
+	}
+	if len(violations) > 0 {
+		return fmt.Errorf("found %d slashable offences in slashing protection JSON file %s", len(violations), protectionFilePath)
+	}
+	log.Infof("Slashing protection JSON file %s is valid and contains no slashable offences", protectionFilePath)
+	return nil
+}
@@ -86,7 +86,9 @@ var appHelpFlagGroups = []flagGroup{
 		Name: "validator",
 		Flags: []cli.Flag{
 			flags.BeaconRPCProviderFlag,
+			flags.BroadcastToBeaconNodesFlag,
 			flags.BeaconRPCGatewayProviderFlag,
+			flags.BeaconRESTApiProviderFlag,
 			flags.CertFlag,
 			flags.EnableWebFlag,
 			flags.DisablePenaltyRewardLogFlag,
@@ -102,6 +104,9 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GrpcHeadersFlag,
 			flags.SlasherRPCProviderFlag,
 			flags.SlasherCertFlag,
+			flags.SlashingProtectionProviderFlag,
+			flags.SlashingProtectionCertFlag,
+			flags.SlashingProtectionHolderFlag,
 			flags.DisableAccountMetricsFlag,
 			flags.WalletDirFlag,
 			flags.WalletPasswordFileFlag,
@@ -109,6 +114,11 @@ var appHelpFlagGroups = []flagGroup{
 			flags.EnableDutyCountDown,
 			flags.Web3SignerURLFlag,
 			flags.Web3SignerPublicValidatorKeysFlag,
+			flags.Web3SignerPublicKeysRefreshIntervalFlag,
+			flags.Web3SignerClientCertFlag,
+			flags.Web3SignerClientKeyFlag,
+			flags.Web3SignerCACertFlag,
+			flags.Web3SignerRequestTimeoutFlag,
 			flags.ProposerSettingsFlag,
 			flags.ProposerSettingsURLFlag,
 			flags.SuggestedFeeRecipientFlag,
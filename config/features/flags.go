@@ -92,7 +92,8 @@ var (
 	}
 	enableDoppelGangerProtection = &cli.BoolFlag{
 		Name: "enable-doppelganger",
-		Usage: "Enables the validator to perform a doppelganger check. (Warning): This is not " +
+		Usage: "Enables the validator to perform a doppelganger check, newly loaded keys only start performing " +
+			"duties once the beacon node has seen them offline for 2 epochs. (Warning): This is not " +
 			"a foolproof method to find duplicate instances in the network. Your validator will still be" +
 			" vulnerable if it is being run in unsafe configurations.",
 	}
@@ -126,6 +127,11 @@ var (
 		Name:  "enable-only-blinded-beacon-blocks",
 		Usage: "Enables storing only blinded beacon blocks in the database without full execution layer transactions",
 	}
+	enableLightClientServer = &cli.BoolFlag{
+		Name: "enable-light-client-server",
+		Usage: "Enables the light client server, which computes light client updates, serves them at the " +
+			"/eth/v1/beacon/light_client endpoints and gossips light client finality and optimistic updates",
+	}
 )
 
 // devModeFlags holds list of flags that are set when development mode is on.
@@ -177,6 +183,7 @@ var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	enableForkChoiceDoublyLinkedTree,
 	enableGossipBatchAggregation,
 	EnableOnlyBlindedBeaconBlocks,
+	enableLightClientServer,
 }...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
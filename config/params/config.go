@@ -197,6 +197,11 @@ type BeaconChainConfig struct {
 	DefaultFeeRecipient              common.Address // DefaultFeeRecipient where the transaction fee goes to.
 	EthBurnAddressHex                string         // EthBurnAddressHex is the constant eth address written in hex format to burn fees in that network. the default is 0x0
 	DefaultBuilderGasLimit           uint64         // DefaultBuilderGasLimit is the default used to set the gaslimit for the Builder APIs, typically at around 30M wei.
+	LocalBlockValueBoost             uint64         // LocalBlockValueBoost is the percentage by which a builder bid must exceed the local payload value to be used for block construction.
+
+	// Mev-boost circuit breaker
+	MaxBuilderConsecutiveMissedSlots types.Slot // MaxBuilderConsecutiveMissedSlots is the number of consecutive missed slots after which the builder is no longer used for block construction.
+	MaxBuilderEpochMissedSlots       types.Slot // MaxBuilderEpochMissedSlots is the number of missed slots within the last epoch after which the builder is no longer used for block construction.
 }
 
 // InitializeForkSchedule initializes the schedules forks baked into the config.
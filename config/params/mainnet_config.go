@@ -249,6 +249,11 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	TerminalTotalDifficulty:          "115792089237316195423570985008687907853269984665640564039457584007913129638912",
 	EthBurnAddressHex:                "0x0000000000000000000000000000000000000000",
 	DefaultBuilderGasLimit:           uint64(30000000),
+	LocalBlockValueBoost:             0,
+
+	// Mev-boost circuit breaker
+	MaxBuilderConsecutiveMissedSlots: 3,
+	MaxBuilderEpochMissedSlots:       8,
 }
 
 // MainnetTestConfig provides a version of the mainnet config that has a different name
@@ -2,6 +2,7 @@ package validator_service_config
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 )
@@ -49,3 +50,205 @@ func DefaultProposerOption() ProposerOption {
 		BuilderConfig: nil,
 	}
 }
+
+// GasLimit returns the builder gas limit of the validator public key, falling back to the gas limit of the
+// default config, and to the default builder gas limit of the network.
+func (ps *ProposerSettings) GasLimit(pubKey [fieldparams.BLSPubkeyLength]byte) uint64 {
+	if ps.ProposeConfig != nil {
+		option, ok := ps.ProposeConfig[pubKey]
+		if ok && option != nil && option.BuilderConfig != nil {
+			return option.BuilderConfig.GasLimit
+		}
+	}
+	if ps.DefaultConfig != nil && ps.DefaultConfig.BuilderConfig != nil {
+		return ps.DefaultConfig.BuilderConfig.GasLimit
+	}
+	return params.BeaconConfig().DefaultBuilderGasLimit
+}
+
+// Relays returns the builder relays of the validator public key, falling back to the relays of the default config.
+func (ps *ProposerSettings) Relays(pubKey [fieldparams.BLSPubkeyLength]byte) []string {
+	if ps.ProposeConfig != nil {
+		option, ok := ps.ProposeConfig[pubKey]
+		if ok && option != nil && option.BuilderConfig != nil {
+			return option.BuilderConfig.Relays
+		}
+	}
+	if ps.DefaultConfig != nil && ps.DefaultConfig.BuilderConfig != nil {
+		return ps.DefaultConfig.BuilderConfig.Relays
+	}
+	return nil
+}
+
+// BuilderEnabled returns whether the builder is enabled for the validator public key, falling back to the builder
+// config of the default config.
+func (ps *ProposerSettings) BuilderEnabled(pubKey [fieldparams.BLSPubkeyLength]byte) bool {
+	if ps.ProposeConfig != nil {
+		option, ok := ps.ProposeConfig[pubKey]
+		if ok && option != nil && option.BuilderConfig != nil {
+			return option.BuilderConfig.Enabled
+		}
+	}
+	return ps.DefaultConfig != nil && ps.DefaultConfig.BuilderConfig != nil && ps.DefaultConfig.BuilderConfig.Enabled
+}
+
+// SetFeeRecipient sets the fee recipient of the validator public key. The proposer option of the key is created from
+// the default config if the key has none.
+func (ps *ProposerSettings) SetFeeRecipient(pubKey [fieldparams.BLSPubkeyLength]byte, feeRecipient common.Address) {
+	ps.option(pubKey).FeeRecipient = feeRecipient
+}
+
+// SetGasLimit sets the builder gas limit of the validator public key. The proposer option of the key is created from
+// the default config if the key has none. Builder configs may be shared between keys, so the builder config of the
+// key is copied before it is updated.
+func (ps *ProposerSettings) SetGasLimit(pubKey [fieldparams.BLSPubkeyLength]byte, gasLimit uint64) {
+	option := ps.option(pubKey)
+	builderConfig := &BuilderConfig{}
+	if option.BuilderConfig != nil {
+		*builderConfig = *option.BuilderConfig
+	}
+	builderConfig.GasLimit = gasLimit
+	option.BuilderConfig = builderConfig
+}
+
+// SetBuilderEnabled enables or disables the builder for the validator public key. The proposer option of the key is
+// created from the default config if the key has none, and a builder config using the default builder gas limit of
+// the network is created if the key has none. Builder configs may be shared between keys, so the builder config of
+// the key is copied before it is updated.
+func (ps *ProposerSettings) SetBuilderEnabled(pubKey [fieldparams.BLSPubkeyLength]byte, enabled bool) {
+	option := ps.option(pubKey)
+	builderConfig := &BuilderConfig{GasLimit: params.BeaconConfig().DefaultBuilderGasLimit}
+	if option.BuilderConfig != nil {
+		*builderConfig = *option.BuilderConfig
+	}
+	builderConfig.Enabled = enabled
+	option.BuilderConfig = builderConfig
+}
+
+// option returns the proposer option of the validator public key, creating it from the default config if needed.
+func (ps *ProposerSettings) option(pubKey [fieldparams.BLSPubkeyLength]byte) *ProposerOption {
+	if ps.ProposeConfig == nil {
+		ps.ProposeConfig = make(map[[fieldparams.BLSPubkeyLength]byte]*ProposerOption)
+	}
+	option, ok := ps.ProposeConfig[pubKey]
+	if !ok || option == nil {
+		newOption := DefaultProposerOption()
+		if ps.DefaultConfig != nil {
+			newOption.FeeRecipient = ps.DefaultConfig.FeeRecipient
+			newOption.BuilderConfig = ps.DefaultConfig.BuilderConfig
+		}
+		option = &newOption
+		ps.ProposeConfig[pubKey] = option
+	}
+	return option
+}
+
+// Clone returns a deep copy of the proposer settings.
+func (ps *ProposerSettings) Clone() *ProposerSettings {
+	if ps == nil {
+		return nil
+	}
+	cloned := &ProposerSettings{DefaultConfig: ps.DefaultConfig.Clone()}
+	if ps.ProposeConfig != nil {
+		cloned.ProposeConfig = make(map[[fieldparams.BLSPubkeyLength]byte]*ProposerOption, len(ps.ProposeConfig))
+		for pubKey, option := range ps.ProposeConfig {
+			cloned.ProposeConfig[pubKey] = option.Clone()
+		}
+	}
+	return cloned
+}
+
+// Clone returns a deep copy of the proposer option.
+func (po *ProposerOption) Clone() *ProposerOption {
+	if po == nil {
+		return nil
+	}
+	return &ProposerOption{
+		FeeRecipient:  po.FeeRecipient,
+		BuilderConfig: po.BuilderConfig.Clone(),
+	}
+}
+
+// Clone returns a deep copy of the builder config.
+func (bc *BuilderConfig) Clone() *BuilderConfig {
+	if bc == nil {
+		return nil
+	}
+	cloned := &BuilderConfig{
+		Enabled:  bc.Enabled,
+		GasLimit: bc.GasLimit,
+	}
+	if bc.Relays != nil {
+		cloned.Relays = make([]string, len(bc.Relays))
+		copy(cloned.Relays, bc.Relays)
+	}
+	return cloned
+}
+
+// ToPayload converts the proposer settings to the format of the proposer settings file, so that the settings in use
+// can be exported and loaded back through the CLI.
+func (ps *ProposerSettings) ToPayload() *ProposerSettingsPayload {
+	payload := &ProposerSettingsPayload{}
+	if ps.DefaultConfig != nil {
+		payload.DefaultConfig = ps.DefaultConfig.toPayload()
+	}
+	if len(ps.ProposeConfig) > 0 {
+		payload.ProposerConfig = make(map[string]*ProposerOptionPayload, len(ps.ProposeConfig))
+		for pubKey, option := range ps.ProposeConfig {
+			if option == nil {
+				continue
+			}
+			payload.ProposerConfig[hexutil.Encode(pubKey[:])] = option.toPayload()
+		}
+	}
+	return payload
+}
+
+func (po *ProposerOption) toPayload() *ProposerOptionPayload {
+	return &ProposerOptionPayload{
+		FeeRecipient:  po.FeeRecipient.Hex(),
+		BuilderConfig: po.BuilderConfig.Clone(),
+	}
+}
+
+// ProposerOptionOverride holds the proposer settings of a validator public key which were edited through the
+// keymanager API. Nil fields are not overridden.
+type ProposerOptionOverride struct {
+	FeeRecipient   *common.Address `json:"fee_recipient,omitempty"`
+	GasLimit       *uint64         `json:"gas_limit,omitempty"`
+	BuilderEnabled *bool           `json:"builder_enabled,omitempty"`
+}
+
+// IsEmpty returns true if the override does not override any setting.
+func (o *ProposerOptionOverride) IsEmpty() bool {
+	return o == nil || (o.FeeRecipient == nil && o.GasLimit == nil && o.BuilderEnabled == nil)
+}
+
+// WithOverrides returns the proposer settings resulting from applying the overrides edited through the keymanager
+// API to the proposer settings loaded from the proposer settings file or URL, or from the flags. The overrides take
+// precedence, setting by setting, so that a setting of a key which was not edited keeps following the loaded
+// settings. The loaded settings are left untouched.
+func WithOverrides(
+	settings *ProposerSettings, overrides map[[fieldparams.BLSPubkeyLength]byte]*ProposerOptionOverride,
+) *ProposerSettings {
+	settings = settings.Clone()
+	for pubKey, override := range overrides {
+		if override.IsEmpty() {
+			continue
+		}
+		if settings == nil {
+			defaultOption := DefaultProposerOption()
+			settings = &ProposerSettings{DefaultConfig: &defaultOption}
+		}
+		if override.FeeRecipient != nil {
+			settings.SetFeeRecipient(pubKey, *override.FeeRecipient)
+		}
+		if override.BuilderEnabled != nil {
+			settings.SetBuilderEnabled(pubKey, *override.BuilderEnabled)
+		}
+		if override.GasLimit != nil {
+			settings.SetGasLimit(pubKey, *override.GasLimit)
+		}
+	}
+	return settings
+}
@@ -0,0 +1,128 @@
+package validator_service_config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestWithOverrides(t *testing.T) {
+	key1 := [fieldparams.BLSPubkeyLength]byte{1}
+	key2 := [fieldparams.BLSPubkeyLength]byte{2}
+	key3 := [fieldparams.BLSPubkeyLength]byte{3}
+	defaultFeeRecipient := common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9")
+	fileFeeRecipient := common.HexToAddress("0x055Fb65722E7b2455012BFEBf6177F1D2e9738D5")
+	apiFeeRecipient := common.HexToAddress("0x6e35733c5af9B61374A128e6F85f553aF09ff89A")
+	apiGasLimit := uint64(40000000)
+	builderEnabled := true
+	builderDisabled := false
+	defaultBuilderConfig := &BuilderConfig{Enabled: true, GasLimit: 30000000, Relays: []string{"relay"}}
+	settings := &ProposerSettings{
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*ProposerOption{
+			key1: {FeeRecipient: fileFeeRecipient, BuilderConfig: &BuilderConfig{GasLimit: 35000000}},
+		},
+		DefaultConfig: &ProposerOption{FeeRecipient: defaultFeeRecipient, BuilderConfig: defaultBuilderConfig},
+	}
+
+	t.Run("overrides take precedence per setting", func(t *testing.T) {
+		got := WithOverrides(settings, map[[fieldparams.BLSPubkeyLength]byte]*ProposerOptionOverride{
+			key1: {GasLimit: &apiGasLimit},
+			key2: {FeeRecipient: &apiFeeRecipient},
+			key3: {},
+		})
+		// The fee recipient of the file is kept as only the gas limit was edited.
+		assert.Equal(t, fileFeeRecipient, got.ProposeConfig[key1].FeeRecipient)
+		assert.Equal(t, apiGasLimit, got.GasLimit(key1))
+		assert.Equal(t, false, got.ProposeConfig[key1].BuilderConfig.Enabled)
+		// Keys which are not in the file follow the default config for the settings which were not edited.
+		assert.Equal(t, apiFeeRecipient, got.ProposeConfig[key2].FeeRecipient)
+		assert.DeepEqual(t, defaultBuilderConfig, got.ProposeConfig[key2].BuilderConfig)
+		_, ok := got.ProposeConfig[key3]
+		assert.Equal(t, false, ok)
+	})
+	t.Run("loaded settings are not modified", func(t *testing.T) {
+		got := WithOverrides(settings, map[[fieldparams.BLSPubkeyLength]byte]*ProposerOptionOverride{
+			key1: {FeeRecipient: &apiFeeRecipient, GasLimit: &apiGasLimit},
+		})
+		got.DefaultConfig.BuilderConfig.Relays[0] = "other"
+		assert.Equal(t, fileFeeRecipient, settings.ProposeConfig[key1].FeeRecipient)
+		assert.Equal(t, uint64(35000000), settings.GasLimit(key1))
+		assert.Equal(t, "relay", defaultBuilderConfig.Relays[0])
+	})
+	t.Run("no loaded settings", func(t *testing.T) {
+		assert.Equal(t, true, WithOverrides(nil, nil) == nil)
+		got := WithOverrides(nil, map[[fieldparams.BLSPubkeyLength]byte]*ProposerOptionOverride{
+			key1: {GasLimit: &apiGasLimit},
+		})
+		require.NotNil(t, got.DefaultConfig)
+		assert.Equal(t, params.BeaconConfig().DefaultFeeRecipient, got.DefaultConfig.FeeRecipient)
+		assert.Equal(t, apiGasLimit, got.GasLimit(key1))
+		assert.Equal(t, params.BeaconConfig().DefaultBuilderGasLimit, got.GasLimit(key2))
+	})
+	t.Run("builder enabled", func(t *testing.T) {
+		got := WithOverrides(settings, map[[fieldparams.BLSPubkeyLength]byte]*ProposerOptionOverride{
+			key1: {BuilderEnabled: &builderEnabled},
+			key2: {BuilderEnabled: &builderDisabled, GasLimit: &apiGasLimit},
+		})
+		// The gas limit of the file is kept as only the builder was enabled.
+		assert.Equal(t, true, got.ProposeConfig[key1].BuilderConfig.Enabled)
+		assert.Equal(t, uint64(35000000), got.GasLimit(key1))
+		assert.Equal(t, false, got.ProposeConfig[key2].BuilderConfig.Enabled)
+		assert.Equal(t, apiGasLimit, got.GasLimit(key2))
+		assert.DeepEqual(t, []string{"relay"}, got.ProposeConfig[key2].BuilderConfig.Relays)
+		// The default builder config shared by the other keys is not modified.
+		assert.Equal(t, true, got.DefaultConfig.BuilderConfig.Enabled)
+		assert.Equal(t, false, settings.ProposeConfig[key1].BuilderConfig.Enabled)
+
+		got = WithOverrides(nil, map[[fieldparams.BLSPubkeyLength]byte]*ProposerOptionOverride{
+			key1: {BuilderEnabled: &builderEnabled},
+		})
+		assert.Equal(t, true, got.ProposeConfig[key1].BuilderConfig.Enabled)
+		assert.Equal(t, params.BeaconConfig().DefaultBuilderGasLimit, got.GasLimit(key1))
+	})
+}
+
+func TestProposerSettings_ToPayload(t *testing.T) {
+	key := [fieldparams.BLSPubkeyLength]byte{1}
+	settings := &ProposerSettings{
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*ProposerOption{
+			key: {
+				FeeRecipient:  common.HexToAddress("0x055fb65722e7b2455012bfebf6177f1d2e9738d5"),
+				BuilderConfig: &BuilderConfig{Enabled: true, GasLimit: 35000000},
+			},
+		},
+		DefaultConfig: &ProposerOption{FeeRecipient: common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9")},
+	}
+	want := &ProposerSettingsPayload{
+		ProposerConfig: map[string]*ProposerOptionPayload{
+			"0x01" + strings.Repeat("0", 94): {
+				FeeRecipient:  "0x055fb65722E7b2455012BfeBF6177F1d2E9738d5",
+				BuilderConfig: &BuilderConfig{Enabled: true, GasLimit: 35000000},
+			},
+		},
+		DefaultConfig: &ProposerOptionPayload{FeeRecipient: "0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9"},
+	}
+	assert.DeepEqual(t, want, settings.ToPayload())
+}
+
+func TestProposerSettings_BuilderEnabled(t *testing.T) {
+	key1 := [fieldparams.BLSPubkeyLength]byte{1}
+	key2 := [fieldparams.BLSPubkeyLength]byte{2}
+	key3 := [fieldparams.BLSPubkeyLength]byte{3}
+	settings := &ProposerSettings{
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*ProposerOption{
+			key1: {BuilderConfig: &BuilderConfig{Enabled: false}},
+			key2: {},
+		},
+		DefaultConfig: &ProposerOption{BuilderConfig: &BuilderConfig{Enabled: true}},
+	}
+	assert.Equal(t, false, settings.BuilderEnabled(key1))
+	assert.Equal(t, true, settings.BuilderEnabled(key2))
+	assert.Equal(t, true, settings.BuilderEnabled(key3))
+	assert.Equal(t, false, (&ProposerSettings{}).BuilderEnabled(key3))
+}
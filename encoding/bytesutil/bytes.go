@@ -4,6 +4,7 @@ package bytesutil
 import (
 	"encoding/binary"
 	"fmt"
+	"math/big"
 	"math/bits"
 	"regexp"
 
@@ -414,6 +415,12 @@ func ReverseByteOrder(input []byte) []byte {
 	return b
 }
 
+// LittleEndianBytesToBigInt takes bytes of a number stored as little-endian and returns a big integer.
+func LittleEndianBytesToBigInt(input []byte) *big.Int {
+	// big.Int expects big-endian bytes, so the byte order is reversed before decoding.
+	return new(big.Int).SetBytes(ReverseByteOrder(input))
+}
+
 // ZeroRoot returns whether or not a root is of proper length and non-zero hash.
 func ZeroRoot(root []byte) bool {
 	return string(make([]byte, fieldparams.RootLength)) == string(root)
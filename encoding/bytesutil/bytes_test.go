@@ -510,6 +510,14 @@ func TestReverseByteOrder(t *testing.T) {
 	assert.Equal(t, bytes.Equal(expectedResult, output), true)
 }
 
+func TestLittleEndianBytesToBigInt(t *testing.T) {
+	input := []byte{0x01, 0x02, 0x00, 0x00}
+	output := bytesutil.LittleEndianBytesToBigInt(input)
+
+	assert.Equal(t, int64(0x0201), output.Int64())
+	assert.Equal(t, bytes.Equal(input, []byte{0x01, 0x02, 0x00, 0x00}), true)
+}
+
 func TestSafeCopy2d32Bytes(t *testing.T) {
 	input := make([][32]byte, 2)
 	input[0] = bytesutil.ToBytes32([]byte{'a'})
@@ -234,6 +234,24 @@ func V1ExitToV1Alpha1(v1Exit *ethpbv1.SignedVoluntaryExit) *ethpbalpha.SignedVol
 	}
 }
 
+// V1SignedValidatorRegistrationToV1Alpha1 converts a v1 signed validator registration to v1alpha1.
+func V1SignedValidatorRegistrationToV1Alpha1(
+	v1Reg *ethpbv1.SubmitValidatorRegistrationsRequest_SignedValidatorRegistration,
+) *ethpbalpha.SignedValidatorRegistrationV1 {
+	if v1Reg == nil || v1Reg.Message == nil {
+		return &ethpbalpha.SignedValidatorRegistrationV1{}
+	}
+	return &ethpbalpha.SignedValidatorRegistrationV1{
+		Message: &ethpbalpha.ValidatorRegistrationV1{
+			FeeRecipient: v1Reg.Message.FeeRecipient,
+			GasLimit:     v1Reg.Message.GasLimit,
+			Timestamp:    v1Reg.Message.Timestamp,
+			Pubkey:       v1Reg.Message.Pubkey,
+		},
+		Signature: v1Reg.Signature,
+	}
+}
+
 // V1AttToV1Alpha1 converts a v1 attestation to v1alpha1.
 func V1AttToV1Alpha1(v1Att *ethpbv1.Attestation) *ethpbalpha.Attestation {
 	if v1Att == nil {
@@ -242,6 +242,29 @@ func Test_V1ExitToV1Alpha1(t *testing.T) {
 	assert.DeepEqual(t, alphaRoot, v1Root)
 }
 
+func Test_V1SignedValidatorRegistrationToV1Alpha1(t *testing.T) {
+	v1Reg := &ethpbv1.SubmitValidatorRegistrationsRequest_SignedValidatorRegistration{
+		Message: &ethpbv1.SubmitValidatorRegistrationsRequest_ValidatorRegistration{
+			FeeRecipient: feeRecipient,
+			GasLimit:     gasLimit,
+			Timestamp:    timestamp,
+			Pubkey:       bytesutil.PadTo([]byte("pubkey"), 48),
+		},
+		Signature: signature,
+	}
+
+	alphaReg := V1SignedValidatorRegistrationToV1Alpha1(v1Reg)
+	assert.DeepEqual(t, &ethpbalpha.SignedValidatorRegistrationV1{
+		Message: &ethpbalpha.ValidatorRegistrationV1{
+			FeeRecipient: v1Reg.Message.FeeRecipient,
+			GasLimit:     gasLimit,
+			Timestamp:    timestamp,
+			Pubkey:       v1Reg.Message.Pubkey,
+		},
+		Signature: signature,
+	}, alphaReg)
+}
+
 func Test_V1AttSlashingToV1Alpha1(t *testing.T) {
 	v1Attestation := &ethpbv1.IndexedAttestation{
 		AttestingIndices: attestingIndices,
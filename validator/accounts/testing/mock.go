@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	validatorserviceconfig "github.com/prysmaticlabs/prysm/config/validator/service"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
@@ -110,6 +111,10 @@ func (_ MockValidator) NextSlot() <-chan types.Slot {
 	panic("implement me")
 }
 
+func (_ MockValidator) BeaconNodeFailovers() <-chan struct{} {
+	panic("implement me")
+}
+
 func (_ MockValidator) SlotDeadline(_ types.Slot) time.Time {
 	panic("implement me")
 }
@@ -122,6 +127,10 @@ func (_ MockValidator) UpdateDuties(_ context.Context, _ types.Slot) error {
 	panic("implement me")
 }
 
+func (_ MockValidator) ResetDuties() {
+	panic("implement me")
+}
+
 func (_ MockValidator) RolesAt(_ context.Context, _ types.Slot) (map[[48]byte][]iface2.ValidatorRole, error) {
 	panic("implement me")
 }
@@ -178,15 +187,22 @@ func (_ MockValidator) HandleKeyReload(_ context.Context, _ [][48]byte) (bool, e
 	panic("implement me")
 }
 
-func (_ MockValidator) CheckDoppelGanger(_ context.Context) error {
+func (_ MockValidator) StartDoppelGangerDetection(_ context.Context) error {
+	panic("implement me")
+}
+
+func (_ MockValidator) CheckDoppelGanger(_ context.Context, _ types.Slot) error {
 	panic("implement me")
 }
 
 // PushProposerSettings for mocking
 func (_ MockValidator) PushProposerSettings(_ context.Context, _ keymanager.IKeymanager) error {
-	panic("implement me")
+	return nil
 }
 
+// SetProposerSettings for mocking
+func (_ MockValidator) SetProposerSettings(_ *validatorserviceconfig.ProposerSettings) {}
+
 // SetPubKeyToValidatorIndexMap for mocking
 func (_ MockValidator) SetPubKeyToValidatorIndexMap(_ context.Context, _ keymanager.IKeymanager) error {
 	panic("implement me")
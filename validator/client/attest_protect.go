@@ -16,6 +16,7 @@ import (
 
 var failedAttLocalProtectionErr = "attempted to make slashable attestation, rejected by local slashing protection"
 var failedPostAttSignExternalErr = "attempted to make slashable attestation, rejected by external slasher service"
+var failedAttRemoteProtectionErr = "attestation rejected by remote slashing protection"
 
 // Checks if an attestation is slashable by comparing it with the attesting
 // history for the given public key in our DB. If it is not, we then update the history
@@ -78,6 +79,15 @@ func (v *validator) slashableAttestationCheck(
 		return errors.Wrap(err, failedAttLocalProtectionErr)
 	}
 
+	if v.remoteSlashingProtection != nil {
+		if err := v.remoteSlashingProtection.checkAttestation(ctx, pubKey, signingRoot, indexedAtt); err != nil {
+			if v.emitAccountMetrics {
+				ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
+			}
+			return errors.Wrap(err, failedAttRemoteProtectionErr)
+		}
+	}
+
 	if err := v.db.SaveAttestationForPubKey(ctx, pubKey, signingRoot, indexedAtt); err != nil {
 		return errors.Wrap(err, "could not save attestation history for validator public key")
 	}
@@ -0,0 +1,189 @@
+package beacon_api
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// The Beacon API encodes consensus objects the same way as protojson with proto field names, except for:
+//   - byte fields, which are 0x-prefixed hex strings instead of base64 strings,
+//   - enums, which are lower case,
+//   - timestamps, which are unix seconds instead of RFC 3339 strings,
+//   - the execution payload base fee, which is a decimal uint256 instead of little-endian bytes.
+// The helpers below convert between the two encodings by walking the JSON tree alongside the proto descriptor,
+// which lets the client reuse the eth/v1 and eth/v2 protos instead of maintaining a parallel set of JSON structs.
+
+const (
+	baseFeeFieldName  = "base_fee_per_gas"
+	timestampFullName = "google.protobuf.Timestamp"
+)
+
+type valueConverter func(fd protoreflect.FieldDescriptor, v interface{}) (interface{}, error)
+
+// marshalApiJSON encodes the message in the Beacon API JSON format.
+func marshalApiJSON(m proto.Message) ([]byte, error) {
+	data, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := decodeJSONObject(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := convertMessage(obj, m.ProtoReflect().Descriptor(), toApiValue); err != nil {
+		return nil, err
+	}
+	return json.Marshal(obj)
+}
+
+// marshalApiJSONArray encodes the messages as a Beacon API JSON array.
+func marshalApiJSONArray(msgs []proto.Message) ([]byte, error) {
+	items := make([]json.RawMessage, len(msgs))
+	for i, m := range msgs {
+		item, err := marshalApiJSON(m)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = item
+	}
+	return json.Marshal(items)
+}
+
+// unmarshalApiJSON decodes Beacon API JSON into the message. Fields unknown to the message are ignored.
+func unmarshalApiJSON(data []byte, m proto.Message) error {
+	obj, err := decodeJSONObject(data)
+	if err != nil {
+		return err
+	}
+	if err := convertMessage(obj, m.ProtoReflect().Descriptor(), fromApiValue); err != nil {
+		return err
+	}
+	converted, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(converted, m)
+}
+
+func decodeJSONObject(data []byte) (map[string]interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	// Keep numbers as they are so that 64 bit values do not lose precision.
+	dec.UseNumber()
+	obj := make(map[string]interface{})
+	if err := dec.Decode(&obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func convertMessage(obj map[string]interface{}, md protoreflect.MessageDescriptor, conv valueConverter) error {
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := string(fd.Name())
+		v, ok := obj[name]
+		if !ok || v == nil || fd.IsMap() {
+			continue
+		}
+		if !fd.IsList() {
+			converted, err := convertValue(fd, v, conv)
+			if err != nil {
+				return errors.Wrapf(err, "field %s", name)
+			}
+			obj[name] = converted
+			continue
+		}
+		list, ok := v.([]interface{})
+		if !ok {
+			return errors.Errorf("field %s is not a list", name)
+		}
+		for j := range list {
+			converted, err := convertValue(fd, list[j], conv)
+			if err != nil {
+				return errors.Wrapf(err, "field %s[%d]", name, j)
+			}
+			list[j] = converted
+		}
+	}
+	return nil
+}
+
+func convertValue(fd protoreflect.FieldDescriptor, v interface{}, conv valueConverter) (interface{}, error) {
+	if fd.Kind() != protoreflect.MessageKind || fd.Message().FullName() == timestampFullName {
+		return conv(fd, v)
+	}
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("not an object")
+	}
+	return obj, convertMessage(obj, fd.Message(), conv)
+}
+
+func fromApiValue(fd protoreflect.FieldDescriptor, v interface{}) (interface{}, error) {
+	s, ok := v.(string)
+	if !ok {
+		return v, nil
+	}
+	switch {
+	case fd.Kind() == protoreflect.MessageKind:
+		secs, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return time.Unix(secs, 0).UTC().Format(time.RFC3339), nil
+	case fd.Kind() == protoreflect.EnumKind:
+		return strings.ToUpper(s), nil
+	case fd.Kind() == protoreflect.BytesKind && fd.Name() == baseFeeFieldName:
+		baseFee, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			return nil, errors.Errorf("invalid uint256 %s", s)
+		}
+		return base64.StdEncoding.EncodeToString(bytesutil.PadTo(bytesutil.ReverseByteOrder(baseFee.Bytes()), 32)), nil
+	case fd.Kind() == protoreflect.BytesKind:
+		b, err := hexutil.Decode(s)
+		if err != nil {
+			return nil, err
+		}
+		return base64.StdEncoding.EncodeToString(b), nil
+	}
+	return v, nil
+}
+
+func toApiValue(fd protoreflect.FieldDescriptor, v interface{}) (interface{}, error) {
+	s, ok := v.(string)
+	if !ok {
+		return v, nil
+	}
+	switch {
+	case fd.Kind() == protoreflect.MessageKind:
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, err
+		}
+		return strconv.FormatInt(t.Unix(), 10), nil
+	case fd.Kind() == protoreflect.EnumKind:
+		return strings.ToLower(s), nil
+	case fd.Kind() == protoreflect.BytesKind:
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return nil, err
+		}
+		if fd.Name() == baseFeeFieldName {
+			return new(big.Int).SetBytes(bytesutil.ReverseByteOrder(b)).String(), nil
+		}
+		return hexutil.Encode(b), nil
+	}
+	return v, nil
+}
@@ -0,0 +1,73 @@
+package beacon_api
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestApiJSON_ExecutionPayload(t *testing.T) {
+	baseFee := bytesutil.PadTo(bytesutil.ReverseByteOrder(big.NewInt(1000000007).Bytes()), 32)
+	payload := &enginev1.ExecutionPayload{
+		ParentHash:    bytesutil.PadTo([]byte{0xab}, 32),
+		FeeRecipient:  make([]byte, 20),
+		StateRoot:     make([]byte, 32),
+		ReceiptsRoot:  make([]byte, 32),
+		LogsBloom:     make([]byte, 256),
+		PrevRandao:    make([]byte, 32),
+		BlockNumber:   12,
+		GasLimit:      30000000,
+		BaseFeePerGas: baseFee,
+		BlockHash:     make([]byte, 32),
+		Transactions:  [][]byte{{0x01, 0x02}},
+	}
+	enc, err := marshalApiJSON(payload)
+	require.NoError(t, err)
+
+	fields := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal(enc, &fields))
+	assert.Equal(t, "1000000007", fields["base_fee_per_gas"])
+	assert.Equal(t, "0xab00000000000000000000000000000000000000000000000000000000000000", fields["parent_hash"])
+	assert.Equal(t, "12", fields["block_number"])
+	assert.DeepEqual(t, []interface{}{"0x0102"}, fields["transactions"])
+
+	decoded := &enginev1.ExecutionPayload{}
+	require.NoError(t, unmarshalApiJSON(enc, decoded))
+	assert.DeepEqual(t, payload, decoded)
+}
+
+func TestApiJSON_EnumsAndTimestamps(t *testing.T) {
+	data := []byte(`{
+		"data": {
+			"genesis_time": "1606824023",
+			"genesis_validators_root": "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95",
+			"genesis_fork_version": "0x00000000",
+			"unknown_field": "ignored"
+		}
+	}`)
+	genesis := &ethpbv1.GenesisResponse{}
+	require.NoError(t, unmarshalApiJSON(data, genesis))
+	assert.Equal(t, int64(1606824023), genesis.Data.GenesisTime.AsTime().Unix())
+	assert.Equal(t, "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95", hexutil.Encode(genesis.Data.GenesisValidatorsRoot))
+
+	val := &ethpbv1.ValidatorContainer{}
+	require.NoError(t, unmarshalApiJSON([]byte(`{"index": "3", "balance": "32000000000", "status": "active_ongoing"}`), val))
+	assert.Equal(t, ethpbv1.ValidatorStatus_ACTIVE_ONGOING, val.Status)
+
+	enc, err := marshalApiJSON(&ethpbv1.GenesisResponse_Genesis{
+		GenesisTime: timestamppb.New(time.Unix(1606824023, 0)),
+	})
+	require.NoError(t, err)
+	fields := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal(enc, &fields))
+	assert.Equal(t, "1606824023", fields["genesis_time"])
+}
@@ -0,0 +1,116 @@
+package beacon_api
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// GetAttestationData asks the beacon node for the data to attest to in the requested slot and committee.
+func (c *beaconApiValidatorClient) GetAttestationData(ctx context.Context, in *ethpb.AttestationDataRequest, _ ...grpc.CallOption) (*ethpb.AttestationData, error) {
+	query := url.Values{}
+	query.Set("slot", strconv.FormatUint(uint64(in.Slot), 10))
+	query.Set("committee_index", strconv.FormatUint(uint64(in.CommitteeIndex), 10))
+	resp := &ethpbv1.ProduceAttestationDataResponse{}
+	if err := c.jsonRestHandler.get(ctx, "/eth/v1/validator/attestation_data?"+query.Encode(), resp); err != nil {
+		return nil, errors.Wrap(err, "could not get attestation data")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("beacon node returned empty attestation data")
+	}
+	data := &ethpb.AttestationData{}
+	if err := convertSSZ(resp.Data, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// ProposeAttestation publishes an attestation through the beacon node.
+func (c *beaconApiValidatorClient) ProposeAttestation(ctx context.Context, in *ethpb.Attestation, _ ...grpc.CallOption) (*ethpb.AttestResponse, error) {
+	att := &ethpbv1.Attestation{}
+	if err := convertSSZ(in, att); err != nil {
+		return nil, err
+	}
+	body, err := marshalApiJSONArray([]proto.Message{att})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.jsonRestHandler.post(ctx, "/eth/v1/beacon/pool/attestations", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not publish attestation")
+	}
+	root, err := in.Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+	return &ethpb.AttestResponse{AttestationDataRoot: root[:]}, nil
+}
+
+// SubmitAggregateSelectionProof asks the beacon node for the best aggregate of the requested committee, and wraps
+// it into an aggregate and proof for the validator to sign.
+func (c *beaconApiValidatorClient) SubmitAggregateSelectionProof(ctx context.Context, in *ethpb.AggregateSelectionRequest, _ ...grpc.CallOption) (*ethpb.AggregateSelectionResponse, error) {
+	indexResp, err := c.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: in.PublicKey})
+	if err != nil {
+		return nil, err
+	}
+	data, err := c.GetAttestationData(ctx, &ethpb.AttestationDataRequest{Slot: in.Slot, CommitteeIndex: in.CommitteeIndex})
+	if err != nil {
+		return nil, err
+	}
+	root, err := data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+
+	query := url.Values{}
+	query.Set("attestation_data_root", hexutil.Encode(root[:]))
+	query.Set("slot", strconv.FormatUint(uint64(in.Slot), 10))
+	resp := &ethpbv1.AggregateAttestationResponse{}
+	if err := c.jsonRestHandler.get(ctx, "/eth/v1/validator/aggregate_attestation?"+query.Encode(), resp); err != nil {
+		return nil, errors.Wrap(err, "could not get aggregate attestation")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("beacon node returned empty aggregate attestation")
+	}
+	aggregate := &ethpb.Attestation{}
+	if err := convertSSZ(resp.Data, aggregate); err != nil {
+		return nil, err
+	}
+	return &ethpb.AggregateSelectionResponse{
+		AggregateAndProof: &ethpb.AggregateAttestationAndProof{
+			AggregatorIndex: indexResp.Index,
+			Aggregate:       aggregate,
+			SelectionProof:  in.SlotSignature,
+		},
+	}, nil
+}
+
+// SubmitSignedAggregateSelectionProof publishes a signed aggregate and proof through the beacon node.
+func (c *beaconApiValidatorClient) SubmitSignedAggregateSelectionProof(ctx context.Context, in *ethpb.SignedAggregateSubmitRequest, _ ...grpc.CallOption) (*ethpb.SignedAggregateSubmitResponse, error) {
+	if in.SignedAggregateAndProof == nil || in.SignedAggregateAndProof.Message == nil ||
+		in.SignedAggregateAndProof.Message.Aggregate == nil {
+		return nil, errors.New("signed aggregate and proof can not be empty")
+	}
+	signed := &ethpbv1.SignedAggregateAttestationAndProof{}
+	if err := convertSSZ(in.SignedAggregateAndProof, signed); err != nil {
+		return nil, err
+	}
+	body, err := marshalApiJSONArray([]proto.Message{signed})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.jsonRestHandler.post(ctx, "/eth/v1/validator/aggregate_and_proofs", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not publish aggregate and proof")
+	}
+	root, err := in.SignedAggregateAndProof.Message.Aggregate.Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+	return &ethpb.SignedAggregateSubmitResponse{AttestationDataRoot: root[:]}, nil
+}
@@ -0,0 +1,81 @@
+package beacon_api
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/network/forks"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// beaconApiValidatorClient implements iface.ValidatorClient on top of the standard Beacon API, which allows the
+// validator client to work with any beacon node implementation.
+type beaconApiValidatorClient struct {
+	jsonRestHandler   jsonRestHandler
+	genesisLock       sync.Mutex
+	genesis           *ethpbv1.GenesisResponse_Genesis
+	attesterDutyLock  sync.RWMutex
+	attesterDutyCache map[committeeKey]*ethpbv1.AttesterDuty
+}
+
+// NewBeaconApiValidatorClient returns a validator client which talks to the Beacon API served at host.
+func NewBeaconApiValidatorClient(host string, timeout time.Duration) iface.ValidatorClient {
+	return &beaconApiValidatorClient{
+		jsonRestHandler: jsonRestHandler{
+			httpClient: http.Client{Timeout: timeout},
+			host:       host,
+		},
+		attesterDutyCache: make(map[committeeKey]*ethpbv1.AttesterDuty),
+	}
+}
+
+// DomainData computes the signature domain locally from the fork schedule and the genesis validators root
+// reported by the beacon node, as the Beacon API does not expose domains.
+func (c *beaconApiValidatorClient) DomainData(ctx context.Context, in *ethpb.DomainRequest, _ ...grpc.CallOption) (*ethpb.DomainResponse, error) {
+	genesis, err := c.getGenesis(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fork, err := forks.Fork(in.Epoch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get fork at epoch %d", in.Epoch)
+	}
+	domain, err := signing.Domain(fork, in.Epoch, bytesutil.ToBytes4(in.Domain), genesis.GenesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute domain")
+	}
+	return &ethpb.DomainResponse{SignatureDomain: domain}, nil
+}
+
+// CheckDoppelGanger is not supported over the Beacon API, which serves the liveness of validators instead.
+func (*beaconApiValidatorClient) CheckDoppelGanger(context.Context, *ethpb.DoppelGangerRequest, ...grpc.CallOption) (*ethpb.DoppelGangerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "Doppelganger protection is not supported over the Beacon API")
+}
+
+// SubmitValidatorRegistrations forwards the signed validator registrations to the builder through the beacon node.
+func (c *beaconApiValidatorClient) SubmitValidatorRegistrations(ctx context.Context, in *ethpb.SignedValidatorRegistrationsV1, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	registrations := make([]proto.Message, len(in.Messages))
+	for i, registration := range in.Messages {
+		registrations[i] = registration
+	}
+	body, err := marshalApiJSONArray(registrations)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal validator registrations")
+	}
+	if err := c.jsonRestHandler.post(ctx, "/eth/v1/validator/register_validator", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not submit validator registrations")
+	}
+	return &emptypb.Empty{}, nil
+}
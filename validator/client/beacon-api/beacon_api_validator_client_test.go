@@ -0,0 +1,42 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestSubmitValidatorRegistrations(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/eth/v1/validator/register_validator", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `[{"message":{"fee_recipient":"0x0101010101010101010101010101010101010101","gas_limit":"30000000",`+
+			`"pubkey":"0x`+strings.Repeat("02", 48)+`","timestamp":"1660000000"},"signature":"0x`+strings.Repeat("03", 96)+`"}]`, string(body))
+	}))
+	defer srv.Close()
+
+	c := NewBeaconApiValidatorClient(srv.URL, time.Second)
+	_, err := c.SubmitValidatorRegistrations(context.Background(), &ethpb.SignedValidatorRegistrationsV1{
+		Messages: []*ethpb.SignedValidatorRegistrationV1{{
+			Message: &ethpb.ValidatorRegistrationV1{
+				FeeRecipient: bytes.Repeat([]byte{1}, 20),
+				GasLimit:     30000000,
+				Timestamp:    1660000000,
+				Pubkey:       bytes.Repeat([]byte{2}, 48),
+			},
+			Signature: bytes.Repeat([]byte{3}, 96),
+		}},
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,99 @@
+package beacon_api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// beaconApiBeaconChainClient implements iface.BeaconChainClient on top of the standard Beacon API.
+type beaconApiBeaconChainClient struct {
+	jsonRestHandler jsonRestHandler
+}
+
+// NewBeaconApiBeaconChainClient returns a beacon chain client which talks to the Beacon API served at host.
+func NewBeaconApiBeaconChainClient(host string, timeout time.Duration) iface.BeaconChainClient {
+	return &beaconApiBeaconChainClient{
+		jsonRestHandler: jsonRestHandler{
+			httpClient: http.Client{Timeout: timeout},
+			host:       host,
+		},
+	}
+}
+
+// GetChainHead returns the head of the beacon node along with its finality checkpoints.
+func (c *beaconApiBeaconChainClient) GetChainHead(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.ChainHead, error) {
+	header := &ethpbv1.BlockHeaderResponse{}
+	if err := c.jsonRestHandler.get(ctx, "/eth/v1/beacon/headers/head", header); err != nil {
+		return nil, errors.Wrap(err, "could not get head block header")
+	}
+	if header.Data == nil || header.Data.Header == nil || header.Data.Header.Message == nil {
+		return nil, errors.New("beacon node returned empty head block header")
+	}
+	finality := &ethpbv1.StateFinalityCheckpointResponse{}
+	if err := c.jsonRestHandler.get(ctx, "/eth/v1/beacon/states/head/finality_checkpoints", finality); err != nil {
+		return nil, errors.Wrap(err, "could not get finality checkpoints")
+	}
+	checkpoints := finality.Data
+	if checkpoints == nil || checkpoints.Finalized == nil || checkpoints.CurrentJustified == nil || checkpoints.PreviousJustified == nil {
+		return nil, errors.New("beacon node returned empty finality checkpoints")
+	}
+	headSlot := header.Data.Header.Message.Slot
+	return &ethpb.ChainHead{
+		HeadSlot:                   headSlot,
+		HeadEpoch:                  slots.ToEpoch(headSlot),
+		HeadBlockRoot:              header.Data.Root,
+		FinalizedSlot:              params.BeaconConfig().SlotsPerEpoch.Mul(uint64(checkpoints.Finalized.Epoch)),
+		FinalizedEpoch:             checkpoints.Finalized.Epoch,
+		FinalizedBlockRoot:         checkpoints.Finalized.Root,
+		JustifiedSlot:              params.BeaconConfig().SlotsPerEpoch.Mul(uint64(checkpoints.CurrentJustified.Epoch)),
+		JustifiedEpoch:             checkpoints.CurrentJustified.Epoch,
+		JustifiedBlockRoot:         checkpoints.CurrentJustified.Root,
+		PreviousJustifiedSlot:      params.BeaconConfig().SlotsPerEpoch.Mul(uint64(checkpoints.PreviousJustified.Epoch)),
+		PreviousJustifiedEpoch:     checkpoints.PreviousJustified.Epoch,
+		PreviousJustifiedBlockRoot: checkpoints.PreviousJustified.Root,
+		OptimisticStatus:           header.ExecutionOptimistic,
+	}, nil
+}
+
+// GetValidatorPerformance returns the performance of validators from the Prysm performance endpoint, as the
+// standard Beacon API does not expose the participation of validators. Beacon nodes of other implementations
+// do not serve this endpoint, in which case an Unimplemented error is returned.
+func (c *beaconApiBeaconChainClient) GetValidatorPerformance(ctx context.Context, in *ethpb.ValidatorPerformanceRequest, _ ...grpc.CallOption) (*ethpb.ValidatorPerformanceResponse, error) {
+	query := url.Values{}
+	for _, pubKey := range in.PublicKeys {
+		query.Add("public_keys", base64.StdEncoding.EncodeToString(pubKey))
+	}
+	for _, index := range in.Indices {
+		query.Add("indices", strconv.FormatUint(uint64(index), 10))
+	}
+	// The Prysm API encodes responses with protojson, unlike the standard Beacon API.
+	var raw json.RawMessage
+	if err := c.jsonRestHandler.get(ctx, "/eth/v1alpha1/validators/performance?"+query.Encode(), &raw); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.Unimplemented, "Validator performance is only served by Prysm beacon nodes")
+		}
+		return nil, errors.Wrap(err, "could not get validator performance")
+	}
+	resp := &ethpb.ValidatorPerformanceResponse{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(raw, resp); err != nil {
+		return nil, errors.Wrap(err, "could not decode validator performance")
+	}
+	return resp, nil
+}
@@ -0,0 +1,47 @@
+package beacon_api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetValidatorPerformance(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/eth/v1alpha1/validators/performance", r.URL.Path)
+		assert.DeepEqual(t, []string{"AQI="}, r.URL.Query()["public_keys"])
+		assert.DeepEqual(t, []string{"3"}, r.URL.Query()["indices"])
+		_, err := w.Write([]byte(`{"currentEffectiveBalances": ["32000000000"], "correctlyVotedHead": [true], ` +
+			`"balancesAfterEpochTransition": ["32000001000"], "missingValidators": [], "unknownField": 1}`))
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	c := NewBeaconApiBeaconChainClient(srv.URL, time.Second)
+	resp, err := c.GetValidatorPerformance(context.Background(), &ethpb.ValidatorPerformanceRequest{
+		PublicKeys: [][]byte{{1, 2}},
+		Indices:    []types.ValidatorIndex{3},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{32000000000}, resp.CurrentEffectiveBalances)
+	assert.DeepEqual(t, []bool{true}, resp.CorrectlyVotedHead)
+	assert.DeepEqual(t, []uint64{32000001000}, resp.BalancesAfterEpochTransition)
+}
+
+func TestGetValidatorPerformance_NotPrysm(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	c := NewBeaconApiBeaconChainClient(srv.URL, time.Second)
+	_, err := c.GetValidatorPerformance(context.Background(), &ethpb.ValidatorPerformanceRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -0,0 +1,248 @@
+package beacon_api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// unknownCommitteeMember fills the positions of committee members that the Beacon API does not report.
+const unknownCommitteeMember = types.ValidatorIndex(^uint64(0))
+
+// committeeKey identifies a beacon committee.
+type committeeKey struct {
+	slot           types.Slot
+	committeeIndex types.CommitteeIndex
+}
+
+// epochDuties holds the duties of the requested validators for one epoch, keyed by validator index.
+type epochDuties struct {
+	attester      map[types.ValidatorIndex]*ethpbv1.AttesterDuty
+	proposerSlots map[types.ValidatorIndex][]types.Slot
+	syncCommittee map[types.ValidatorIndex]bool
+}
+
+// GetDuties returns the duties of the requested validators for the requested epoch and the next one.
+func (c *beaconApiValidatorClient) GetDuties(ctx context.Context, in *ethpb.DutiesRequest, _ ...grpc.CallOption) (*ethpb.DutiesResponse, error) {
+	ids := make([]string, len(in.PublicKeys))
+	for i, pubKey := range in.PublicKeys {
+		ids[i] = hexutil.Encode(pubKey)
+	}
+	vals, err := c.getStateValidators(ctx, ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get validators")
+	}
+	valsByPubKey := make(map[[fieldparams.BLSPubkeyLength]byte]*ethpbv1.ValidatorContainer, len(vals))
+	indices := make([]types.ValidatorIndex, 0, len(vals))
+	for _, val := range vals {
+		if val.Validator == nil {
+			continue
+		}
+		valsByPubKey[bytesutil.ToBytes48(val.Validator.Pubkey)] = val
+		indices = append(indices, val.Index)
+	}
+
+	current, err := c.epochDuties(ctx, in.Epoch, indices, true /* withProposer */)
+	if err != nil {
+		return nil, err
+	}
+	// The Beacon API can not tell the proposers of the next epoch, which is in line with the gRPC API.
+	next, err := c.epochDuties(ctx, in.Epoch+1, indices, false /* withProposer */)
+	if err != nil {
+		return nil, err
+	}
+	c.cacheAttesterDuties(in.Epoch, current, next)
+
+	currentDuties := make([]*ethpb.DutiesResponse_Duty, len(in.PublicKeys))
+	nextDuties := make([]*ethpb.DutiesResponse_Duty, len(in.PublicKeys))
+	for i, pubKey := range in.PublicKeys {
+		val, ok := valsByPubKey[bytesutil.ToBytes48(pubKey)]
+		if !ok {
+			currentDuties[i] = &ethpb.DutiesResponse_Duty{PublicKey: pubKey, Status: ethpb.ValidatorStatus_UNKNOWN_STATUS}
+			nextDuties[i] = &ethpb.DutiesResponse_Duty{PublicKey: pubKey, Status: ethpb.ValidatorStatus_UNKNOWN_STATUS}
+			continue
+		}
+		currentDuties[i] = current.duty(pubKey, val)
+		nextDuties[i] = next.duty(pubKey, val)
+	}
+	return &ethpb.DutiesResponse{
+		Duties:             currentDuties,
+		CurrentEpochDuties: currentDuties,
+		NextEpochDuties:    nextDuties,
+	}, nil
+}
+
+func (c *beaconApiValidatorClient) epochDuties(ctx context.Context, epoch types.Epoch, indices []types.ValidatorIndex, withProposer bool) (*epochDuties, error) {
+	duties := &epochDuties{
+		attester:      make(map[types.ValidatorIndex]*ethpbv1.AttesterDuty),
+		proposerSlots: make(map[types.ValidatorIndex][]types.Slot),
+		syncCommittee: make(map[types.ValidatorIndex]bool),
+	}
+	if len(indices) == 0 {
+		return duties, nil
+	}
+	body, err := indicesJSON(indices)
+	if err != nil {
+		return nil, err
+	}
+
+	attesterResp := &ethpbv1.AttesterDutiesResponse{}
+	if err := c.jsonRestHandler.post(ctx, fmt.Sprintf("/eth/v1/validator/duties/attester/%d", epoch), body, attesterResp); err != nil {
+		return nil, errors.Wrapf(err, "could not get attester duties for epoch %d", epoch)
+	}
+	for _, duty := range attesterResp.Data {
+		duties.attester[duty.ValidatorIndex] = duty
+	}
+
+	if withProposer {
+		proposerResp := &ethpbv1.ProposerDutiesResponse{}
+		if err := c.jsonRestHandler.get(ctx, fmt.Sprintf("/eth/v1/validator/duties/proposer/%d", epoch), proposerResp); err != nil {
+			return nil, errors.Wrapf(err, "could not get proposer duties for epoch %d", epoch)
+		}
+		// Proposer duties are reported for the whole validator set, only keep the requested validators.
+		requested := make(map[types.ValidatorIndex]bool, len(indices))
+		for _, index := range indices {
+			requested[index] = true
+		}
+		for _, duty := range proposerResp.Data {
+			if requested[duty.ValidatorIndex] {
+				duties.proposerSlots[duty.ValidatorIndex] = append(duties.proposerSlots[duty.ValidatorIndex], duty.Slot)
+			}
+		}
+	}
+
+	if epoch < params.BeaconConfig().AltairForkEpoch {
+		return duties, nil
+	}
+	syncResp := &ethpbv2.SyncCommitteeDutiesResponse{}
+	if err := c.jsonRestHandler.post(ctx, fmt.Sprintf("/eth/v1/validator/duties/sync/%d", epoch), body, syncResp); err != nil {
+		return nil, errors.Wrapf(err, "could not get sync committee duties for epoch %d", epoch)
+	}
+	subscriptions := make([]proto.Message, 0, len(syncResp.Data))
+	untilEpoch := (slots.SyncCommitteePeriod(epoch) + 1) * uint64(params.BeaconConfig().EpochsPerSyncCommitteePeriod)
+	for _, duty := range syncResp.Data {
+		duties.syncCommittee[duty.ValidatorIndex] = true
+		subscriptions = append(subscriptions, &ethpbv2.SyncCommitteeSubscription{
+			ValidatorIndex:       duty.ValidatorIndex,
+			SyncCommitteeIndices: duty.ValidatorSyncCommitteeIndices,
+			UntilEpoch:           types.Epoch(untilEpoch),
+		})
+	}
+	// The gRPC API subscribes sync committee members to their subnets when serving duties, do the same here.
+	if len(subscriptions) > 0 {
+		subscriptionsBody, err := marshalApiJSONArray(subscriptions)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.jsonRestHandler.post(ctx, "/eth/v1/validator/sync_committee_subscriptions", subscriptionsBody, nil); err != nil {
+			return nil, errors.Wrap(err, "could not subscribe to sync committee subnets")
+		}
+	}
+	return duties, nil
+}
+
+// duty builds the validator client duty of a validator. The Beacon API only reports the length of the committee
+// and the position of the validator in it, which is all the validator client needs: the committee is rebuilt
+// with the requested validators at their position and unknown members everywhere else.
+func (d *epochDuties) duty(pubKey []byte, val *ethpbv1.ValidatorContainer) *ethpb.DutiesResponse_Duty {
+	duty := &ethpb.DutiesResponse_Duty{
+		PublicKey:       pubKey,
+		ValidatorIndex:  val.Index,
+		Status:          validatorStatusFromV1(val.Status),
+		ProposerSlots:   d.proposerSlots[val.Index],
+		IsSyncCommittee: d.syncCommittee[val.Index],
+	}
+	attesterDuty, ok := d.attester[val.Index]
+	if !ok {
+		return duty
+	}
+	committee := make([]types.ValidatorIndex, attesterDuty.CommitteeLength)
+	for i := range committee {
+		committee[i] = unknownCommitteeMember
+	}
+	for _, other := range d.attester {
+		if other.Slot == attesterDuty.Slot && other.CommitteeIndex == attesterDuty.CommitteeIndex &&
+			uint64(other.ValidatorCommitteeIndex) < attesterDuty.CommitteeLength {
+			committee[other.ValidatorCommitteeIndex] = other.ValidatorIndex
+		}
+	}
+	duty.Committee = committee
+	duty.CommitteeIndex = attesterDuty.CommitteeIndex
+	duty.AttesterSlot = attesterDuty.Slot
+	return duty
+}
+
+// cacheAttesterDuties remembers the attester duties of the given epochs, as the beacon committee subscriptions
+// of the Beacon API need details that the validator client does not send along.
+func (c *beaconApiValidatorClient) cacheAttesterDuties(epoch types.Epoch, duties ...*epochDuties) {
+	c.attesterDutyLock.Lock()
+	defer c.attesterDutyLock.Unlock()
+	for key := range c.attesterDutyCache {
+		if slots.ToEpoch(key.slot) < epoch {
+			delete(c.attesterDutyCache, key)
+		}
+	}
+	for _, d := range duties {
+		for _, duty := range d.attester {
+			c.attesterDutyCache[committeeKey{slot: duty.Slot, committeeIndex: duty.CommitteeIndex}] = duty
+		}
+	}
+}
+
+// SubscribeCommitteeSubnets asks the beacon node to subscribe to the subnets of the requested committees.
+func (c *beaconApiValidatorClient) SubscribeCommitteeSubnets(ctx context.Context, in *ethpb.CommitteeSubnetsSubscribeRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	if len(in.Slots) != len(in.CommitteeIds) || len(in.Slots) != len(in.IsAggregator) {
+		return nil, status.Error(codes.InvalidArgument, "Slots, committee IDs and aggregator flags must have the same length")
+	}
+	subscriptions := make([]proto.Message, 0, len(in.Slots))
+	c.attesterDutyLock.RLock()
+	for i, slot := range in.Slots {
+		duty, ok := c.attesterDutyCache[committeeKey{slot: slot, committeeIndex: in.CommitteeIds[i]}]
+		if !ok {
+			c.attesterDutyLock.RUnlock()
+			return nil, status.Errorf(codes.FailedPrecondition, "No attester duty known for committee %d at slot %d", in.CommitteeIds[i], slot)
+		}
+		subscriptions = append(subscriptions, &ethpbv1.BeaconCommitteeSubscribe{
+			ValidatorIndex:   duty.ValidatorIndex,
+			CommitteeIndex:   duty.CommitteeIndex,
+			CommitteesAtSlot: duty.CommitteesAtSlot,
+			Slot:             slot,
+			IsAggregator:     in.IsAggregator[i],
+		})
+	}
+	c.attesterDutyLock.RUnlock()
+	body, err := marshalApiJSONArray(subscriptions)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.jsonRestHandler.post(ctx, "/eth/v1/validator/beacon_committee_subscriptions", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not subscribe to beacon committee subnets")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// indicesJSON encodes validator indices as the JSON array of strings expected by the Beacon API.
+func indicesJSON(indices []types.ValidatorIndex) ([]byte, error) {
+	strIndices := make([]string, len(indices))
+	for i, index := range indices {
+		strIndices[i] = strconv.FormatUint(uint64(index), 10)
+	}
+	return json.Marshal(strIndices)
+}
@@ -0,0 +1,98 @@
+package beacon_api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestGetDuties(t *testing.T) {
+	pubKeys := [][]byte{bytesutil.PadTo([]byte{1}, 48), bytesutil.PadTo([]byte{2}, 48)}
+	unknown := bytesutil.PadTo([]byte{3}, 48)
+	var subscriptions []map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.Handle("/eth/v1/beacon/states/head/validators", validatorsHandler(t, pubKeys))
+	mux.HandleFunc("/eth/v1/validator/duties/attester/", func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `["0","1"]`, string(body))
+		var epoch uint64
+		_, err = fmt.Sscanf(r.URL.Path, "/eth/v1/validator/duties/attester/%d", &epoch)
+		require.NoError(t, err)
+		slot := epoch*32 + 3
+		// Both validators share a committee of 4 in the current epoch, validator 1 is alone in the next one.
+		if epoch == 0 {
+			_, err = fmt.Fprintf(w, `{"data": [
+				{"pubkey": "%#x", "validator_index": "0", "committee_index": "2", "committee_length": "4", "committees_at_slot": "8", "validator_committee_index": "3", "slot": "%d"},
+				{"pubkey": "%#x", "validator_index": "1", "committee_index": "2", "committee_length": "4", "committees_at_slot": "8", "validator_committee_index": "1", "slot": "%d"}
+			]}`, pubKeys[0], slot, pubKeys[1], slot)
+		} else {
+			_, err = fmt.Fprintf(w, `{"data": [
+				{"pubkey": "%#x", "validator_index": "1", "committee_index": "0", "committee_length": "2", "committees_at_slot": "8", "validator_committee_index": "0", "slot": "%d"}
+			]}`, pubKeys[1], slot)
+		}
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/eth/v1/validator/duties/proposer/0", func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"data": [
+			{"pubkey": "0x00", "validator_index": "1", "slot": "4"},
+			{"pubkey": "0x00", "validator_index": "7", "slot": "5"}
+		]}`))
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/eth/v1/validator/beacon_committee_subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&subscriptions))
+	})
+	c := newTestClient(t, mux)
+
+	resp, err := c.GetDuties(context.Background(), &ethpb.DutiesRequest{
+		Epoch:      0,
+		PublicKeys: [][]byte{pubKeys[0], pubKeys[1], unknown},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(resp.CurrentEpochDuties))
+	require.Equal(t, 3, len(resp.NextEpochDuties))
+
+	first := resp.CurrentEpochDuties[0]
+	assert.Equal(t, ethpb.ValidatorStatus_ACTIVE, first.Status)
+	assert.Equal(t, types.Slot(3), first.AttesterSlot)
+	assert.Equal(t, types.CommitteeIndex(2), first.CommitteeIndex)
+	assert.DeepEqual(t, []types.ValidatorIndex{unknownCommitteeMember, 1, unknownCommitteeMember, 0}, first.Committee)
+	assert.Equal(t, 0, len(first.ProposerSlots))
+	assert.DeepEqual(t, []types.Slot{4}, resp.CurrentEpochDuties[1].ProposerSlots)
+	assert.Equal(t, ethpb.ValidatorStatus_UNKNOWN_STATUS, resp.CurrentEpochDuties[2].Status)
+
+	assert.Equal(t, 0, len(resp.NextEpochDuties[0].Committee))
+	next := resp.NextEpochDuties[1]
+	assert.Equal(t, types.Slot(35), next.AttesterSlot)
+	assert.DeepEqual(t, []types.ValidatorIndex{1, unknownCommitteeMember}, next.Committee)
+
+	_, err = c.SubscribeCommitteeSubnets(context.Background(), &ethpb.CommitteeSubnetsSubscribeRequest{
+		Slots:        []types.Slot{3, 35},
+		CommitteeIds: []types.CommitteeIndex{2, 0},
+		IsAggregator: []bool{false, true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(subscriptions))
+	assert.Equal(t, "8", subscriptions[0]["committees_at_slot"])
+	assert.Equal(t, "35", subscriptions[1]["slot"])
+	assert.Equal(t, "1", subscriptions[1]["validator_index"])
+	assert.Equal(t, true, subscriptions[1]["is_aggregator"])
+
+	_, err = c.SubscribeCommitteeSubnets(context.Background(), &ethpb.CommitteeSubnetsSubscribeRequest{
+		Slots:        []types.Slot{100},
+		CommitteeIds: []types.CommitteeIndex{0},
+		IsAggregator: []bool{false},
+	})
+	assert.ErrorContains(t, "No attester duty known", err)
+}
@@ -0,0 +1,72 @@
+package beacon_api
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const genesisPollInterval = 5 * time.Second
+
+// getGenesis returns the genesis of the chain, which is only requested from the beacon node once it is known.
+func (c *beaconApiValidatorClient) getGenesis(ctx context.Context) (*ethpbv1.GenesisResponse_Genesis, error) {
+	c.genesisLock.Lock()
+	defer c.genesisLock.Unlock()
+	if c.genesis != nil {
+		return c.genesis, nil
+	}
+	genesis, err := requestGenesis(ctx, &c.jsonRestHandler)
+	if err != nil {
+		return nil, err
+	}
+	c.genesis = genesis
+	return genesis, nil
+}
+
+func requestGenesis(ctx context.Context, handler *jsonRestHandler) (*ethpbv1.GenesisResponse_Genesis, error) {
+	resp := &ethpbv1.GenesisResponse{}
+	if err := handler.get(ctx, "/eth/v1/beacon/genesis", resp); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil || resp.Data.GenesisTime == nil {
+		return nil, errors.New("beacon node returned empty genesis")
+	}
+	return resp.Data, nil
+}
+
+// WaitForChainStart returns a stream which waits for the beacon node to know about the genesis of the chain.
+func (c *beaconApiValidatorClient) WaitForChainStart(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForChainStartClient, error) {
+	return &waitForChainStartStream{pollingStream: pollingStream{ctx: ctx}, client: c}, nil
+}
+
+type waitForChainStartStream struct {
+	pollingStream
+	client *beaconApiValidatorClient
+}
+
+// Recv blocks until the genesis of the chain is known. The Beacon API responds with 404 until then.
+func (s *waitForChainStartStream) Recv() (*ethpb.ChainStartResponse, error) {
+	for {
+		genesis, err := s.client.getGenesis(s.ctx)
+		if err == nil {
+			return &ethpb.ChainStartResponse{
+				Started:               true,
+				GenesisTime:           uint64(genesis.GenesisTime.Seconds),
+				GenesisValidatorsRoot: genesis.GenesisValidatorsRoot,
+			}, nil
+		}
+		if status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+		if err := s.wait(genesisPollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
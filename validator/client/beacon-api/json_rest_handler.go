@@ -0,0 +1,110 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// apiError is the error body returned by the Beacon API.
+type apiError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// jsonRestHandler performs JSON requests against a Beacon API host.
+type jsonRestHandler struct {
+	httpClient http.Client
+	host       string
+}
+
+// get sends a GET request to the given endpoint and decodes the response into resp.
+func (c *jsonRestHandler) get(ctx context.Context, endpoint string, resp interface{}) error {
+	return c.do(ctx, http.MethodGet, endpoint, nil, resp)
+}
+
+// post sends a POST request with the given JSON body to the given endpoint and decodes the response into resp,
+// which may be nil when the endpoint does not return any data.
+func (c *jsonRestHandler) post(ctx context.Context, endpoint string, body []byte, resp interface{}) error {
+	return c.do(ctx, http.MethodPost, endpoint, body, resp)
+}
+
+func (c *jsonRestHandler) do(ctx context.Context, method, endpoint string, body []byte, resp interface{}) error {
+	url := c.host + endpoint
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		return errors.Wrapf(err, "could not create request for %s", url)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	httpResp, err := c.httpClient.Do(req)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "Could not query %s: %v", url, err)
+	}
+	defer func() {
+		if err := httpResp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "could not read response body from %s", url)
+	}
+	// Some endpoints acknowledge a request with 202 Accepted, which is a success as far as the caller is concerned.
+	if httpResp.StatusCode < http.StatusOK || httpResp.StatusCode >= http.StatusMultipleChoices {
+		return responseError(url, httpResp.StatusCode, respBody)
+	}
+	if resp == nil {
+		return nil
+	}
+	if m, ok := resp.(proto.Message); ok {
+		err = unmarshalApiJSON(respBody, m)
+	} else {
+		err = json.Unmarshal(respBody, resp)
+	}
+	return errors.Wrapf(err, "could not decode response from %s", url)
+}
+
+// responseError converts a non-200 response into a gRPC status error, so that callers can treat both transports
+// the same way.
+func responseError(url string, statusCode int, body []byte) error {
+	msg := fmt.Sprintf("%s returned status code %d", url, statusCode)
+	e := &apiError{}
+	if err := json.Unmarshal(body, e); err == nil && e.Message != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Message)
+	}
+	return status.Error(httpStatusToCode(statusCode), msg)
+}
+
+func httpStatusToCode(statusCode int) codes.Code {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
@@ -0,0 +1,89 @@
+package beacon_api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newTestClient returns a validator client talking to a test server backed by the given handler.
+func newTestClient(t *testing.T, handler http.Handler) *beaconApiValidatorClient {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return NewBeaconApiValidatorClient(srv.URL, time.Second).(*beaconApiValidatorClient)
+}
+
+func TestJsonRestHandler_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		code       codes.Code
+		msg        string
+	}{
+		{
+			name:       "bad request",
+			statusCode: http.StatusBadRequest,
+			body:       `{"code": 400, "message": "Invalid epoch"}`,
+			code:       codes.InvalidArgument,
+			msg:        "Invalid epoch",
+		},
+		{
+			name:       "not found",
+			statusCode: http.StatusNotFound,
+			body:       `{"code": 404, "message": "Chain genesis info is not yet known"}`,
+			code:       codes.NotFound,
+			msg:        "Chain genesis info is not yet known",
+		},
+		{
+			name:       "syncing",
+			statusCode: http.StatusServiceUnavailable,
+			body:       `not json`,
+			code:       codes.Unavailable,
+			msg:        "returned status code 503",
+		},
+		{
+			name:       "internal",
+			statusCode: http.StatusInternalServerError,
+			code:       codes.Internal,
+			msg:        "returned status code 500",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.statusCode)
+				_, err := w.Write([]byte(tt.body))
+				require.NoError(t, err)
+			}))
+			err := c.jsonRestHandler.get(context.Background(), "/eth/v1/beacon/genesis", &apiError{})
+			require.NotNil(t, err)
+			assert.Equal(t, tt.code, status.Code(err))
+			assert.ErrorContains(t, tt.msg, err)
+		})
+	}
+}
+
+func TestJsonRestHandler_Accepted(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	require.NoError(t, c.jsonRestHandler.post(context.Background(), "/eth/v1/beacon/blocks", []byte(`{}`), nil))
+}
+
+func TestJsonRestHandler_Unreachable(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+	c := NewBeaconApiValidatorClient(srv.URL, time.Second).(*beaconApiValidatorClient)
+	err := c.jsonRestHandler.get(context.Background(), "/eth/v1/beacon/genesis", &apiError{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
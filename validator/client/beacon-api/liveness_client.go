@@ -0,0 +1,41 @@
+package beacon_api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	"google.golang.org/grpc"
+)
+
+// beaconApiLivenessClient implements iface.LivenessClient on top of the standard Beacon API.
+type beaconApiLivenessClient struct {
+	jsonRestHandler jsonRestHandler
+}
+
+// NewBeaconApiLivenessClient returns a liveness client which talks to the Beacon API served at host.
+func NewBeaconApiLivenessClient(host string, timeout time.Duration) iface.LivenessClient {
+	return &beaconApiLivenessClient{
+		jsonRestHandler: jsonRestHandler{
+			httpClient: http.Client{Timeout: timeout},
+			host:       host,
+		},
+	}
+}
+
+// GetLiveness returns whether the requested validators were seen live by the beacon node in the requested epoch.
+func (c *beaconApiLivenessClient) GetLiveness(ctx context.Context, in *ethpbv2.GetLivenessRequest, _ ...grpc.CallOption) (*ethpbv2.GetLivenessResponse, error) {
+	body, err := indicesJSON(in.Index)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ethpbv2.GetLivenessResponse{}
+	if err := c.jsonRestHandler.post(ctx, fmt.Sprintf("/eth/v1/validator/liveness/%d", in.Epoch), body, resp); err != nil {
+		return nil, errors.Wrapf(err, "could not get liveness for epoch %d", in.Epoch)
+	}
+	return resp, nil
+}
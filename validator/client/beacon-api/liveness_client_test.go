@@ -0,0 +1,35 @@
+package beacon_api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestGetLiveness(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/eth/v1/validator/liveness/7", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `["1","4"]`, string(body))
+		_, err = w.Write([]byte(`{"data": [{"index": "1", "is_live": false}, {"index": "4", "is_live": true}]}`))
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	c := NewBeaconApiLivenessClient(srv.URL, time.Second)
+	resp, err := c.GetLiveness(context.Background(), &ethpbv2.GetLivenessRequest{Epoch: 7, Index: []types.ValidatorIndex{1, 4}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Data))
+	assert.Equal(t, false, resp.Data[0].IsLive)
+	assert.Equal(t, types.ValidatorIndex(4), resp.Data[1].Index)
+	assert.Equal(t, true, resp.Data[1].IsLive)
+}
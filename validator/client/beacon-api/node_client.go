@@ -0,0 +1,62 @@
+package beacon_api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// beaconApiNodeClient implements iface.NodeClient on top of the standard Beacon API.
+type beaconApiNodeClient struct {
+	jsonRestHandler jsonRestHandler
+}
+
+// NewBeaconApiNodeClient returns a node client which talks to the Beacon API served at host.
+func NewBeaconApiNodeClient(host string, timeout time.Duration) iface.NodeClient {
+	return &beaconApiNodeClient{
+		jsonRestHandler: jsonRestHandler{
+			httpClient: http.Client{Timeout: timeout},
+			host:       host,
+		},
+	}
+}
+
+// GetSyncStatus returns whether the beacon node is syncing.
+func (c *beaconApiNodeClient) GetSyncStatus(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.SyncStatus, error) {
+	resp := &ethpbv1.SyncingResponse{}
+	if err := c.jsonRestHandler.get(ctx, "/eth/v1/node/syncing", resp); err != nil {
+		return nil, errors.Wrap(err, "could not get sync status")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("beacon node returned empty sync status")
+	}
+	return &ethpb.SyncStatus{Syncing: resp.Data.IsSyncing}, nil
+}
+
+// GetGenesis returns the genesis of the chain along with the deposit contract address.
+func (c *beaconApiNodeClient) GetGenesis(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.Genesis, error) {
+	genesis, err := requestGenesis(ctx, &c.jsonRestHandler)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get genesis")
+	}
+	depositContract := &ethpbv1.DepositContractResponse{}
+	if err := c.jsonRestHandler.get(ctx, "/eth/v1/config/deposit_contract", depositContract); err != nil {
+		return nil, errors.Wrap(err, "could not get deposit contract")
+	}
+	if depositContract.Data == nil {
+		return nil, errors.New("beacon node returned empty deposit contract")
+	}
+	return &ethpb.Genesis{
+		GenesisTime:            genesis.GenesisTime,
+		DepositContractAddress: common.HexToAddress(depositContract.Data.Address).Bytes(),
+		GenesisValidatorsRoot:  genesis.GenesisValidatorsRoot,
+	}, nil
+}
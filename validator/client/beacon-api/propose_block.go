@@ -0,0 +1,199 @@
+package beacon_api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// BlindedBlockMetadataKey is the key of the outgoing gRPC metadata with which the validator client asks for a blinded
+// block, for proposers which registered with a builder. The Prysm gRPC API picks the block type on its own, while the
+// Beacon API serves blinded blocks from a separate endpoint.
+const BlindedBlockMetadataKey = "prysm-blinded-block"
+
+type sszMarshaler interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+type sszUnmarshaler interface {
+	UnmarshalSSZ([]byte) error
+}
+
+// versionedJson is the envelope of Beacon API responses whose data depends on the fork.
+type versionedJson struct {
+	Version string          `json:"version"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// signedJson is the Beacon API encoding of a signed consensus object.
+type signedJson struct {
+	Message   json.RawMessage `json:"message"`
+	Signature string          `json:"signature"`
+}
+
+// convertSSZ copies a consensus object between the v1alpha1 protos used by the validator client and the eth/v1
+// and eth/v2 protos matching the Beacon API, which share the same SSZ encoding.
+func convertSSZ(from sszMarshaler, to sszUnmarshaler) error {
+	enc, err := from.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal object")
+	}
+	return errors.Wrap(to.UnmarshalSSZ(enc), "could not unmarshal object")
+}
+
+// GetBeaconBlock asks the beacon node to produce a block for the requested slot. A blinded block is requested when
+// the context carries the BlindedBlockMetadataKey metadata.
+func (c *beaconApiValidatorClient) GetBeaconBlock(ctx context.Context, in *ethpb.BlockRequest, _ ...grpc.CallOption) (*ethpb.GenericBeaconBlock, error) {
+	query := url.Values{}
+	query.Set("randao_reveal", hexutil.Encode(in.RandaoReveal))
+	if len(in.Graffiti) > 0 {
+		query.Set("graffiti", hexutil.Encode(in.Graffiti))
+	}
+	blinded := blindedBlockRequested(ctx)
+	resp := &versionedJson{}
+	endpoint := fmt.Sprintf("/eth/v2/validator/blocks/%d?%s", in.Slot, query.Encode())
+	if blinded {
+		endpoint = fmt.Sprintf("/eth/v1/validator/blinded_blocks/%d?%s", in.Slot, query.Encode())
+	}
+	if err := c.jsonRestHandler.get(ctx, endpoint, resp); err != nil {
+		return nil, errors.Wrap(err, "could not produce block")
+	}
+
+	switch resp.Version {
+	case version.String(version.Phase0):
+		apiBlock, block := &ethpbv1.BeaconBlock{}, &ethpb.BeaconBlock{}
+		if err := decodeBlock(resp.Data, apiBlock, block); err != nil {
+			return nil, err
+		}
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Phase0{Phase0: block}}, nil
+	case version.String(version.Altair):
+		apiBlock, block := &ethpbv2.BeaconBlockAltair{}, &ethpb.BeaconBlockAltair{}
+		if err := decodeBlock(resp.Data, apiBlock, block); err != nil {
+			return nil, err
+		}
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Altair{Altair: block}}, nil
+	case version.String(version.Bellatrix):
+		if blinded {
+			apiBlock, block := &ethpbv2.BlindedBeaconBlockBellatrix{}, &ethpb.BlindedBeaconBlockBellatrix{}
+			if err := decodeBlock(resp.Data, apiBlock, block); err != nil {
+				return nil, err
+			}
+			return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_BlindedBellatrix{BlindedBellatrix: block}}, nil
+		}
+		apiBlock, block := &ethpbv2.BeaconBlockBellatrix{}, &ethpb.BeaconBlockBellatrix{}
+		if err := decodeBlock(resp.Data, apiBlock, block); err != nil {
+			return nil, err
+		}
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Bellatrix{Bellatrix: block}}, nil
+	default:
+		return nil, errors.Errorf("unsupported block version %s", resp.Version)
+	}
+}
+
+// blindedBlockRequested returns whether the outgoing metadata of the context asks for a blinded block.
+func blindedBlockRequested(ctx context.Context) bool {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		return false
+	}
+	values := md.Get(BlindedBlockMetadataKey)
+	return len(values) > 0 && values[0] == "true"
+}
+
+// decodeBlock decodes a block in the Beacon API format into its v1alpha1 counterpart.
+func decodeBlock(data []byte, apiBlock interface {
+	proto.Message
+	sszMarshaler
+}, block sszUnmarshaler) error {
+	if err := unmarshalApiJSON(data, apiBlock); err != nil {
+		return errors.Wrap(err, "could not decode block")
+	}
+	return convertSSZ(apiBlock, block)
+}
+
+// ProposeBeaconBlock publishes a signed block through the beacon node.
+func (c *beaconApiValidatorClient) ProposeBeaconBlock(ctx context.Context, in *ethpb.GenericSignedBeaconBlock, _ ...grpc.CallOption) (*ethpb.ProposeResponse, error) {
+	var (
+		block     interface{ HashTreeRoot() ([32]byte, error) }
+		apiBlock  proto.Message
+		signature []byte
+		endpoint  = "/eth/v1/beacon/blocks"
+	)
+	switch b := in.Block.(type) {
+	case *ethpb.GenericSignedBeaconBlock_Phase0:
+		signed := &ethpbv1.SignedBeaconBlock{}
+		if err := convertSSZ(b.Phase0, signed); err != nil {
+			return nil, err
+		}
+		block, apiBlock, signature = b.Phase0.Block, signed.Block, signed.Signature
+	case *ethpb.GenericSignedBeaconBlock_Altair:
+		signed := &ethpbv2.SignedBeaconBlockAltair{}
+		if err := convertSSZ(b.Altair, signed); err != nil {
+			return nil, err
+		}
+		block, apiBlock, signature = b.Altair.Block, signed.Message, signed.Signature
+	case *ethpb.GenericSignedBeaconBlock_Bellatrix:
+		signed := &ethpbv2.SignedBeaconBlockBellatrix{}
+		if err := convertSSZ(b.Bellatrix, signed); err != nil {
+			return nil, err
+		}
+		block, apiBlock, signature = b.Bellatrix.Block, signed.Message, signed.Signature
+	case *ethpb.GenericSignedBeaconBlock_BlindedBellatrix:
+		signed := &ethpbv2.SignedBlindedBeaconBlockBellatrix{}
+		if err := convertSSZ(b.BlindedBellatrix, signed); err != nil {
+			return nil, err
+		}
+		block, apiBlock, signature = b.BlindedBellatrix.Block, signed.Message, signed.Signature
+		endpoint = "/eth/v1/beacon/blinded_blocks"
+	default:
+		return nil, errors.Errorf("unsupported block type %T", in.Block)
+	}
+
+	message, err := marshalApiJSON(apiBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode block")
+	}
+	body, err := json.Marshal(&signedJson{Message: message, Signature: hexutil.Encode(signature)})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.jsonRestHandler.post(ctx, endpoint, body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not publish block")
+	}
+	root, err := block.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute block root")
+	}
+	return &ethpb.ProposeResponse{BlockRoot: root[:]}, nil
+}
+
+// PrepareBeaconProposer sends the fee recipients of the validators to the beacon node.
+func (c *beaconApiValidatorClient) PrepareBeaconProposer(ctx context.Context, in *ethpb.PrepareBeaconProposerRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	recipients := make([]proto.Message, len(in.Recipients))
+	for i, r := range in.Recipients {
+		recipients[i] = &ethpbv1.PrepareBeaconProposerRequest_FeeRecipientContainer{
+			FeeRecipient:   r.FeeRecipient,
+			ValidatorIndex: r.ValidatorIndex,
+		}
+	}
+	body, err := marshalApiJSONArray(recipients)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.jsonRestHandler.post(ctx, "/eth/v1/validator/prepare_beacon_proposer", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not prepare beacon proposer")
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,98 @@
+package beacon_api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestGetBeaconBlock_Phase0(t *testing.T) {
+	block := util.NewBeaconBlock().Block
+	block.Slot = 10
+	block.ProposerIndex = 3
+	apiBlock := &ethpbv1.BeaconBlock{}
+	require.NoError(t, convertSSZ(block, apiBlock))
+	data, err := marshalApiJSON(apiBlock)
+	require.NoError(t, err)
+	randao := make([]byte, 96)
+	randao[0] = 0xaa
+
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/eth/v2/validator/blocks/10", r.URL.Path)
+		assert.Equal(t, hexutil.Encode(randao), r.URL.Query().Get("randao_reveal"))
+		require.NoError(t, json.NewEncoder(w).Encode(&versionedJson{Version: "phase0", Data: data}))
+	}))
+
+	resp, err := c.GetBeaconBlock(context.Background(), &ethpb.BlockRequest{Slot: 10, RandaoReveal: randao})
+	require.NoError(t, err)
+	assert.DeepEqual(t, block, resp.GetPhase0())
+}
+
+func TestGetBeaconBlock_Blinded(t *testing.T) {
+	block := util.NewBlindedBeaconBlockBellatrix().Block
+	block.Slot = 10
+	apiBlock := &ethpbv2.BlindedBeaconBlockBellatrix{}
+	require.NoError(t, convertSSZ(block, apiBlock))
+	data, err := marshalApiJSON(apiBlock)
+	require.NoError(t, err)
+
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/eth/v1/validator/blinded_blocks/10", r.URL.Path)
+		require.NoError(t, json.NewEncoder(w).Encode(&versionedJson{Version: "bellatrix", Data: data}))
+	}))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), BlindedBlockMetadataKey, "true")
+	resp, err := c.GetBeaconBlock(ctx, &ethpb.BlockRequest{Slot: 10, RandaoReveal: make([]byte, 96)})
+	require.NoError(t, err)
+	assert.DeepEqual(t, block, resp.GetBlindedBellatrix())
+}
+
+func TestProposeBeaconBlock_Phase0(t *testing.T) {
+	signed := util.NewBeaconBlock()
+	signed.Block.Slot = 10
+	signed.Signature[0] = 0xbb
+	var published *ethpbv1.BeaconBlock
+	var signature string
+
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/eth/v1/beacon/blocks", r.URL.Path)
+		body := &signedJson{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(body))
+		published = &ethpbv1.BeaconBlock{}
+		require.NoError(t, unmarshalApiJSON(body.Message, published))
+		signature = body.Signature
+	}))
+
+	resp, err := c.ProposeBeaconBlock(context.Background(), &ethpb.GenericSignedBeaconBlock{
+		Block: &ethpb.GenericSignedBeaconBlock_Phase0{Phase0: signed},
+	})
+	require.NoError(t, err)
+	root, err := signed.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], resp.BlockRoot)
+	assert.Equal(t, hexutil.Encode(signed.Signature), signature)
+	publishedRoot, err := published.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, publishedRoot)
+}
+
+func TestProposeBeaconBlock_Blinded(t *testing.T) {
+	signed := util.NewBlindedBeaconBlockBellatrix()
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/eth/v1/beacon/blinded_blocks", r.URL.Path)
+	}))
+	_, err := c.ProposeBeaconBlock(context.Background(), &ethpb.GenericSignedBeaconBlock{
+		Block: &ethpb.GenericSignedBeaconBlock_BlindedBellatrix{BlindedBellatrix: signed},
+	})
+	require.NoError(t, err)
+}
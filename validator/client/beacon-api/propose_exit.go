@@ -0,0 +1,33 @@
+package beacon_api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+)
+
+// ProposeExit publishes a signed voluntary exit through the beacon node.
+func (c *beaconApiValidatorClient) ProposeExit(ctx context.Context, in *ethpb.SignedVoluntaryExit, _ ...grpc.CallOption) (*ethpb.ProposeExitResponse, error) {
+	if in.Exit == nil {
+		return nil, errors.New("voluntary exit can not be empty")
+	}
+	exit := &ethpbv1.SignedVoluntaryExit{}
+	if err := convertSSZ(in, exit); err != nil {
+		return nil, err
+	}
+	body, err := marshalApiJSON(exit)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.jsonRestHandler.post(ctx, "/eth/v1/beacon/pool/voluntary_exits", body, nil); err != nil {
+		return nil, errors.Wrapf(err, "could not publish voluntary exit of validator %d", in.Exit.ValidatorIndex)
+	}
+	root, err := in.Exit.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute exit root")
+	}
+	return &ethpb.ProposeExitResponse{ExitRoot: root[:]}, nil
+}
@@ -0,0 +1,162 @@
+package beacon_api
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getStateValidators returns the head state validators matching the given IDs, which are either
+// 0x-prefixed public keys or decimal indices. Validators unknown to the beacon node are omitted.
+func (c *beaconApiValidatorClient) getStateValidators(ctx context.Context, ids []string) ([]*ethpbv1.ValidatorContainer, error) {
+	// Without any ID, the Beacon API would return the whole validator registry.
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := url.Values{}
+	for _, id := range ids {
+		query.Add("id", id)
+	}
+	resp := &ethpbv1.StateValidatorsResponse{}
+	if err := c.jsonRestHandler.get(ctx, "/eth/v1/beacon/states/head/validators?"+query.Encode(), resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// ValidatorIndex returns the index of the validator with the requested public key.
+func (c *beaconApiValidatorClient) ValidatorIndex(ctx context.Context, in *ethpb.ValidatorIndexRequest, _ ...grpc.CallOption) (*ethpb.ValidatorIndexResponse, error) {
+	vals, err := c.getStateValidators(ctx, []string{hexutil.Encode(in.PublicKey)})
+	if err != nil {
+		return nil, err
+	}
+	if len(vals) == 0 {
+		return nil, status.Errorf(codes.NotFound, "Could not find validator index for public key %#x", in.PublicKey)
+	}
+	return &ethpb.ValidatorIndexResponse{Index: vals[0].Index}, nil
+}
+
+// MultipleValidatorStatus returns the status of the requested validators, in the order of the request.
+func (c *beaconApiValidatorClient) MultipleValidatorStatus(ctx context.Context, in *ethpb.MultipleValidatorStatusRequest, _ ...grpc.CallOption) (*ethpb.MultipleValidatorStatusResponse, error) {
+	ids := make([]string, 0, len(in.PublicKeys)+len(in.Indices))
+	for _, pubKey := range in.PublicKeys {
+		ids = append(ids, hexutil.Encode(pubKey))
+	}
+	for _, index := range in.Indices {
+		ids = append(ids, strconv.FormatInt(index, 10))
+	}
+	vals, err := c.getStateValidators(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byPubKey := make(map[[fieldparams.BLSPubkeyLength]byte]*ethpbv1.ValidatorContainer, len(vals))
+	byIndex := make(map[types.ValidatorIndex]*ethpbv1.ValidatorContainer, len(vals))
+	for _, val := range vals {
+		if val.Validator == nil {
+			continue
+		}
+		byPubKey[bytesutil.ToBytes48(val.Validator.Pubkey)] = val
+		byIndex[val.Index] = val
+	}
+
+	resp := &ethpb.MultipleValidatorStatusResponse{}
+	add := func(pubKey []byte, val *ethpbv1.ValidatorContainer) {
+		resp.PublicKeys = append(resp.PublicKeys, pubKey)
+		if val == nil {
+			resp.Statuses = append(resp.Statuses, &ethpb.ValidatorStatusResponse{Status: ethpb.ValidatorStatus_UNKNOWN_STATUS})
+			resp.Indices = append(resp.Indices, 0)
+			return
+		}
+		resp.Statuses = append(resp.Statuses, validatorStatusResponse(val))
+		resp.Indices = append(resp.Indices, val.Index)
+	}
+	for _, pubKey := range in.PublicKeys {
+		add(pubKey, byPubKey[bytesutil.ToBytes48(pubKey)])
+	}
+	for _, index := range in.Indices {
+		if val, ok := byIndex[types.ValidatorIndex(index)]; ok {
+			add(val.Validator.Pubkey, val)
+		}
+	}
+	return resp, nil
+}
+
+// WaitForActivation returns a stream which reports the status of the requested validators once per slot.
+func (c *beaconApiValidatorClient) WaitForActivation(ctx context.Context, in *ethpb.ValidatorActivationRequest, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForActivationClient, error) {
+	return &waitForActivationStream{
+		pollingStream: pollingStream{ctx: ctx},
+		client:        c,
+		publicKeys:    in.PublicKeys,
+	}, nil
+}
+
+type waitForActivationStream struct {
+	pollingStream
+	client     *beaconApiValidatorClient
+	publicKeys [][]byte
+	polled     bool
+}
+
+// Recv returns the current statuses right away on the first call, and waits for the next slot on subsequent calls.
+func (s *waitForActivationStream) Recv() (*ethpb.ValidatorActivationResponse, error) {
+	if s.polled {
+		if err := s.wait(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second); err != nil {
+			return nil, err
+		}
+	}
+	s.polled = true
+	resp, err := s.client.MultipleValidatorStatus(s.ctx, &ethpb.MultipleValidatorStatusRequest{PublicKeys: s.publicKeys})
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]*ethpb.ValidatorActivationResponse_Status, len(resp.Statuses))
+	for i := range resp.Statuses {
+		statuses[i] = &ethpb.ValidatorActivationResponse_Status{
+			PublicKey: resp.PublicKeys[i],
+			Status:    resp.Statuses[i],
+			Index:     resp.Indices[i],
+		}
+	}
+	return &ethpb.ValidatorActivationResponse{Statuses: statuses}, nil
+}
+
+func validatorStatusResponse(val *ethpbv1.ValidatorContainer) *ethpb.ValidatorStatusResponse {
+	resp := &ethpb.ValidatorStatusResponse{Status: validatorStatusFromV1(val.Status)}
+	if val.Validator != nil {
+		resp.ActivationEpoch = val.Validator.ActivationEpoch
+	}
+	return resp
+}
+
+// validatorStatusFromV1 maps the validator status of the Beacon API to the coarser status used by the validator client.
+func validatorStatusFromV1(s ethpbv1.ValidatorStatus) ethpb.ValidatorStatus {
+	switch s {
+	case ethpbv1.ValidatorStatus_PENDING_INITIALIZED:
+		return ethpb.ValidatorStatus_DEPOSITED
+	case ethpbv1.ValidatorStatus_PENDING_QUEUED, ethpbv1.ValidatorStatus_PENDING:
+		return ethpb.ValidatorStatus_PENDING
+	case ethpbv1.ValidatorStatus_ACTIVE_ONGOING, ethpbv1.ValidatorStatus_ACTIVE:
+		return ethpb.ValidatorStatus_ACTIVE
+	case ethpbv1.ValidatorStatus_ACTIVE_EXITING:
+		return ethpb.ValidatorStatus_EXITING
+	case ethpbv1.ValidatorStatus_ACTIVE_SLASHED:
+		return ethpb.ValidatorStatus_SLASHING
+	case ethpbv1.ValidatorStatus_EXITED_UNSLASHED, ethpbv1.ValidatorStatus_EXITED_SLASHED, ethpbv1.ValidatorStatus_EXITED,
+		ethpbv1.ValidatorStatus_WITHDRAWAL_POSSIBLE, ethpbv1.ValidatorStatus_WITHDRAWAL_DONE, ethpbv1.ValidatorStatus_WITHDRAWAL:
+		return ethpb.ValidatorStatus_EXITED
+	default:
+		return ethpb.ValidatorStatus_UNKNOWN_STATUS
+	}
+}
@@ -0,0 +1,70 @@
+package beacon_api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validatorsHandler serves the head state validators from a registry of active validators.
+func validatorsHandler(t *testing.T, pubKeys [][]byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/eth/v1/beacon/states/head/validators", r.URL.Path)
+		data := make([]string, 0)
+		for _, id := range r.URL.Query()["id"] {
+			for i, pubKey := range pubKeys {
+				if id == hexutil.Encode(pubKey) || id == strconv.Itoa(i) {
+					data = append(data, fmt.Sprintf(
+						`{"index": "%d", "balance": "32000000000", "status": "active_ongoing", "validator": {"pubkey": "%#x", "activation_epoch": "5"}}`,
+						i, pubKey,
+					))
+				}
+			}
+		}
+		_, err := fmt.Fprintf(w, `{"data": [%s]}`, strings.Join(data, ","))
+		require.NoError(t, err)
+	}
+}
+
+func TestMultipleValidatorStatus(t *testing.T) {
+	pubKeys := [][]byte{bytesutil.PadTo([]byte{1}, 48), bytesutil.PadTo([]byte{2}, 48)}
+	unknown := bytesutil.PadTo([]byte{3}, 48)
+	c := newTestClient(t, validatorsHandler(t, pubKeys))
+
+	resp, err := c.MultipleValidatorStatus(context.Background(), &ethpb.MultipleValidatorStatusRequest{
+		PublicKeys: [][]byte{pubKeys[1], unknown},
+		Indices:    []int64{0},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][]byte{pubKeys[1], unknown, pubKeys[0]}, resp.PublicKeys)
+	require.Equal(t, 3, len(resp.Statuses))
+	assert.Equal(t, ethpb.ValidatorStatus_ACTIVE, resp.Statuses[0].Status)
+	assert.Equal(t, uint64(5), uint64(resp.Statuses[0].ActivationEpoch))
+	assert.Equal(t, ethpb.ValidatorStatus_UNKNOWN_STATUS, resp.Statuses[1].Status)
+	assert.Equal(t, ethpb.ValidatorStatus_ACTIVE, resp.Statuses[2].Status)
+	assert.Equal(t, uint64(1), uint64(resp.Indices[0]))
+	assert.Equal(t, uint64(0), uint64(resp.Indices[2]))
+}
+
+func TestValidatorIndex(t *testing.T) {
+	pubKeys := [][]byte{bytesutil.PadTo([]byte{1}, 48), bytesutil.PadTo([]byte{2}, 48)}
+	c := newTestClient(t, validatorsHandler(t, pubKeys))
+
+	resp, err := c.ValidatorIndex(context.Background(), &ethpb.ValidatorIndexRequest{PublicKey: pubKeys[1]})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), uint64(resp.Index))
+
+	_, err = c.ValidatorIndex(context.Background(), &ethpb.ValidatorIndexRequest{PublicKey: bytesutil.PadTo([]byte{3}, 48)})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
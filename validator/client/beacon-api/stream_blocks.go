@@ -0,0 +1,99 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"google.golang.org/grpc"
+)
+
+const blockPollInterval = time.Second
+
+// StreamBlocksAltair returns a stream of the blocks becoming the head of the beacon node.
+func (c *beaconApiValidatorClient) StreamBlocksAltair(ctx context.Context, _ *ethpb.StreamBlocksRequest, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_StreamBlocksAltairClient, error) {
+	return &streamBlocksStream{pollingStream: pollingStream{ctx: ctx}, client: c}, nil
+}
+
+type streamBlocksStream struct {
+	pollingStream
+	client   *beaconApiValidatorClient
+	headRoot []byte
+}
+
+// Recv polls the head of the beacon node and returns the head block whenever it changes. Only verified blocks
+// become the head, so the stream behaves as if verified blocks were requested.
+func (s *streamBlocksStream) Recv() (*ethpb.StreamBlocksResponse, error) {
+	for {
+		header := &ethpbv1.BlockHeaderResponse{}
+		if err := s.client.jsonRestHandler.get(s.ctx, "/eth/v1/beacon/headers/head", header); err != nil {
+			return nil, errors.Wrap(err, "could not get head block header")
+		}
+		if header.Data != nil && !bytes.Equal(header.Data.Root, s.headRoot) {
+			resp, err := s.client.signedBlock(s.ctx, header.Data.Root)
+			if err != nil {
+				return nil, err
+			}
+			s.headRoot = header.Data.Root
+			return resp, nil
+		}
+		if err := s.wait(blockPollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// signedBlock returns the signed block with the given root.
+func (c *beaconApiValidatorClient) signedBlock(ctx context.Context, root []byte) (*ethpb.StreamBlocksResponse, error) {
+	resp := &versionedJson{}
+	if err := c.jsonRestHandler.get(ctx, "/eth/v2/beacon/blocks/"+hexutil.Encode(root), resp); err != nil {
+		return nil, errors.Wrapf(err, "could not get block %#x", root)
+	}
+	signed := &signedJson{}
+	if err := json.Unmarshal(resp.Data, signed); err != nil {
+		return nil, errors.Wrap(err, "could not decode signed block")
+	}
+	sig, err := hexutil.Decode(signed.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode block signature")
+	}
+
+	switch resp.Version {
+	case version.String(version.Phase0):
+		apiBlock, block := &ethpbv1.BeaconBlock{}, &ethpb.SignedBeaconBlock{}
+		if err := unmarshalApiJSON(signed.Message, apiBlock); err != nil {
+			return nil, errors.Wrap(err, "could not decode block")
+		}
+		if err := convertSSZ(&ethpbv1.SignedBeaconBlock{Block: apiBlock, Signature: sig}, block); err != nil {
+			return nil, err
+		}
+		return &ethpb.StreamBlocksResponse{Block: &ethpb.StreamBlocksResponse_Phase0Block{Phase0Block: block}}, nil
+	case version.String(version.Altair):
+		apiBlock, block := &ethpbv2.BeaconBlockAltair{}, &ethpb.SignedBeaconBlockAltair{}
+		if err := unmarshalApiJSON(signed.Message, apiBlock); err != nil {
+			return nil, errors.Wrap(err, "could not decode block")
+		}
+		if err := convertSSZ(&ethpbv2.SignedBeaconBlockAltair{Message: apiBlock, Signature: sig}, block); err != nil {
+			return nil, err
+		}
+		return &ethpb.StreamBlocksResponse{Block: &ethpb.StreamBlocksResponse_AltairBlock{AltairBlock: block}}, nil
+	case version.String(version.Bellatrix):
+		apiBlock, block := &ethpbv2.BeaconBlockBellatrix{}, &ethpb.SignedBeaconBlockBellatrix{}
+		if err := unmarshalApiJSON(signed.Message, apiBlock); err != nil {
+			return nil, errors.Wrap(err, "could not decode block")
+		}
+		if err := convertSSZ(&ethpbv2.SignedBeaconBlockBellatrix{Message: apiBlock, Signature: sig}, block); err != nil {
+			return nil, err
+		}
+		return &ethpb.StreamBlocksResponse{Block: &ethpb.StreamBlocksResponse_BellatrixBlock{BellatrixBlock: block}}, nil
+	default:
+		return nil, errors.Errorf("unsupported block version %s", resp.Version)
+	}
+}
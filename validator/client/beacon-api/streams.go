@@ -0,0 +1,50 @@
+package beacon_api
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// pollingStream provides the grpc.ClientStream methods of the server streams consumed by the validator client.
+// The Beacon API has no equivalent of these streams, so they are emulated by polling the beacon node in Recv.
+type pollingStream struct {
+	ctx context.Context
+}
+
+func (*pollingStream) Header() (metadata.MD, error) {
+	return nil, nil
+}
+
+func (*pollingStream) Trailer() metadata.MD {
+	return nil
+}
+
+func (*pollingStream) CloseSend() error {
+	return nil
+}
+
+func (s *pollingStream) Context() context.Context {
+	return s.ctx
+}
+
+func (*pollingStream) SendMsg(interface{}) error {
+	return status.Error(codes.Unimplemented, "Sending messages is not supported over the Beacon API")
+}
+
+func (*pollingStream) RecvMsg(interface{}) error {
+	return status.Error(codes.Unimplemented, "Use Recv to receive messages over the Beacon API")
+}
+
+// wait blocks for the given duration or until the stream context is done.
+func (s *pollingStream) wait(d time.Duration) error {
+	select {
+	case <-time.After(d):
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
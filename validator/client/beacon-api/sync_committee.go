@@ -0,0 +1,127 @@
+package beacon_api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GetSyncMessageBlockRoot returns the head block root for sync committee members to sign.
+func (c *beaconApiValidatorClient) GetSyncMessageBlockRoot(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.SyncMessageBlockRootResponse, error) {
+	root, err := c.headBlockRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.SyncMessageBlockRootResponse{Root: root}, nil
+}
+
+func (c *beaconApiValidatorClient) headBlockRoot(ctx context.Context) ([]byte, error) {
+	resp := &ethpbv1.BlockRootResponse{}
+	if err := c.jsonRestHandler.get(ctx, "/eth/v1/beacon/blocks/head/root", resp); err != nil {
+		return nil, errors.Wrap(err, "could not get head block root")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("beacon node returned empty head block root")
+	}
+	return resp.Data.Root, nil
+}
+
+// SubmitSyncMessage publishes a sync committee message through the beacon node.
+func (c *beaconApiValidatorClient) SubmitSyncMessage(ctx context.Context, in *ethpb.SyncCommitteeMessage, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	body, err := marshalApiJSONArray([]proto.Message{&ethpbv2.SyncCommitteeMessage{
+		Slot:            in.Slot,
+		BeaconBlockRoot: in.BlockRoot,
+		ValidatorIndex:  in.ValidatorIndex,
+		Signature:       in.Signature,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.jsonRestHandler.post(ctx, "/eth/v1/beacon/pool/sync_committees", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not publish sync committee message")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetSyncSubcommitteeIndex returns the positions of the validator in the sync committee relevant to the slot.
+func (c *beaconApiValidatorClient) GetSyncSubcommitteeIndex(ctx context.Context, in *ethpb.SyncSubcommitteeIndexRequest, _ ...grpc.CallOption) (*ethpb.SyncSubcommitteeIndexResponse, error) {
+	indexResp, err := c.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: in.PublicKey})
+	if err != nil {
+		return nil, err
+	}
+	body, err := indicesJSON([]types.ValidatorIndex{indexResp.Index})
+	if err != nil {
+		return nil, err
+	}
+	// At the sync committee period boundary, the validator should sample the next period sync committee.
+	epoch := slots.ToEpoch(in.Slot + 1)
+	resp := &ethpbv2.SyncCommitteeDutiesResponse{}
+	if err := c.jsonRestHandler.post(ctx, fmt.Sprintf("/eth/v1/validator/duties/sync/%d", epoch), body, resp); err != nil {
+		return nil, errors.Wrapf(err, "could not get sync committee duties for epoch %d", epoch)
+	}
+	var indices []types.CommitteeIndex
+	for _, duty := range resp.Data {
+		if duty.ValidatorIndex != indexResp.Index {
+			continue
+		}
+		for _, index := range duty.ValidatorSyncCommitteeIndices {
+			indices = append(indices, types.CommitteeIndex(index))
+		}
+	}
+	return &ethpb.SyncSubcommitteeIndexResponse{Indices: indices}, nil
+}
+
+// GetSyncCommitteeContribution asks the beacon node for the aggregate of the sync committee messages of a
+// subcommittee for the head block.
+func (c *beaconApiValidatorClient) GetSyncCommitteeContribution(ctx context.Context, in *ethpb.SyncCommitteeContributionRequest, _ ...grpc.CallOption) (*ethpb.SyncCommitteeContribution, error) {
+	root, err := c.headBlockRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := url.Values{}
+	query.Set("slot", strconv.FormatUint(uint64(in.Slot), 10))
+	query.Set("subcommittee_index", strconv.FormatUint(in.SubnetId, 10))
+	query.Set("beacon_block_root", hexutil.Encode(root))
+	resp := &ethpbv2.ProduceSyncCommitteeContributionResponse{}
+	if err := c.jsonRestHandler.get(ctx, "/eth/v1/validator/sync_committee_contribution?"+query.Encode(), resp); err != nil {
+		return nil, errors.Wrap(err, "could not get sync committee contribution")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("beacon node returned empty sync committee contribution")
+	}
+	return &ethpb.SyncCommitteeContribution{
+		Slot:              resp.Data.Slot,
+		BlockRoot:         resp.Data.BeaconBlockRoot,
+		SubcommitteeIndex: resp.Data.SubcommitteeIndex,
+		AggregationBits:   resp.Data.AggregationBits,
+		Signature:         resp.Data.Signature,
+	}, nil
+}
+
+// SubmitSignedContributionAndProof publishes a signed sync committee contribution through the beacon node.
+func (c *beaconApiValidatorClient) SubmitSignedContributionAndProof(ctx context.Context, in *ethpb.SignedContributionAndProof, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	if in.Message == nil || in.Message.Contribution == nil {
+		return nil, errors.New("signed contribution and proof can not be empty")
+	}
+	body, err := marshalApiJSONArray([]proto.Message{migration.V1Alpha1SignedContributionAndProofToV2(in)})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.jsonRestHandler.post(ctx, "/eth/v1/validator/contribution_and_proofs", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not publish sync committee contribution")
+	}
+	return &emptypb.Empty{}, nil
+}
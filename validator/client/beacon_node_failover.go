@@ -0,0 +1,473 @@
+package client
+
+import (
+	"context"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/async"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// maxHealthyHeadLag is the number of slots the head of a beacon node can lag behind the current slot for the
+	// beacon node to be considered healthy.
+	maxHealthyHeadLag = 2
+	// minHealthyPeerCount is the minimum number of connected peers of a healthy beacon node.
+	minHealthyPeerCount = 1
+)
+
+// broadcastMethods are the methods submitting signed objects, which are submitted to every healthy beacon node.
+// The methods mapped to false are only broadcast in broadcast mode, while blocks and attestations are always
+// broadcast.
+var broadcastMethods = map[string]bool{
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/ProposeBlock":                        true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/ProposeBeaconBlock":                  true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/ProposeAttestation":                  true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/SubmitSignedAggregateSelectionProof": false,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/SubmitSyncMessage":                   false,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/SubmitSignedContributionAndProof":    false,
+}
+
+// beaconNodeStatus ranks the health of a beacon node, from the healthiest to the least healthy.
+type beaconNodeStatus int
+
+const (
+	beaconNodeHealthy beaconNodeStatus = iota
+	// beaconNodeDegraded is the status of synced beacon nodes which are optimistic, lagging behind or with too
+	// few peers.
+	beaconNodeDegraded
+	beaconNodeSyncing
+	beaconNodeUnreachable
+)
+
+func (s beaconNodeStatus) String() string {
+	switch s {
+	case beaconNodeHealthy:
+		return "healthy"
+	case beaconNodeDegraded:
+		return "degraded"
+	case beaconNodeSyncing:
+		return "syncing"
+	default:
+		return "unreachable"
+	}
+}
+
+// beaconNodeHealth is the health of a beacon node, as last probed.
+type beaconNodeHealth struct {
+	status       beaconNodeStatus
+	optimistic   bool
+	headSlot     types.Slot
+	syncDistance types.Slot
+	peers        uint64
+}
+
+// healthier states whether a beacon node in health a is healthier than a beacon node in health b. Among nodes
+// which are not healthy, the nodes with the smallest head lag, and then with the most peers, are preferred.
+func (a beaconNodeHealth) healthier(b beaconNodeHealth) bool {
+	if a.status != b.status {
+		return a.status < b.status
+	}
+	if a.status == beaconNodeHealthy || a.status == beaconNodeUnreachable {
+		return false
+	}
+	if a.syncDistance != b.syncDistance {
+		return a.syncDistance < b.syncDistance
+	}
+	return a.peers > b.peers
+}
+
+// beaconNode is the connection to one of the beacon nodes the validator client is configured with.
+type beaconNode struct {
+	endpoint string
+	conn     *grpc.ClientConn
+	client   ethpbservice.BeaconNodeClient
+	health   beaconNodeHealth
+}
+
+// probe queries the sync status and the peer count of the beacon node.
+func (n *beaconNode) probe(ctx context.Context) beaconNodeHealth {
+	syncStatus, err := n.client.GetSyncStatus(ctx, &empty.Empty{})
+	if err != nil || syncStatus.Data == nil {
+		log.WithError(err).WithField("endpoint", n.endpoint).Debug("Could not get beacon node sync status")
+		return beaconNodeHealth{status: beaconNodeUnreachable}
+	}
+	h := beaconNodeHealth{
+		status:       beaconNodeHealthy,
+		optimistic:   syncStatus.Data.IsOptimistic,
+		headSlot:     syncStatus.Data.HeadSlot,
+		syncDistance: syncStatus.Data.SyncDistance,
+	}
+	peerCount, err := n.client.PeerCount(ctx, &empty.Empty{})
+	if err != nil {
+		log.WithError(err).WithField("endpoint", n.endpoint).Debug("Could not get beacon node peer count")
+	} else if peerCount.Data != nil {
+		h.peers = peerCount.Data.Connected
+	}
+	switch {
+	case syncStatus.Data.IsSyncing:
+		h.status = beaconNodeSyncing
+	case h.optimistic, h.syncDistance > maxHealthyHeadLag, h.peers < minHealthyPeerCount:
+		h.status = beaconNodeDegraded
+	}
+	return h
+}
+
+// failoverConn is a grpc connection to several beacon nodes, which routes every call to the healthiest beacon node.
+// The health of the beacon nodes is probed every slot, and the calls fail over to another beacon node as soon as
+// a healthier one is found, or the active beacon node is unavailable. The active beacon node is kept as long as no
+// other beacon node is healthier, in the order of the configured endpoints.
+//
+// Signed blocks and attestations are submitted to every healthy beacon node instead, so that they are published
+// even when the active beacon node is poorly peered. In broadcast mode, so are aggregates and sync committee
+// messages.
+type failoverConn struct {
+	sync.RWMutex
+	nodes     []*beaconNode
+	active    int
+	broadcast bool
+	// streams cancels the streams opened on the active beacon node, so that they are opened again on the new
+	// active beacon node after a failover. The streams are removed once they end.
+	streams    map[uint64]context.CancelFunc
+	nextStream uint64
+	// failovers is signaled after a failover, so that the validator pushes its subnet subscriptions and proposer
+	// settings to the new active beacon node.
+	failovers chan struct{}
+}
+
+var _ grpc.ClientConnInterface = (*failoverConn)(nil)
+
+// beaconNodeEndpoints splits the comma separated beacon node endpoints.
+func beaconNodeEndpoints(endpoint string) []string {
+	var endpoints []string
+	for _, e := range strings.Split(endpoint, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			endpoints = append(endpoints, e)
+		}
+	}
+	return endpoints
+}
+
+// dialBeaconNodes dials every beacon node endpoint. The first endpoint is active until the beacon nodes are probed.
+// Aggregates and sync committee messages are also submitted to every healthy beacon node if broadcast is set.
+func dialBeaconNodes(ctx context.Context, endpoints []string, broadcast bool, opts ...grpc.DialOption) (*failoverConn, error) {
+	c := &failoverConn{
+		broadcast: broadcast,
+		streams:   make(map[uint64]context.CancelFunc),
+		failovers: make(chan struct{}, 1),
+	}
+	for _, endpoint := range endpoints {
+		conn, err := grpc.DialContext(ctx, endpoint, opts...)
+		if err != nil {
+			if closeErr := c.Close(); closeErr != nil {
+				log.WithError(closeErr).Error("Could not close beacon node connections")
+			}
+			return nil, errors.Wrapf(err, "could not dial beacon node %s", endpoint)
+		}
+		c.nodes = append(c.nodes, &beaconNode{
+			endpoint: endpoint,
+			conn:     conn,
+			client:   ethpbservice.NewBeaconNodeClient(conn),
+		})
+	}
+	beaconNodeActive.WithLabelValues(endpoints[0]).Set(1)
+	return c, nil
+}
+
+// start probes the health of the beacon nodes every slot, until the context is canceled.
+func (c *failoverConn) start(ctx context.Context) {
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	c.probe(ctx, slotDuration/3)
+	async.RunEvery(ctx, slotDuration, func() {
+		c.probe(ctx, slotDuration/3)
+	})
+}
+
+// probe probes the health of all the beacon nodes concurrently, and fails over to the healthiest beacon node.
+func (c *failoverConn) probe(ctx context.Context, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	healths := make([]beaconNodeHealth, len(c.nodes))
+	var wg sync.WaitGroup
+	for i, n := range c.nodes {
+		wg.Add(1)
+		go func(i int, n *beaconNode) {
+			defer wg.Done()
+			healths[i] = n.probe(ctx)
+		}(i, n)
+	}
+	wg.Wait()
+
+	c.Lock()
+	defer c.Unlock()
+	for i, n := range c.nodes {
+		if n.health.status != healths[i].status {
+			log.WithFields(logrus.Fields{
+				"endpoint":     n.endpoint,
+				"status":       healths[i].status,
+				"headSlot":     healths[i].headSlot,
+				"syncDistance": healths[i].syncDistance,
+				"optimistic":   healths[i].optimistic,
+				"peers":        healths[i].peers,
+			}).Info("Beacon node health changed")
+		}
+		n.health = healths[i]
+		beaconNodeStatusGauge.WithLabelValues(n.endpoint).Set(float64(n.health.status))
+	}
+	c.failoverLocked()
+}
+
+// failoverLocked makes the healthiest beacon node active, if it is not already. The caller must hold the lock.
+func (c *failoverConn) failoverLocked() {
+	best := c.active
+	for i, n := range c.nodes {
+		if n.health.healthier(c.nodes[best].health) {
+			best = i
+		}
+	}
+	if best == c.active {
+		return
+	}
+	from, to := c.nodes[c.active], c.nodes[best]
+	log.WithFields(logrus.Fields{
+		"from":       from.endpoint,
+		"fromStatus": from.health.status,
+		"to":         to.endpoint,
+		"toStatus":   to.health.status,
+	}).Warn("Failing over to another beacon node")
+	for id, cancel := range c.streams {
+		cancel()
+		delete(c.streams, id)
+	}
+	c.active = best
+	beaconNodeActive.WithLabelValues(from.endpoint).Set(0)
+	beaconNodeActive.WithLabelValues(to.endpoint).Set(1)
+	beaconNodeFailovers.Inc()
+	// A failover which was not handled yet covers this one as well.
+	select {
+	case c.failovers <- struct{}{}:
+	default:
+	}
+}
+
+// Failovers is signaled after the calls failed over to another beacon node.
+func (c *failoverConn) Failovers() <-chan struct{} {
+	return c.failovers
+}
+
+// broadcastNodes returns the beacon nodes signed objects are submitted to: the active beacon node, and every other
+// healthy beacon node.
+func (c *failoverConn) broadcastNodes() (*beaconNode, []*beaconNode) {
+	c.RLock()
+	defer c.RUnlock()
+	active := c.nodes[c.active]
+	nodes := []*beaconNode{active}
+	for _, n := range c.nodes {
+		if n != active && n.health.status == beaconNodeHealthy {
+			nodes = append(nodes, n)
+		}
+	}
+	return active, nodes
+}
+
+// activeNode returns the beacon node the calls are routed to.
+func (c *failoverConn) activeNode() *beaconNode {
+	c.RLock()
+	defer c.RUnlock()
+	return c.nodes[c.active]
+}
+
+// failoverFrom marks the beacon node as unreachable when it is still the active one, and fails over to the
+// healthiest beacon node. It returns the new active beacon node, or false if there is no other beacon node to
+// fail over to.
+func (c *failoverConn) failoverFrom(n *beaconNode) (*beaconNode, bool) {
+	c.Lock()
+	defer c.Unlock()
+	if c.nodes[c.active] == n {
+		n.health = beaconNodeHealth{status: beaconNodeUnreachable}
+		beaconNodeStatusGauge.WithLabelValues(n.endpoint).Set(float64(n.health.status))
+		c.failoverLocked()
+	}
+	active := c.nodes[c.active]
+	return active, active != n
+}
+
+// Invoke performs a unary call on the active beacon node. If the active beacon node is unavailable, the call is
+// retried once on the healthiest other beacon node, without waiting for the next health probe. The calls submitting
+// signed objects are performed on every healthy beacon node.
+func (c *failoverConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	if always, ok := broadcastMethods[method]; ok && (always || c.broadcast) {
+		if active, nodes := c.broadcastNodes(); len(nodes) > 1 {
+			return c.broadcastInvoke(ctx, active, nodes, method, args, reply, opts...)
+		}
+	}
+	n := c.activeNode()
+	err := n.conn.Invoke(ctx, method, args, reply, opts...)
+	if status.Code(err) != codes.Unavailable {
+		return err
+	}
+	next, ok := c.failoverFrom(n)
+	if !ok {
+		return err
+	}
+	return next.conn.Invoke(ctx, method, args, reply, opts...)
+}
+
+// broadcastInvoke performs a unary call on the beacon nodes concurrently, and returns as soon as one of them
+// succeeds. The calls to the other beacon nodes carry on in the background until the deadline of the caller, and
+// the outcome of every call is logged. If the call fails on every beacon node, the error of the active beacon node
+// is returned.
+func (c *failoverConn) broadcastInvoke(
+	ctx context.Context,
+	active *beaconNode,
+	nodes []*beaconNode,
+	method string,
+	args, reply interface{},
+	opts ...grpc.CallOption,
+) error {
+	replyMsg, ok := reply.(proto.Message)
+	if !ok {
+		return errors.Errorf("could not broadcast %s: reply is not a proto message", method)
+	}
+	type result struct {
+		node  *beaconNode
+		reply proto.Message
+		err   error
+	}
+	broadcastCtx, cancel := detachedContext(ctx)
+	results := make(chan result, len(nodes))
+	var wg sync.WaitGroup
+	for _, n := range nodes {
+		wg.Add(1)
+		go func(n *beaconNode) {
+			defer wg.Done()
+			r := proto.Clone(replyMsg)
+			start := time.Now()
+			err := n.conn.Invoke(broadcastCtx, method, args, r, opts...)
+			logBroadcast(n.endpoint, method, time.Since(start), err)
+			results <- result{node: n, reply: r, err: err}
+		}(n)
+	}
+	go func() {
+		wg.Wait()
+		cancel()
+	}()
+
+	var activeErr error
+	for range nodes {
+		select {
+		case res := <-results:
+			if res.err == nil {
+				proto.Reset(replyMsg)
+				proto.Merge(replyMsg, res.reply)
+				return nil
+			}
+			if res.node == active {
+				activeErr = res.err
+			}
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+	return activeErr
+}
+
+// detachedContext returns a context which carries the outgoing metadata and the deadline of ctx, but is not canceled
+// with it. Without a deadline, the context expires after a slot.
+func detachedContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	}
+	detached, cancel := context.WithDeadline(context.Background(), deadline)
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		detached = metadata.NewOutgoingContext(detached, md)
+	}
+	return detached, cancel
+}
+
+func logBroadcast(endpoint, method string, latency time.Duration, err error) {
+	method = path.Base(method)
+	l := log.WithFields(logrus.Fields{
+		"endpoint": endpoint,
+		"method":   method,
+		"latency":  latency,
+	})
+	if err != nil {
+		beaconNodeBroadcasts.WithLabelValues(endpoint, method, "failure").Inc()
+		l.WithError(err).Debug("Could not broadcast to beacon node")
+		return
+	}
+	beaconNodeBroadcasts.WithLabelValues(endpoint, method, "success").Inc()
+	l.Debug("Beacon node acknowledged broadcast")
+}
+
+// NewStream opens a stream on the active beacon node. The stream is canceled when failing over to another beacon
+// node, so that the caller opens it again on the new active beacon node.
+func (c *failoverConn) NewStream(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	method string,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	c.Lock()
+	n := c.nodes[c.active]
+	id := c.nextStream
+	c.nextStream++
+	c.streams[id] = cancel
+	c.Unlock()
+	// The stream is forgotten once its context is done, which is when the caller cancels it, when it is canceled
+	// on failover or when it ends.
+	go func() {
+		<-ctx.Done()
+		c.Lock()
+		delete(c.streams, id)
+		c.Unlock()
+	}()
+	stream, err := n.conn.NewStream(ctx, desc, method, opts...)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &failoverStream{ClientStream: stream, cancel: cancel}, nil
+}
+
+// failoverStream cancels the context of a stream once the stream ends.
+type failoverStream struct {
+	grpc.ClientStream
+	cancel context.CancelFunc
+}
+
+// RecvMsg receives a message from the stream, and cancels the context of the stream once it ends.
+func (s *failoverStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.cancel()
+	}
+	return err
+}
+
+// Close closes the connections to all the beacon nodes.
+func (c *failoverConn) Close() error {
+	var closeErr error
+	for _, n := range c.nodes {
+		if err := n.conn.Close(); err != nil {
+			closeErr = errors.Wrapf(err, "could not close connection to beacon node %s", n.endpoint)
+		}
+	}
+	return closeErr
+}
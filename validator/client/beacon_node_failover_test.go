@@ -0,0 +1,359 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbalpha "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeBeaconNode serves the sync status and the peer count of a beacon node.
+type fakeBeaconNode struct {
+	ethpbservice.UnimplementedBeaconNodeServer
+	sync.Mutex
+	syncInfo *ethpb.SyncInfo
+	peers    uint64
+	// validator serves the validator API of the beacon node, if set.
+	validator *fakeValidatorNode
+}
+
+func (f *fakeBeaconNode) setSyncInfo(syncInfo *ethpb.SyncInfo) {
+	f.Lock()
+	defer f.Unlock()
+	f.syncInfo = syncInfo
+}
+
+func (f *fakeBeaconNode) GetSyncStatus(_ context.Context, _ *empty.Empty) (*ethpb.SyncingResponse, error) {
+	f.Lock()
+	defer f.Unlock()
+	return &ethpb.SyncingResponse{Data: f.syncInfo}, nil
+}
+
+func (f *fakeBeaconNode) PeerCount(_ context.Context, _ *empty.Empty) (*ethpb.PeerCountResponse, error) {
+	return &ethpb.PeerCountResponse{Data: &ethpb.PeerCountResponse_PeerCount{Connected: f.peers}}, nil
+}
+
+func (f *fakeBeaconNode) GetVersion(_ context.Context, _ *empty.Empty) (*ethpb.VersionResponse, error) {
+	return &ethpb.VersionResponse{Data: &ethpb.Version{Version: "fake"}}, nil
+}
+
+func startFakeBeaconNode(t *testing.T, node *fakeBeaconNode) (string, *grpc.Server) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	ethpbservice.RegisterBeaconNodeServer(server, node)
+	if node.validator != nil {
+		ethpbalpha.RegisterBeaconNodeValidatorServer(server, node.validator)
+	}
+	go func() {
+		if err := server.Serve(lis); err != nil {
+			t.Log(err)
+		}
+	}()
+	t.Cleanup(server.Stop)
+	return lis.Addr().String(), server
+}
+
+// fakeValidatorNode acknowledges the attestations and sync committee messages submitted to a beacon node, or rejects them with err.
+type fakeValidatorNode struct {
+	ethpbalpha.UnimplementedBeaconNodeValidatorServer
+	root         []byte
+	err          error
+	attestations chan *ethpbalpha.Attestation
+	syncMessages chan *ethpbalpha.SyncCommitteeMessage
+}
+
+func newFakeValidatorNode(root []byte, err error) *fakeValidatorNode {
+	return &fakeValidatorNode{
+		root:         root,
+		err:          err,
+		attestations: make(chan *ethpbalpha.Attestation, 1),
+		syncMessages: make(chan *ethpbalpha.SyncCommitteeMessage, 1),
+	}
+}
+
+func (f *fakeValidatorNode) SubmitSyncMessage(_ context.Context, msg *ethpbalpha.SyncCommitteeMessage) (*empty.Empty, error) {
+	f.syncMessages <- msg
+	return &empty.Empty{}, f.err
+}
+
+func (f *fakeValidatorNode) ProposeAttestation(_ context.Context, att *ethpbalpha.Attestation) (*ethpbalpha.AttestResponse, error) {
+	f.attestations <- att
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ethpbalpha.AttestResponse{AttestationDataRoot: f.root}, nil
+}
+
+func (f *fakeValidatorNode) WaitForChainStart(_ *empty.Empty, stream ethpbalpha.BeaconNodeValidator_WaitForChainStartServer) error {
+	return stream.Send(&ethpbalpha.ChainStartResponse{Started: true})
+}
+
+func healthyNode() *fakeBeaconNode {
+	return &fakeBeaconNode{syncInfo: &ethpb.SyncInfo{HeadSlot: 100}, peers: 50}
+}
+
+func TestBeaconNodeEndpoints(t *testing.T) {
+	assert.DeepEqual(t, []string{"127.0.0.1:4000"}, beaconNodeEndpoints("127.0.0.1:4000"))
+	assert.DeepEqual(t, []string{"127.0.0.1:4000", "127.0.0.1:4001"}, beaconNodeEndpoints("127.0.0.1:4000, 127.0.0.1:4001,"))
+}
+
+func TestBeaconNode_Probe(t *testing.T) {
+	tests := []struct {
+		name string
+		node *fakeBeaconNode
+		want beaconNodeStatus
+	}{
+		{name: "healthy", node: healthyNode(), want: beaconNodeHealthy},
+		{name: "syncing", node: &fakeBeaconNode{syncInfo: &ethpb.SyncInfo{IsSyncing: true, SyncDistance: 100}, peers: 50}, want: beaconNodeSyncing},
+		{name: "optimistic", node: &fakeBeaconNode{syncInfo: &ethpb.SyncInfo{IsOptimistic: true}, peers: 50}, want: beaconNodeDegraded},
+		{name: "lagging", node: &fakeBeaconNode{syncInfo: &ethpb.SyncInfo{SyncDistance: maxHealthyHeadLag + 1}, peers: 50}, want: beaconNodeDegraded},
+		{name: "no peers", node: &fakeBeaconNode{syncInfo: &ethpb.SyncInfo{}}, want: beaconNodeDegraded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint, _ := startFakeBeaconNode(t, tt.node)
+			c, err := dialBeaconNodes(context.Background(), []string{endpoint, endpoint}, false, grpc.WithInsecure())
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, c.Close())
+			}()
+			h := c.nodes[0].probe(context.Background())
+			assert.Equal(t, tt.want, h.status)
+		})
+	}
+}
+
+func TestFailoverConn_Probe(t *testing.T) {
+	primary := healthyNode()
+	secondary := healthyNode()
+	primaryEndpoint, _ := startFakeBeaconNode(t, primary)
+	secondaryEndpoint, _ := startFakeBeaconNode(t, secondary)
+	c, err := dialBeaconNodes(context.Background(), []string{primaryEndpoint, secondaryEndpoint}, false, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, c.Close())
+	}()
+
+	c.probe(context.Background(), time.Second)
+	assert.Equal(t, 0, c.active)
+	assert.Equal(t, 0, len(c.Failovers()), "Failover signaled without a failover")
+
+	// The primary beacon node falls behind.
+	primary.setSyncInfo(&ethpb.SyncInfo{HeadSlot: 90, SyncDistance: 10})
+	streamCanceled := false
+	c.streams[0] = func() { streamCanceled = true }
+	c.probe(context.Background(), time.Second)
+	assert.Equal(t, 1, c.active)
+	assert.Equal(t, beaconNodeDegraded, c.nodes[0].health.status)
+	assert.Equal(t, true, streamCanceled, "Streams were not canceled on failover")
+	assert.Equal(t, 0, len(c.streams))
+	assert.Equal(t, 1, len(c.Failovers()), "Failover was not signaled")
+	<-c.Failovers()
+
+	// The secondary beacon node is kept while it is healthy, even once the primary beacon node is healthy again.
+	primary.setSyncInfo(&ethpb.SyncInfo{HeadSlot: 101})
+	c.probe(context.Background(), time.Second)
+	assert.Equal(t, 1, c.active)
+
+	// Among unhealthy beacon nodes, the one with the smallest head lag is preferred.
+	primary.setSyncInfo(&ethpb.SyncInfo{IsSyncing: true, SyncDistance: 10})
+	secondary.setSyncInfo(&ethpb.SyncInfo{IsSyncing: true, SyncDistance: 20})
+	c.probe(context.Background(), time.Second)
+	assert.Equal(t, 0, c.active)
+}
+
+func TestFailoverConn_Invoke_Unavailable(t *testing.T) {
+	primaryEndpoint, primaryServer := startFakeBeaconNode(t, healthyNode())
+	secondaryEndpoint, _ := startFakeBeaconNode(t, healthyNode())
+	c, err := dialBeaconNodes(context.Background(), []string{primaryEndpoint, secondaryEndpoint}, false, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, c.Close())
+	}()
+	client := ethpbservice.NewBeaconNodeClient(c)
+
+	_, err = client.GetVersion(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, c.active)
+
+	// The call is retried on the secondary beacon node without waiting for the next probe.
+	primaryServer.Stop()
+	res, err := client.GetVersion(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, "fake", res.Data.Version)
+	assert.Equal(t, 1, c.active)
+	assert.Equal(t, beaconNodeUnreachable, c.nodes[0].health.status)
+}
+
+func TestFailoverConn_NewStream_RemovedOnEnd(t *testing.T) {
+	node := healthyNode()
+	node.validator = newFakeValidatorNode(nil, nil)
+	endpoint, _ := startFakeBeaconNode(t, node)
+	c, err := dialBeaconNodes(context.Background(), []string{endpoint}, false, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, c.Close())
+	}()
+	client := ethpbalpha.NewBeaconNodeValidatorClient(c)
+
+	// A stream read until its end is removed.
+	stream, err := client.WaitForChainStart(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	res, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, true, res.Started)
+	_, err = stream.Recv()
+	require.Equal(t, io.EOF, err)
+	waitForStreams(t, c, 0)
+
+	// A stream canceled by the caller is removed.
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err = client.WaitForChainStart(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	cancel()
+	waitForStreams(t, c, 0)
+}
+
+// waitForStreams waits for the number of streams tracked by the connection to be n.
+func waitForStreams(t *testing.T, c *failoverConn, n int) {
+	for i := 0; i < 100; i++ {
+		c.RLock()
+		count := len(c.streams)
+		c.RUnlock()
+		if count == n {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("Expected %d streams", n)
+}
+
+func TestBeaconNodeHealth_Healthier(t *testing.T) {
+	healthy := beaconNodeHealth{status: beaconNodeHealthy, peers: 10}
+	assert.Equal(t, true, healthy.healthier(beaconNodeHealth{status: beaconNodeDegraded}))
+	assert.Equal(t, false, healthy.healthier(beaconNodeHealth{status: beaconNodeHealthy, peers: 1}))
+	degraded := beaconNodeHealth{status: beaconNodeDegraded, syncDistance: types.Slot(3)}
+	assert.Equal(t, true, degraded.healthier(beaconNodeHealth{status: beaconNodeDegraded, syncDistance: 4}))
+	assert.Equal(t, true, degraded.healthier(beaconNodeHealth{status: beaconNodeUnreachable}))
+	assert.Equal(t, false, degraded.healthier(beaconNodeHealth{status: beaconNodeDegraded, syncDistance: 3, peers: 1}))
+}
+
+func TestFailoverConn_Broadcast(t *testing.T) {
+	att := &ethpbalpha.Attestation{Signature: []byte{'a'}}
+	tests := []struct {
+		name       string
+		primaryErr error
+		// secondaryStatus is the health of the secondary beacon node.
+		secondaryStatus beaconNodeStatus
+		wantRoot        []byte
+		wantErr         string
+		// wantSecondary is whether the attestation is submitted to the secondary beacon node.
+		wantSecondary bool
+	}{
+		{
+			name:          "primary acknowledges",
+			wantRoot:      []byte("primary"),
+			wantSecondary: true,
+		},
+		{
+			name:          "secondary acknowledges",
+			primaryErr:    status.Error(codes.Internal, "primary rejected"),
+			wantRoot:      []byte("secondary"),
+			wantSecondary: true,
+		},
+		{
+			name:            "unhealthy secondary",
+			primaryErr:      status.Error(codes.Internal, "primary rejected"),
+			secondaryStatus: beaconNodeDegraded,
+			wantErr:         "primary rejected",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary, secondary := healthyNode(), healthyNode()
+			primary.validator = newFakeValidatorNode([]byte("primary"), tt.primaryErr)
+			secondary.validator = newFakeValidatorNode([]byte("secondary"), nil)
+			if tt.primaryErr == nil {
+				// The primary beacon node acknowledges first.
+				secondary.validator.err = status.Error(codes.Internal, "secondary rejected")
+			}
+			primaryEndpoint, _ := startFakeBeaconNode(t, primary)
+			secondaryEndpoint, _ := startFakeBeaconNode(t, secondary)
+			// Attestations are broadcast without broadcast mode.
+			c, err := dialBeaconNodes(context.Background(), []string{primaryEndpoint, secondaryEndpoint}, false, grpc.WithInsecure())
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, c.Close())
+			}()
+			c.nodes[1].health.status = tt.secondaryStatus
+
+			res, err := ethpbalpha.NewBeaconNodeValidatorClient(c).ProposeAttestation(context.Background(), att)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+			} else {
+				require.NoError(t, err)
+				assert.DeepEqual(t, tt.wantRoot, res.AttestationDataRoot)
+			}
+			assert.DeepEqual(t, att.Signature, (<-primary.validator.attestations).Signature)
+			if tt.wantSecondary {
+				assert.DeepEqual(t, att.Signature, (<-secondary.validator.attestations).Signature)
+			} else {
+				assert.Equal(t, 0, len(secondary.validator.attestations))
+			}
+		})
+	}
+}
+
+func TestFailoverConn_Broadcast_Mode(t *testing.T) {
+	// Sync committee messages are only submitted to every healthy beacon node in broadcast mode.
+	for _, broadcast := range []bool{false, true} {
+		primary, secondary := healthyNode(), healthyNode()
+		primary.validator = newFakeValidatorNode(nil, nil)
+		secondary.validator = newFakeValidatorNode(nil, nil)
+		primaryEndpoint, _ := startFakeBeaconNode(t, primary)
+		secondaryEndpoint, _ := startFakeBeaconNode(t, secondary)
+		c, err := dialBeaconNodes(context.Background(), []string{primaryEndpoint, secondaryEndpoint}, broadcast, grpc.WithInsecure())
+		require.NoError(t, err)
+
+		_, err = ethpbalpha.NewBeaconNodeValidatorClient(c).SubmitSyncMessage(context.Background(), &ethpbalpha.SyncCommitteeMessage{})
+		require.NoError(t, err)
+		<-primary.validator.syncMessages
+		if broadcast {
+			<-secondary.validator.syncMessages
+		} else {
+			assert.Equal(t, 0, len(secondary.validator.syncMessages))
+		}
+		require.NoError(t, c.Close())
+	}
+}
+
+func TestFailoverConn_Broadcast_AllFail(t *testing.T) {
+	primary, secondary := healthyNode(), healthyNode()
+	primary.validator = newFakeValidatorNode(nil, status.Error(codes.Internal, "primary rejected"))
+	secondary.validator = newFakeValidatorNode(nil, status.Error(codes.Internal, "secondary rejected"))
+	primaryEndpoint, _ := startFakeBeaconNode(t, primary)
+	secondaryEndpoint, _ := startFakeBeaconNode(t, secondary)
+	c, err := dialBeaconNodes(context.Background(), []string{primaryEndpoint, secondaryEndpoint}, true, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, c.Close())
+	}()
+
+	// The error of the active beacon node is returned.
+	_, err = ethpbalpha.NewBeaconNodeValidatorClient(c).ProposeAttestation(context.Background(), &ethpbalpha.Attestation{})
+	require.ErrorContains(t, "primary rejected", err)
+}
@@ -0,0 +1,318 @@
+package client
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// doppelGangerEpochs is the number of epochs in which a key must be seen offline before it starts performing duties.
+const doppelGangerEpochs = 2
+
+var (
+	// ErrDoppelGangerDetected is returned when a key under doppelganger detection was seen live on the network.
+	ErrDoppelGangerDetected = errors.New("doppelganger detected")
+	// ErrDoppelGangerUnavailable is returned when the beacon node supports no way to detect doppelgangers.
+	ErrDoppelGangerUnavailable = errors.New("doppelganger detection unavailable")
+)
+
+// StartDoppelGangerDetection puts all the validating keys under doppelganger detection. The keys do not
+// perform any duty until they were seen offline for doppelGangerEpochs epochs.
+func (v *validator) StartDoppelGangerDetection(ctx context.Context) error {
+	if !features.Get().EnableDoppelGanger {
+		return nil
+	}
+	pubKeys, err := v.keyManager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return err
+	}
+	v.trackDoppelGangerKeys(pubKeys)
+	return nil
+}
+
+// trackDoppelGangerKeys puts the keys which were not loaded before under doppelganger detection and forgets about
+// the keys which are not loaded anymore, so that they are checked again if they are loaded back.
+//
+// Detection starts in the epoch following the current one, as this validator client may have attested or proposed
+// with the keys in the current epoch before a restart or before the keys were moved from another validator client.
+func (v *validator) trackDoppelGangerKeys(pubKeys [][fieldparams.BLSPubkeyLength]byte) {
+	if !features.Get().EnableDoppelGanger {
+		return
+	}
+	startEpoch := slots.ToEpoch(slots.CurrentSlot(v.genesisTime)) + 1
+
+	v.doppelGangerLock.Lock()
+	defer v.doppelGangerLock.Unlock()
+	if v.doppelGangerKeys == nil {
+		v.doppelGangerKeys = make(map[[fieldparams.BLSPubkeyLength]byte]types.Epoch)
+	}
+	loaded := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(pubKeys))
+	newKeys := 0
+	for _, pubKey := range pubKeys {
+		loaded[pubKey] = true
+		if !v.doppelGangerLoadedKeys[pubKey] {
+			v.doppelGangerKeys[pubKey] = startEpoch
+			newKeys++
+		}
+	}
+	for pubKey := range v.doppelGangerKeys {
+		if !loaded[pubKey] {
+			delete(v.doppelGangerKeys, pubKey)
+		}
+	}
+	v.doppelGangerLoadedKeys = loaded
+	if newKeys > 0 {
+		log.WithFields(logrus.Fields{
+			"keys":       newKeys,
+			"dutiesFrom": startEpoch + doppelGangerEpochs,
+		}).Info("Starting doppelganger detection, keys will not perform duties until they are seen offline")
+	}
+}
+
+// isUnderDoppelGangerDetection returns whether the key must not perform duties yet.
+func (v *validator) isUnderDoppelGangerDetection(pubKey [fieldparams.BLSPubkeyLength]byte) bool {
+	v.doppelGangerLock.RLock()
+	defer v.doppelGangerLock.RUnlock()
+	_, ok := v.doppelGangerKeys[pubKey]
+	return ok
+}
+
+// CheckDoppelGanger asks the beacon node whether the keys under doppelganger detection were seen live in the current
+// or the previous epoch, and releases the keys which were seen offline for long enough. An error wrapping
+// ErrDoppelGangerDetected is returned if any of the keys was seen live.
+//
+// The indices of the keys are taken from the duties of the current epoch, so the duties must be updated first.
+//
+// If the beacon node does not serve the liveness of validators, or has failed to for doppelGangerEpochs epochs,
+// the keys are checked against their attestation history with the Prysm doppelganger check instead. An error
+// wrapping ErrDoppelGangerUnavailable is returned if neither check is supported by the beacon node.
+func (v *validator) CheckDoppelGanger(ctx context.Context, slot types.Slot) error {
+	if !features.Get().EnableDoppelGanger {
+		return nil
+	}
+	v.doppelGangerLock.RLock()
+	startEpochs := make(map[[fieldparams.BLSPubkeyLength]byte]types.Epoch, len(v.doppelGangerKeys))
+	for pubKey, startEpoch := range v.doppelGangerKeys {
+		startEpochs[pubKey] = startEpoch
+	}
+	v.doppelGangerLock.RUnlock()
+	if len(startEpochs) == 0 {
+		return nil
+	}
+	if v.livenessClient == nil {
+		return v.checkDoppelGangerWithHistory(ctx, startEpochs)
+	}
+
+	epoch := slots.ToEpoch(slot)
+	err := v.checkDoppelGangerLiveness(ctx, epoch, startEpochs)
+	if err == nil || errors.Is(err, ErrDoppelGangerDetected) {
+		v.doppelGangerLivenessFailing = false
+		return err
+	}
+	if !isUnimplementedError(err) {
+		if !v.doppelGangerLivenessFailing {
+			v.doppelGangerLivenessFailing = true
+			v.doppelGangerLivenessFailingSince = epoch
+		}
+		if epoch < v.doppelGangerLivenessFailingSince+doppelGangerEpochs {
+			return err
+		}
+	}
+	log.WithError(err).Warn("Could not get the liveness of validators, falling back to the doppelganger check of the beacon node")
+	historyErr := v.checkDoppelGangerWithHistory(ctx, startEpochs)
+	if isUnimplementedError(historyErr) {
+		return errors.Wrapf(
+			ErrDoppelGangerUnavailable,
+			"beacon node serves neither the liveness of validators (%v) nor the doppelganger check (%v)",
+			err,
+			historyErr,
+		)
+	}
+	return historyErr
+}
+
+// checkDoppelGangerLiveness checks the liveness of the keys under doppelganger detection in the current and previous
+// epochs, and releases the keys which were seen offline for doppelGangerEpochs epochs.
+func (v *validator) checkDoppelGangerLiveness(
+	ctx context.Context, epoch types.Epoch, startEpochs map[[fieldparams.BLSPubkeyLength]byte]types.Epoch,
+) error {
+	indices := make(map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex, len(startEpochs))
+	if v.duties != nil {
+		for _, duty := range v.duties.CurrentEpochDuties {
+			pubKey := [fieldparams.BLSPubkeyLength]byte{}
+			copy(pubKey[:], duty.PublicKey)
+			if _, ok := startEpochs[pubKey]; ok && duty.Status != ethpb.ValidatorStatus_UNKNOWN_STATUS {
+				indices[pubKey] = duty.ValidatorIndex
+			}
+		}
+	}
+
+	checkEpochs := []types.Epoch{epoch}
+	if epoch > 0 {
+		checkEpochs = append(checkEpochs, epoch-1)
+	}
+	for _, checkEpoch := range checkEpochs {
+		keys := make(map[types.ValidatorIndex][fieldparams.BLSPubkeyLength]byte)
+		req := &ethpbv2.GetLivenessRequest{Epoch: checkEpoch}
+		for pubKey, index := range indices {
+			if startEpochs[pubKey] <= checkEpoch {
+				keys[index] = pubKey
+				req.Index = append(req.Index, index)
+			}
+		}
+		if len(req.Index) == 0 {
+			continue
+		}
+		resp, err := v.livenessClient.GetLiveness(ctx, req)
+		if err != nil {
+			return errors.Wrapf(err, "could not get liveness for epoch %d", checkEpoch)
+		}
+		var live [][]byte
+		for _, l := range resp.Data {
+			if pubKey, ok := keys[l.Index]; ok && l.IsLive {
+				live = append(live, pubKey[:])
+			}
+		}
+		if len(live) > 0 {
+			return errors.Wrapf(ErrDoppelGangerDetected, "validator keys %#x were seen live in epoch %d", live, checkEpoch)
+		}
+	}
+
+	v.doppelGangerLock.Lock()
+	defer v.doppelGangerLock.Unlock()
+	released := 0
+	for pubKey, startEpoch := range v.doppelGangerKeys {
+		if _, ok := indices[pubKey]; !ok {
+			// Keys unknown to the beacon node can not be checked, nor perform duties. They are checked from
+			// scratch once they are known.
+			v.doppelGangerKeys[pubKey] = epoch + 1
+			continue
+		}
+		// The last epoch of the detection was checked as the previous epoch, so it is over.
+		if startEpoch == startEpochs[pubKey] && epoch >= startEpoch+doppelGangerEpochs {
+			delete(v.doppelGangerKeys, pubKey)
+			released++
+		}
+	}
+	if released > 0 {
+		log.WithField("keys", released).Info("Doppelganger detection completed, keys will start performing duties")
+	}
+	return nil
+}
+
+// checkDoppelGangerWithHistory asks the beacon node whether the keys under doppelganger detection were seen
+// attesting after their latest attestation in the slashing protection history, and releases them if they were not.
+func (v *validator) checkDoppelGangerWithHistory(
+	ctx context.Context, startEpochs map[[fieldparams.BLSPubkeyLength]byte]types.Epoch,
+) error {
+	log.WithField("keys", len(startEpochs)).Info("Running doppelganger check")
+	req := &ethpb.DoppelGangerRequest{ValidatorRequests: []*ethpb.DoppelGangerRequest_ValidatorRequest{}}
+	for pkey := range startEpochs {
+		copiedKey := pkey
+		attRec, err := v.db.AttestationHistoryForPubKey(ctx, copiedKey)
+		if err != nil {
+			return err
+		}
+		if len(attRec) == 0 {
+			// If no history exists we simply send in a zero
+			// value for the request epoch and root.
+			req.ValidatorRequests = append(req.ValidatorRequests,
+				&ethpb.DoppelGangerRequest_ValidatorRequest{
+					PublicKey:  copiedKey[:],
+					Epoch:      0,
+					SignedRoot: make([]byte, fieldparams.RootLength),
+				})
+			continue
+		}
+		r := retrieveLatestRecord(attRec)
+		if copiedKey != r.PubKey {
+			return errors.New("attestation record mismatched public key")
+		}
+		req.ValidatorRequests = append(req.ValidatorRequests,
+			&ethpb.DoppelGangerRequest_ValidatorRequest{
+				PublicKey:  r.PubKey[:],
+				Epoch:      r.Target,
+				SignedRoot: r.SigningRoot[:],
+			})
+	}
+	resp, err := v.validatorClient.CheckDoppelGanger(ctx, req)
+	if err != nil {
+		return err
+	}
+	// If nothing is returned by the beacon node, we return an
+	// error as it is unsafe for us to proceed.
+	if resp == nil || resp.Responses == nil || len(resp.Responses) == 0 {
+		return errors.New("beacon node returned 0 responses for doppelganger check")
+	}
+	if err := buildDuplicateError(resp.Responses); err != nil {
+		return err
+	}
+
+	v.doppelGangerLock.Lock()
+	defer v.doppelGangerLock.Unlock()
+	for pubKey, startEpoch := range startEpochs {
+		// Keys reloaded in the meantime are checked again.
+		if v.doppelGangerKeys[pubKey] == startEpoch {
+			delete(v.doppelGangerKeys, pubKey)
+		}
+	}
+	log.WithField("keys", len(startEpochs)).Info("Doppelganger check completed, keys will start performing duties")
+	return nil
+}
+
+func buildDuplicateError(response []*ethpb.DoppelGangerResponse_ValidatorResponse) error {
+	duplicates := make([][]byte, 0)
+	for _, valRes := range response {
+		if valRes.DuplicateExists {
+			copiedKey := [fieldparams.BLSPubkeyLength]byte{}
+			copy(copiedKey[:], valRes.PublicKey)
+			duplicates = append(duplicates, copiedKey[:])
+		}
+	}
+	if len(duplicates) == 0 {
+		return nil
+	}
+	return errors.Wrapf(ErrDoppelGangerDetected, "Duplicate instances exists in the network for validator keys: %#x", duplicates)
+}
+
+// Ensures that the latest attestation history is retrieved.
+func retrieveLatestRecord(recs []*kv.AttestationRecord) *kv.AttestationRecord {
+	if len(recs) == 0 {
+		return nil
+	}
+	lastSource := recs[len(recs)-1].Source
+	chosenRec := recs[len(recs)-1]
+	for i := len(recs) - 1; i >= 0; i-- {
+		// Exit if we are now on a different source
+		// as it is assumed that all source records are
+		// byte sorted.
+		if recs[i].Source != lastSource {
+			break
+		}
+		// If we have a smaller target, we do
+		// change our chosen record.
+		if chosenRec.Target < recs[i].Target {
+			chosenRec = recs[i]
+		}
+	}
+	return chosenRec
+}
+
+// isUnimplementedError returns whether the beacon node does not serve the called endpoint.
+func isUnimplementedError(err error) bool {
+	if err == nil {
+		return false
+	}
+	st, ok := status.FromError(errors.Cause(err))
+	return ok && (st.Code() == codes.Unimplemented || st.Code() == codes.NotFound)
+}
@@ -0,0 +1,194 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	mock2 "github.com/prysmaticlabs/prysm/testing/mock"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	dbTest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeLivenessClient reports the validators in live as live, and records the requests it receives.
+type fakeLivenessClient struct {
+	live     map[types.Epoch]map[types.ValidatorIndex]bool
+	requests []*ethpbv2.GetLivenessRequest
+	err      error
+}
+
+func (c *fakeLivenessClient) GetLiveness(_ context.Context, in *ethpbv2.GetLivenessRequest, _ ...grpc.CallOption) (*ethpbv2.GetLivenessResponse, error) {
+	c.requests = append(c.requests, in)
+	if c.err != nil {
+		return nil, c.err
+	}
+	resp := &ethpbv2.GetLivenessResponse{}
+	for _, index := range in.Index {
+		resp.Data = append(resp.Data, &ethpbv2.GetLivenessResponse_Liveness{Index: index, IsLive: c.live[in.Epoch][index]})
+	}
+	return resp, nil
+}
+
+// doppelGangerTestValidator returns a validator in the middle of the given epoch, with active keys that have
+// a proposer duty at the first slot of the epoch.
+func doppelGangerTestValidator(t *testing.T, epoch types.Epoch, numKeys int) (*validator, *fakeLivenessClient, [][fieldparams.BLSPubkeyLength]byte) {
+	km := genMockKeymanager(numKeys)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	duties := make([]*ethpb.DutiesResponse_Duty, len(keys))
+	for i := range keys {
+		duties[i] = &ethpb.DutiesResponse_Duty{
+			PublicKey:      keys[i][:],
+			ValidatorIndex: types.ValidatorIndex(i),
+			Status:         ethpb.ValidatorStatus_ACTIVE,
+			ProposerSlots:  []types.Slot{params.BeaconConfig().SlotsPerEpoch.Mul(uint64(epoch))},
+		}
+	}
+	secondsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot))
+	elapsed := uint64(epoch)*secondsPerEpoch + secondsPerEpoch/2
+	client := &fakeLivenessClient{live: make(map[types.Epoch]map[types.ValidatorIndex]bool)}
+	v := &validator{
+		keyManager:     km,
+		db:             dbTest.SetupDB(t, keys),
+		livenessClient: client,
+		genesisTime:    uint64(time.Now().Unix()) - elapsed,
+		duties:         &ethpb.DutiesResponse{Duties: duties, CurrentEpochDuties: duties},
+	}
+	return v, client, keys
+}
+
+func TestDoppelGanger_KeysReleasedWhenOffline(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableDoppelGanger: true})
+	defer resetCfg()
+	ctx := context.Background()
+	v, client, keys := doppelGangerTestValidator(t, 10, 2)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	require.NoError(t, v.StartDoppelGangerDetection(ctx))
+	roles, err := v.RolesAt(ctx, slotsPerEpoch.Mul(10))
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(roles), "Keys under detection must not perform duties")
+
+	// Detection starts in the next epoch, so nothing is checked in the current one.
+	require.NoError(t, v.CheckDoppelGanger(ctx, slotsPerEpoch.Mul(10)+20))
+	assert.Equal(t, 0, len(client.requests))
+
+	require.NoError(t, v.CheckDoppelGanger(ctx, slotsPerEpoch.Mul(11)))
+	require.Equal(t, 1, len(client.requests))
+	assert.Equal(t, types.Epoch(11), client.requests[0].Epoch)
+	require.NoError(t, v.CheckDoppelGanger(ctx, slotsPerEpoch.Mul(12)))
+	assert.Equal(t, true, v.isUnderDoppelGangerDetection(keys[0]))
+
+	// Epochs 11 and 12 were checked once epoch 13 starts.
+	require.NoError(t, v.CheckDoppelGanger(ctx, slotsPerEpoch.Mul(13)))
+	assert.Equal(t, false, v.isUnderDoppelGangerDetection(keys[0]))
+	assert.Equal(t, false, v.isUnderDoppelGangerDetection(keys[1]))
+	roles, err = v.RolesAt(ctx, slotsPerEpoch.Mul(10))
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(roles))
+
+	// Released keys are not checked anymore.
+	client.requests = nil
+	require.NoError(t, v.CheckDoppelGanger(ctx, slotsPerEpoch.Mul(13)+1))
+	assert.Equal(t, 0, len(client.requests))
+}
+
+func TestDoppelGanger_LiveKeyDetected(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableDoppelGanger: true})
+	defer resetCfg()
+	ctx := context.Background()
+	v, client, _ := doppelGangerTestValidator(t, 10, 2)
+	client.live[11] = map[types.ValidatorIndex]bool{1: true}
+
+	require.NoError(t, v.StartDoppelGangerDetection(ctx))
+	err := v.CheckDoppelGanger(ctx, params.BeaconConfig().SlotsPerEpoch.Mul(12))
+	assert.Equal(t, true, errors.Is(err, ErrDoppelGangerDetected))
+	assert.ErrorContains(t, "seen live in epoch 11", err)
+}
+
+func TestDoppelGanger_ReloadedKeys(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableDoppelGanger: true})
+	defer resetCfg()
+	v, _, keys := doppelGangerTestValidator(t, 10, 3)
+
+	v.trackDoppelGangerKeys(keys[:1])
+	v.doppelGangerKeys = make(map[[fieldparams.BLSPubkeyLength]byte]types.Epoch)
+
+	// Only the newly loaded key is put under detection.
+	v.trackDoppelGangerKeys(keys[:2])
+	assert.Equal(t, false, v.isUnderDoppelGangerDetection(keys[0]))
+	assert.Equal(t, true, v.isUnderDoppelGangerDetection(keys[1]))
+	assert.Equal(t, types.Epoch(11), v.doppelGangerKeys[keys[1]])
+
+	// Removed keys are forgotten.
+	v.trackDoppelGangerKeys([][fieldparams.BLSPubkeyLength]byte{keys[0], keys[2]})
+	assert.Equal(t, false, v.isUnderDoppelGangerDetection(keys[1]))
+	assert.Equal(t, true, v.isUnderDoppelGangerDetection(keys[2]))
+}
+
+func TestDoppelGanger_Disabled(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{})
+	defer resetCfg()
+	v, _, keys := doppelGangerTestValidator(t, 10, 1)
+	require.NoError(t, v.StartDoppelGangerDetection(context.Background()))
+	assert.Equal(t, false, v.isUnderDoppelGangerDetection(keys[0]))
+}
+
+func TestDoppelGanger_LivenessUnimplemented(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableDoppelGanger: true})
+	defer resetCfg()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	v, client, keys := doppelGangerTestValidator(t, 10, 1)
+	client.err = status.Error(codes.NotFound, "not found")
+	validatorClient := mock2.NewMockBeaconNodeValidatorClient(ctrl)
+	v.validatorClient = validatorClient
+	validatorClient.EXPECT().CheckDoppelGanger(gomock.Any(), gomock.Any()).Return(&ethpb.DoppelGangerResponse{
+		Responses: []*ethpb.DoppelGangerResponse_ValidatorResponse{{PublicKey: keys[0][:], DuplicateExists: false}},
+	}, nil)
+
+	require.NoError(t, v.StartDoppelGangerDetection(ctx))
+	// The beacon node does not serve the liveness of validators, so the keys are checked with their history.
+	require.NoError(t, v.CheckDoppelGanger(ctx, params.BeaconConfig().SlotsPerEpoch.Mul(11)))
+	assert.Equal(t, false, v.isUnderDoppelGangerDetection(keys[0]))
+}
+
+func TestDoppelGanger_LivenessFailing(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableDoppelGanger: true})
+	defer resetCfg()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	v, client, keys := doppelGangerTestValidator(t, 10, 1)
+	client.err = status.Error(codes.Unavailable, "unavailable")
+	validatorClient := mock2.NewMockBeaconNodeValidatorClient(ctrl)
+	v.validatorClient = validatorClient
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	require.NoError(t, v.StartDoppelGangerDetection(ctx))
+	// Failures are retried for doppelGangerEpochs epochs.
+	err := v.CheckDoppelGanger(ctx, slotsPerEpoch.Mul(11))
+	assert.ErrorContains(t, "could not get liveness", err)
+	err = v.CheckDoppelGanger(ctx, slotsPerEpoch.Mul(12))
+	assert.ErrorContains(t, "could not get liveness", err)
+	assert.Equal(t, true, v.isUnderDoppelGangerDetection(keys[0]))
+
+	// The fallback is not supported either, so doppelganger detection fails.
+	validatorClient.EXPECT().CheckDoppelGanger(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unimplemented, "unimplemented"))
+	err = v.CheckDoppelGanger(ctx, slotsPerEpoch.Mul(13))
+	assert.Equal(t, true, errors.Is(err, ErrDoppelGangerUnavailable))
+	assert.Equal(t, true, v.isUnderDoppelGangerDetection(keys[0]))
+}
@@ -0,0 +1,14 @@
+package iface
+
+import (
+	"context"
+
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"google.golang.org/grpc"
+)
+
+// LivenessClient defines the beacon node method the validator client uses to find out whether
+// validators were seen live on the network, which backs doppelganger detection.
+type LivenessClient interface {
+	GetLiveness(ctx context.Context, in *ethpbv2.GetLivenessRequest, opts ...grpc.CallOption) (*ethpbv2.GetLivenessResponse, error)
+}
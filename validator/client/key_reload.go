@@ -14,6 +14,8 @@ func (v *validator) HandleKeyReload(ctx context.Context, newKeys [][fieldparams.
 	ctx, span := trace.StartSpan(ctx, "validator.HandleKeyReload")
 	defer span.End()
 
+	v.trackDoppelGangerKeys(newKeys)
+
 	statusRequestKeys := make([][]byte, len(newKeys))
 	for i := range newKeys {
 		statusRequestKeys[i] = newKeys[i][:]
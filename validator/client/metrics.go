@@ -13,6 +13,8 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/time/slots"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -212,6 +214,45 @@ var (
 			"pubkey",
 		},
 	)
+	beaconNodeStatusGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_status",
+			Help:      "Beacon node health when several beacon nodes are configured: 0 healthy, 1 degraded, 2 syncing, 3 unreachable",
+		},
+		[]string{
+			"endpoint",
+		},
+	)
+	beaconNodeActive = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_active",
+			Help:      "1 for the beacon node the validator client currently uses, 0 otherwise",
+		},
+		[]string{
+			"endpoint",
+		},
+	)
+	beaconNodeFailovers = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_failovers_total",
+			Help:      "The number of times the validator client failed over to another beacon node",
+		},
+	)
+	beaconNodeBroadcasts = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_broadcasts_total",
+			Help:      "The number of signed objects broadcast to each beacon node, by method and outcome",
+		},
+		[]string{
+			"endpoint",
+			"method",
+			"outcome",
+		},
+	)
 )
 
 // LogValidatorGainsAndLosses logs important metrics related to this validator client's
@@ -239,6 +280,11 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot types.S
 		PublicKeys: pubKeys,
 	}
 	resp, err := v.beaconClient.GetValidatorPerformance(ctx, req)
+	if status.Code(err) == codes.Unimplemented {
+		// Beacon nodes served through the standard Beacon API may not report the performance of validators.
+		log.WithError(err).Debug("Could not get validator performance")
+		return nil
+	}
 	if err != nil {
 		return err
 	}
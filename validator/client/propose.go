@@ -21,9 +21,11 @@ import (
 	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/runtime/version"
 	prysmTime "github.com/prysmaticlabs/prysm/time"
+	beaconApi "github.com/prysmaticlabs/prysm/validator/client/beacon-api"
 	"github.com/prysmaticlabs/prysm/validator/client/iface"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -71,7 +73,10 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 		log.WithError(err).Warn("Could not get graffiti")
 	}
 
-	// Request block from beacon node
+	// Request block from beacon node, blinded if the proposer registered with a builder
+	if v.builderEnabled(pubKey) {
+		ctx = metadata.AppendToOutgoingContext(ctx, beaconApi.BlindedBlockMetadataKey, "true")
+	}
 	b, err := v.validatorClient.GetBeaconBlock(ctx, &ethpb.BlockRequest{
 		Slot:         slot,
 		RandaoReveal: randaoReveal,
@@ -185,8 +190,8 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 // The exit is signed by the validator before being sent to the beacon node for broadcasting.
 func ProposeExit(
 	ctx context.Context,
-	validatorClient ethpb.BeaconNodeValidatorClient,
-	nodeClient ethpb.NodeClient,
+	validatorClient iface.ValidatorClient,
+	nodeClient iface.NodeClient,
 	signer iface.SigningFunc,
 	pubKey []byte,
 ) error {
@@ -283,7 +288,7 @@ func (v *validator) signBlock(ctx context.Context, pubKey [fieldparams.BLSPubkey
 // Sign voluntary exit with proposer domain and private key.
 func signVoluntaryExit(
 	ctx context.Context,
-	validatorClient ethpb.BeaconNodeValidatorClient,
+	validatorClient iface.ValidatorClient,
 	signer iface.SigningFunc,
 	pubKey []byte,
 	exit *ethpb.VoluntaryExit,
@@ -318,6 +323,13 @@ func signVoluntaryExit(
 	return sig.Marshal(), nil
 }
 
+// builderEnabled returns whether the proposer settings enable the builder for the validator public key.
+func (v *validator) builderEnabled(pubKey [fieldparams.BLSPubkeyLength]byte) bool {
+	v.proposerSettingsLock.RLock()
+	defer v.proposerSettingsLock.RUnlock()
+	return v.ProposerSettings != nil && v.ProposerSettings.BuilderEnabled(pubKey)
+}
+
 // Gets the graffiti from cli or file for the validator public key.
 func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, error) {
 	// When specified, default graffiti from the command line takes the first priority.
@@ -14,6 +14,7 @@ import (
 
 var failedBlockSignLocalErr = "attempted to sign a double proposal, block rejected by local protection"
 var failedBlockSignExternalErr = "attempted a double proposal, block rejected by remote slashing protection"
+var failedBlockSignRemoteProtectionErr = "block rejected by remote slashing protection"
 
 func (v *validator) slashableProposalCheck(
 	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signedBlock interfaces.SignedBeaconBlock, signingRoot [32]byte,
@@ -74,6 +75,14 @@ func (v *validator) slashableProposalCheck(
 			return errors.New(failedBlockSignExternalErr)
 		}
 	}
+	if v.remoteSlashingProtection != nil {
+		if err := v.remoteSlashingProtection.checkProposal(ctx, pubKey, signingRoot, blk.Slot()); err != nil {
+			if v.emitAccountMetrics {
+				ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
+			}
+			return errors.Wrap(err, failedBlockSignRemoteProtectionErr)
+		}
+	}
 	if err := v.db.SaveProposalHistoryForSlot(ctx, pubKey, blk.Slot(), signingRoot[:]); err != nil {
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
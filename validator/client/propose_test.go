@@ -13,6 +13,7 @@ import (
 	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
+	validatorserviceconfig "github.com/prysmaticlabs/prysm/config/validator/service"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
@@ -24,10 +25,12 @@ import (
 	"github.com/prysmaticlabs/prysm/testing/mock"
 	"github.com/prysmaticlabs/prysm/testing/require"
 	"github.com/prysmaticlabs/prysm/testing/util"
+	beaconApi "github.com/prysmaticlabs/prysm/validator/client/beacon-api"
 	testing2 "github.com/prysmaticlabs/prysm/validator/db/testing"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -191,6 +194,52 @@ func TestProposeBlock_RequestBlockFailed(t *testing.T) {
 	}
 }
 
+func TestProposeBlock_RequestsBlindedBlock(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled bool
+	}{
+		{
+			name:    "builder enabled",
+			enabled: true,
+		},
+		{
+			name:    "builder disabled",
+			enabled: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator, m, validatorKey, finish := setup(t)
+			defer finish()
+			pubKey := [fieldparams.BLSPubkeyLength]byte{}
+			copy(pubKey[:], validatorKey.PublicKey().Marshal())
+			validator.ProposerSettings = &validatorserviceconfig.ProposerSettings{
+				DefaultConfig: &validatorserviceconfig.ProposerOption{
+					BuilderConfig: &validatorserviceconfig.BuilderConfig{Enabled: tt.enabled},
+				},
+			}
+
+			m.validatorClient.EXPECT().DomainData(
+				gomock.Any(), // ctx
+				gomock.Any(), // epoch
+			).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+
+			m.validatorClient.EXPECT().GetBeaconBlock(
+				gomock.Any(), // ctx
+				gomock.AssignableToTypeOf(&ethpb.BlockRequest{}),
+			).DoAndReturn(func(ctx context.Context, _ *ethpb.BlockRequest, _ ...grpc.CallOption) (*ethpb.GenericBeaconBlock, error) {
+				md, _ := metadata.FromOutgoingContext(ctx)
+				assert.Equal(t, tt.enabled, len(md.Get(beaconApi.BlindedBlockMetadataKey)) == 1)
+				return nil, errors.New("uh oh")
+			})
+
+			validator.ProposeBlock(context.Background(), 1, pubKey)
+		})
+	}
+}
+
 func TestProposeBlock_ProposeBlockFailed(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -2,22 +2,26 @@ package client
 
 import (
 	"context"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/api/client/builder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/validator/client/iface"
 	"go.opencensus.io/trace"
+	"google.golang.org/grpc/metadata"
 )
 
 // SubmitValidatorRegistrations signs validator registration objects and submits it to the beacon node.
 func SubmitValidatorRegistrations(
 	ctx context.Context,
-	validatorClient ethpb.BeaconNodeValidatorClient,
+	validatorClient iface.ValidatorClient,
 	signedRegs []*ethpb.SignedValidatorRegistrationV1,
 ) error {
 	ctx, span := trace.StartSpan(ctx, "validator.SubmitValidatorRegistrations")
@@ -36,6 +40,39 @@ func SubmitValidatorRegistrations(
 	return nil
 }
 
+// submitValidatorRegistrationsToRelays submits the registrations grouped by the builder relays of their validators,
+// which are passed to the beacon node in the request metadata, so that each validator is registered with its own
+// relays. Validators without relays are registered with the default relays of the beacon node.
+func submitValidatorRegistrationsToRelays(
+	ctx context.Context,
+	validatorClient iface.ValidatorClient,
+	signedRegs []*ethpb.SignedValidatorRegistrationV1,
+	relays func(pubKey [fieldparams.BLSPubkeyLength]byte) []string,
+) error {
+	var groups []string
+	regsByGroup := make(map[string][]*ethpb.SignedValidatorRegistrationV1)
+	relaysByGroup := make(map[string][]string)
+	for _, reg := range signedRegs {
+		r := relays(bytesutil.ToBytes48(reg.Message.Pubkey))
+		g := strings.Join(r, ",")
+		if _, ok := regsByGroup[g]; !ok {
+			groups = append(groups, g)
+			relaysByGroup[g] = r
+		}
+		regsByGroup[g] = append(regsByGroup[g], reg)
+	}
+	for _, g := range groups {
+		groupCtx := ctx
+		for _, r := range relaysByGroup[g] {
+			groupCtx = metadata.AppendToOutgoingContext(groupCtx, builder.RelaysMetadataKey, r)
+		}
+		if err := SubmitValidatorRegistrations(groupCtx, validatorClient, regsByGroup[g]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Sings validator registration obj with the proposer domain and private key.
 func signValidatorRegistration(ctx context.Context, signer iface.SigningFunc, reg *ethpb.ValidatorRegistrationV1) ([]byte, error) {
 
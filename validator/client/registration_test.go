@@ -2,18 +2,24 @@ package client
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/api/client/builder"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
+	validatorserviceconfig "github.com/prysmaticlabs/prysm/config/validator/service"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func TestSubmitValidatorRegistrations(t *testing.T) {
@@ -44,6 +50,44 @@ func TestSubmitValidatorRegistrations(t *testing.T) {
 	}))
 }
 
+func TestSubmitValidatorRegistrationsToRelays(t *testing.T) {
+	_, m, _, finish := setup(t)
+	defer finish()
+
+	key1 := [fieldparams.BLSPubkeyLength]byte{1}
+	key2 := [fieldparams.BLSPubkeyLength]byte{2}
+	key3 := [fieldparams.BLSPubkeyLength]byte{3}
+	relays := []string{"https://0x01@relay1", "https://0x02@relay2"}
+	settings := &validatorserviceconfig.ProposerSettings{
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*validatorserviceconfig.ProposerOption{
+			key3: {BuilderConfig: &validatorserviceconfig.BuilderConfig{Enabled: true}},
+		},
+		DefaultConfig: &validatorserviceconfig.ProposerOption{
+			BuilderConfig: &validatorserviceconfig.BuilderConfig{Enabled: true, Relays: relays},
+		},
+	}
+	regs := make([]*ethpb.SignedValidatorRegistrationV1, 0)
+	for _, k := range [][fieldparams.BLSPubkeyLength]byte{key1, key2, key3} {
+		regs = append(regs, &ethpb.SignedValidatorRegistrationV1{
+			Message: &ethpb.ValidatorRegistrationV1{Pubkey: bytesutil.SafeCopyBytes(k[:])},
+		})
+	}
+
+	submitted := make(map[string][]*ethpb.SignedValidatorRegistrationV1)
+	m.validatorClient.EXPECT().
+		SubmitValidatorRegistrations(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, in *ethpb.SignedValidatorRegistrationsV1, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			r := strings.Join(md.Get(builder.RelaysMetadataKey), ",")
+			submitted[r] = append(submitted[r], in.Messages...)
+			return &emptypb.Empty{}, nil
+		}).Times(2)
+	require.NoError(t, submitValidatorRegistrationsToRelays(context.Background(), m.validatorClient, regs, settings.Relays))
+	require.DeepEqual(t, regs[:2], submitted[strings.Join(relays, ",")])
+	// Validators without relays are registered with the default relays of the beacon node.
+	require.DeepEqual(t, regs[2:], submitted[""])
+}
+
 func TestSubmitValidatorRegistration_CantSign(t *testing.T) {
 	_, m, validatorKey, finish := setup(t)
 	defer finish()
@@ -0,0 +1,154 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	grpcprometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/plugin/ocgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+// releaseLeasesTimeout bounds the time spent releasing the leases of the public keys when the validator client
+// stops.
+const releaseLeasesTimeout = 5 * time.Second
+
+// remoteSlashingProtection consults a remote slashing protection server before signing, in addition to the
+// local slashing protection history. The server leases each public key to a single validator client at a time,
+// so that validator clients running the same keys never both sign for a key.
+type remoteSlashingProtection struct {
+	conn       *grpc.ClientConn
+	client     validatorpb.RemoteSlashingProtectionClient
+	holder     string
+	leasedLock sync.Mutex
+	leased     map[[fieldparams.BLSPubkeyLength]byte]bool
+}
+
+// dialRemoteSlashingProtection dials the remote slashing protection server. The validator client holds the leases
+// of its public keys as holder, which defaults to the host name and process ID of the validator client.
+func dialRemoteSlashingProtection(
+	ctx context.Context, endpoint, withCert, holder string,
+) (*remoteSlashingProtection, error) {
+	var transportSecurity grpc.DialOption
+	if withCert != "" {
+		creds, err := credentials.NewClientTLSFromFile(withCert, "")
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get valid credentials")
+		}
+		transportSecurity = grpc.WithTransportCredentials(creds)
+	} else {
+		transportSecurity = grpc.WithInsecure()
+		log.Warn("You are using an insecure gRPC connection to the remote slashing protection server")
+	}
+	conn, err := grpc.DialContext(
+		ctx,
+		endpoint,
+		transportSecurity,
+		grpc.WithStatsHandler(&ocgrpc.ClientHandler{}),
+		grpc.WithUnaryInterceptor(grpcprometheus.UnaryClientInterceptor),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not dial remote slashing protection server %s", endpoint)
+	}
+	if holder == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			hostname = "validator"
+		}
+		holder = fmt.Sprintf("%s-%d", hostname, os.Getpid())
+	}
+	log.WithFields(logrus.Fields{
+		"endpoint": endpoint,
+		"holder":   holder,
+	}).Info("Using remote slashing protection")
+	return &remoteSlashingProtection{
+		conn:   conn,
+		client: validatorpb.NewRemoteSlashingProtectionClient(conn),
+		holder: holder,
+		leased: make(map[[fieldparams.BLSPubkeyLength]byte]bool),
+	}, nil
+}
+
+// checkAttestation checks that the attestation is not slashable, and records it in the remote slashing
+// protection history. The lease of the public key is acquired or renewed.
+func (r *remoteSlashingProtection) checkAttestation(
+	ctx context.Context,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	signingRoot [32]byte,
+	indexedAtt *ethpb.IndexedAttestation,
+) error {
+	_, err := r.client.CheckAndSaveAttestation(ctx, &validatorpb.SlashableAttestationRequest{
+		Holder:      r.holder,
+		PublicKey:   pubKey[:],
+		SigningRoot: signingRoot[:],
+		SourceEpoch: uint64(indexedAtt.Data.Source.Epoch),
+		TargetEpoch: uint64(indexedAtt.Data.Target.Epoch),
+	})
+	return r.leaseResult(pubKey, err)
+}
+
+// checkProposal checks that the block proposal is not slashable, and records it in the remote slashing protection
+// history. The lease of the public key is acquired or renewed.
+func (r *remoteSlashingProtection) checkProposal(
+	ctx context.Context,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	signingRoot [32]byte,
+	slot types.Slot,
+) error {
+	_, err := r.client.CheckAndSaveProposal(ctx, &validatorpb.SlashableProposalRequest{
+		Holder:      r.holder,
+		PublicKey:   pubKey[:],
+		SigningRoot: signingRoot[:],
+		Slot:        uint64(slot),
+	})
+	return r.leaseResult(pubKey, err)
+}
+
+// leaseResult tracks the public keys leased to the validator client, so that their leases are released when it
+// stops.
+func (r *remoteSlashingProtection) leaseResult(pubKey [fieldparams.BLSPubkeyLength]byte, err error) error {
+	r.leasedLock.Lock()
+	defer r.leasedLock.Unlock()
+	switch status.Code(err) {
+	case codes.OK:
+		r.leased[pubKey] = true
+		return nil
+	case codes.PermissionDenied:
+		delete(r.leased, pubKey)
+		return errors.Wrap(err, "public key is leased to another validator client")
+	default:
+		return err
+	}
+}
+
+// close releases the leases of the public keys of the validator client, so that another validator client can
+// sign for them right away, and closes the connection to the remote slashing protection server.
+func (r *remoteSlashingProtection) close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), releaseLeasesTimeout)
+	defer cancel()
+	r.leasedLock.Lock()
+	for pubKey := range r.leased {
+		pk := pubKey
+		if _, err := r.client.ReleaseLease(ctx, &validatorpb.LeaseRequest{
+			PublicKey: pk[:],
+			Holder:    r.holder,
+		}); err != nil {
+			log.WithError(err).WithField("publicKey", fmt.Sprintf("%#x", pk)).Error("Could not release lease")
+		}
+		delete(r.leased, pubKey)
+	}
+	r.leasedLock.Unlock()
+	return r.conn.Close()
+}
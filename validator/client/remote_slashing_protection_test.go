@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	slashingprotection "github.com/prysmaticlabs/prysm/validator/slashing-protection-server"
+	"google.golang.org/grpc"
+)
+
+func startSlashingProtectionServer(t *testing.T, pubKey [fieldparams.BLSPubkeyLength]byte) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	validatorpb.RegisterRemoteSlashingProtectionServer(server, slashingprotection.NewServer(context.Background(), &slashingprotection.Config{
+		ValDB: dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey}),
+	}))
+	go func() {
+		if err := server.Serve(lis); err != nil {
+			t.Log(err)
+		}
+	}()
+	t.Cleanup(server.Stop)
+	return lis.Addr().String()
+}
+
+func TestRemoteSlashingProtection_Leases(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	endpoint := startSlashingProtectionServer(t, pubKey)
+	primary, err := dialRemoteSlashingProtection(ctx, endpoint, "", "primary")
+	require.NoError(t, err)
+	standby, err := dialRemoteSlashingProtection(ctx, endpoint, "", "standby")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, standby.close())
+	}()
+
+	att := &ethpb.IndexedAttestation{
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 1},
+			Target: &ethpb.Checkpoint{Epoch: 2},
+		},
+	}
+	require.NoError(t, primary.checkAttestation(ctx, pubKey, [32]byte{1}, att))
+	require.ErrorContains(t, "leased to another validator client", standby.checkAttestation(ctx, pubKey, [32]byte{1}, att))
+	require.ErrorContains(t, "leased to another validator client", standby.checkProposal(ctx, pubKey, [32]byte{2}, 10))
+
+	// The standby validator client takes over once the primary validator client stops.
+	require.NoError(t, primary.close())
+	require.NoError(t, standby.checkProposal(ctx, pubKey, [32]byte{2}, 10))
+	// The slashing protection history is shared.
+	require.ErrorContains(t, "lowest target epoch", standby.checkAttestation(ctx, pubKey, [32]byte{3}, att))
+}
@@ -62,6 +62,7 @@ func run(ctx context.Context, v iface.Validator) {
 			log.Fatalf("Failed to update proposer settings: %v", err) // allow fatal. skipcq
 		}
 	}
+	currentSlot := headSlot
 	for {
 		_, cancel := context.WithCancel(ctx)
 		ctx, span := trace.StartSpan(ctx, "validator.processSlot")
@@ -80,6 +81,17 @@ func run(ctx context.Context, v iface.Validator) {
 				go v.ReceiveBlocks(ctx, connectionErrorChannel)
 				continue
 			}
+		case <-v.BeaconNodeFailovers():
+			// The new beacon node is neither subscribed to the subnets of the validators nor prepared for their
+			// proposals, the duties and proposer settings are pushed to it again right away.
+			log.Info("Beacon node changed, updating duties and proposer settings")
+			v.ResetDuties()
+			if err := v.UpdateDuties(ctx, currentSlot); err != nil {
+				handleAssignmentError(err, currentSlot)
+			}
+			if err := v.PushProposerSettings(ctx, km); err != nil {
+				log.WithError(err).Warn("Failed to update proposer settings")
+			}
 		case newKeys := <-accountsChangedChan:
 			anyActive, err := v.HandleKeyReload(ctx, newKeys)
 			if err != nil {
@@ -94,6 +106,7 @@ func run(ctx context.Context, v iface.Validator) {
 			}
 		case slot := <-v.NextSlot():
 			span.AddAttributes(trace.Int64Attribute("slot", int64(slot))) // lint:ignore uintcast -- This conversion is OK for tracing.
+			currentSlot = slot
 			reloadRemoteKeys(ctx, km)
 			allExited, err := v.AllValidatorsAreExited(ctx)
 			if err != nil {
@@ -127,6 +140,14 @@ func run(ctx context.Context, v iface.Validator) {
 				}()
 			}
 
+			if err := v.CheckDoppelGanger(ctx, slot); errors.Is(err, ErrDoppelGangerDetected) {
+				log.Fatalf("Stopping the validator client to prevent slashing: %v", err)
+			} else if errors.Is(err, ErrDoppelGangerUnavailable) {
+				log.Fatalf("Could not run doppelganger detection, use a beacon node which supports it or disable --enable-doppelganger: %v", err)
+			} else if err != nil {
+				log.WithError(err).Error("Could not check doppelganger")
+			}
+
 			// Start fetching domain data for the next epoch.
 			if slots.IsEpochEnd(slot) {
 				go v.UpdateDomainDataCaches(ctx, slot+1)
@@ -212,13 +233,9 @@ func waitForActivation(ctx context.Context, v iface.Validator) (types.Slot, erro
 		if err != nil {
 			log.Fatalf("Could not get current canonical head slot: %v", err)
 		}
-		err = v.CheckDoppelGanger(ctx)
-		if isConnectionError(err) {
-			log.Warnf("Could not wait for checking doppelganger: %v", err)
-			continue
-		}
+		err = v.StartDoppelGangerDetection(ctx)
 		if err != nil {
-			log.Fatalf("Could not succeed with doppelganger check: %v", err)
+			log.Fatalf("Could not start doppelganger detection: %v", err)
 		}
 		break
 	}
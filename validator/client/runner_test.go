@@ -247,6 +247,30 @@ func TestKeyReload_RemoteKeymanager(t *testing.T) {
 	assert.Equal(t, true, km.ReloadPublicKeysCalled)
 }
 
+func TestBeaconNodeFailover_UpdatesDutiesAndProposerSettings(t *testing.T) {
+	v := &testutil.FakeValidator{Km: &mockKeymanager{accountsChangedFeed: &event.Feed{}}}
+	ctx, cancel := context.WithCancel(context.Background())
+	slot := types.Slot(55)
+	ticker := make(chan types.Slot)
+	v.NextSlotRet = ticker
+	failovers := make(chan struct{})
+	v.BeaconNodeFailoversRet = failovers
+	go func() {
+		ticker <- slot
+		failovers <- struct{}{}
+
+		cancel()
+	}()
+
+	run(ctx, v)
+	// The proposer settings are pushed on start and once more after the failover.
+	assert.Equal(t, 2, v.PushProposerSettingsCalled)
+	// The duties are fetched again for the current slot.
+	assert.Equal(t, true, v.ResetDutiesCalled, "Expected ResetDuties() to be called")
+	assert.Equal(t, true, v.UpdateDutiesCalled, "Expected UpdateDuties() to be called")
+	assert.Equal(t, uint64(slot), v.UpdateDutiesArg1, "UpdateDuties was called with wrong argument")
+}
+
 func TestUpdateProposerSettingsAt_EpochStart(t *testing.T) {
 	v := &testutil.FakeValidator{Km: &mockKeymanager{accountsChangedFeed: &event.Feed{}}}
 	ctx, cancel := context.WithCancel(context.Background())
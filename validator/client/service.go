@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dgraph-io/ristretto"
@@ -14,13 +15,16 @@ import (
 	grpcutil "github.com/prysmaticlabs/prysm/api/grpc"
 	"github.com/prysmaticlabs/prysm/async/event"
 	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
+	"github.com/prysmaticlabs/prysm/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	validatorserviceconfig "github.com/prysmaticlabs/prysm/config/validator/service"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	beaconApi "github.com/prysmaticlabs/prysm/validator/client/beacon-api"
 	"github.com/prysmaticlabs/prysm/validator/client/iface"
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
@@ -45,6 +49,12 @@ type GenesisFetcher interface {
 	GenesisInfo(ctx context.Context) (*ethpb.Genesis, error)
 }
 
+// beaconNodeConn is the grpc connection of the validator client to its beacon nodes.
+type beaconNodeConn interface {
+	grpc.ClientConnInterface
+	Close() error
+}
+
 // ValidatorService represents a service to manage the validator client
 // routine.
 type ValidatorService struct {
@@ -52,8 +62,14 @@ type ValidatorService struct {
 	emitAccountMetrics    bool
 	logValidatorBalances  bool
 	logDutyCountDown      bool
+	broadcast             bool
 	interopKeysConfig     *local.InteropKeymanagerConfig
-	conn                  *grpc.ClientConn
+	conn                  beaconNodeConn
+	slashingProtection    *remoteSlashingProtection
+	validatorClient       iface.ValidatorClient
+	beaconClient          iface.BeaconChainClient
+	nodeClient            iface.NodeClient
+	livenessClient        iface.LivenessClient
 	grpcRetryDelay        time.Duration
 	grpcRetries           uint
 	maxCallRecvMsgSize    int
@@ -64,6 +80,8 @@ type ValidatorService struct {
 	dataDir               string
 	withCert              string
 	endpoint              string
+	beaconApiEndpoint     string
+	beaconApiTimeout      time.Duration
 	ctx                   context.Context
 	validator             iface.Validator
 	db                    db.Database
@@ -71,6 +89,11 @@ type ValidatorService struct {
 	graffiti              []byte
 	Web3SignerConfig      *remoteweb3signer.SetupConfig
 	ProposerSettings      *validatorserviceconfig.ProposerSettings
+
+	// loadedProposerSettings are the proposer settings loaded from the flags or from the proposer settings file or
+	// URL, before the settings edited through the keymanager API are applied.
+	loadedProposerSettings *validatorserviceconfig.ProposerSettings
+	proposerSettingsLock   sync.Mutex
 }
 
 // Config for the validator service.
@@ -79,6 +102,7 @@ type Config struct {
 	LogValidatorBalances       bool
 	EmitAccountMetrics         bool
 	LogDutyCountDown           bool
+	BroadcastToBeaconNodes     bool
 	InteropKeysConfig          *local.InteropKeymanagerConfig
 	Wallet                     *wallet.Wallet
 	WalletInitializedFeed      *event.Feed
@@ -93,6 +117,11 @@ type Config struct {
 	GrpcHeadersFlag            string
 	GraffitiFlag               string
 	Endpoint                   string
+	BeaconApiEndpoint          string
+	BeaconApiTimeout           time.Duration
+	SlashingProtectionEndpoint string
+	SlashingProtectionCert     string
+	SlashingProtectionHolder   string
 	Web3SignerConfig           *remoteweb3signer.SetupConfig
 	ProposerSettings           *validatorserviceconfig.ProposerSettings
 }
@@ -105,6 +134,8 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		ctx:                   ctx,
 		cancel:                cancel,
 		endpoint:              cfg.Endpoint,
+		beaconApiEndpoint:     cfg.BeaconApiEndpoint,
+		beaconApiTimeout:      cfg.BeaconApiTimeout,
 		withCert:              cfg.CertFlag,
 		dataDir:               cfg.DataDir,
 		graffiti:              []byte(cfg.GraffitiFlag),
@@ -122,10 +153,44 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		interopKeysConfig:     cfg.InteropKeysConfig,
 		graffitiStruct:        cfg.GraffitiStruct,
 		logDutyCountDown:      cfg.LogDutyCountDown,
+		broadcast:             cfg.BroadcastToBeaconNodes,
 		Web3SignerConfig:      cfg.Web3SignerConfig,
 		ProposerSettings:      cfg.ProposerSettings,
 	}
 
+	// Settings edited through the keymanager API take precedence over the loaded proposer settings.
+	s.loadedProposerSettings = cfg.ProposerSettings
+	if s.db != nil {
+		if err := s.refreshProposerSettings(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.SlashingProtectionEndpoint != "" {
+		slashingProtection, err := dialRemoteSlashingProtection(
+			ctx, cfg.SlashingProtectionEndpoint, cfg.SlashingProtectionCert, cfg.SlashingProtectionHolder,
+		)
+		if err != nil {
+			return nil, err
+		}
+		s.slashingProtection = slashingProtection
+	}
+
+	// When a Beacon API endpoint is provided, the validator talks to the beacon node
+	// over the standard REST API instead of the Prysm gRPC API.
+	if s.beaconApiEndpoint != "" {
+		s.validatorClient = beaconApi.NewBeaconApiValidatorClient(s.beaconApiEndpoint, s.beaconApiTimeout)
+		s.beaconClient = beaconApi.NewBeaconApiBeaconChainClient(s.beaconApiEndpoint, s.beaconApiTimeout)
+		s.nodeClient = beaconApi.NewBeaconApiNodeClient(s.beaconApiEndpoint, s.beaconApiTimeout)
+		s.livenessClient = beaconApi.NewBeaconApiLivenessClient(s.beaconApiEndpoint, s.beaconApiTimeout)
+		if s.logValidatorBalances {
+			log.Info("Validator balance logging is not supported over the Beacon API and will be disabled")
+			s.logValidatorBalances = false
+		}
+		log.WithField("endpoint", s.beaconApiEndpoint).Info("Using the Beacon API to communicate with the beacon node")
+		return s, nil
+	}
+
 	dialOpts := ConstructDialOptions(
 		s.maxCallRecvMsgSize,
 		s.withCert,
@@ -138,14 +203,34 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 
 	s.ctx = grpcutil.AppendHeaders(ctx, s.grpcHeaders)
 
-	conn, err := grpc.DialContext(ctx, s.endpoint, dialOpts...)
-	if err != nil {
-		return s, err
+	if endpoints := beaconNodeEndpoints(s.endpoint); len(endpoints) > 1 {
+		conn, err := dialBeaconNodes(ctx, endpoints, s.broadcast, dialOpts...)
+		if err != nil {
+			return s, err
+		}
+		go conn.start(s.ctx)
+		log.WithField("endpoints", endpoints).Info("Failing over between beacon nodes according to their health")
+		if s.broadcast {
+			log.Info("Broadcasting signed aggregates and sync committee messages to all healthy beacon nodes")
+		}
+		s.conn = conn
+	} else {
+		if s.broadcast {
+			log.Warn("Broadcasting to all beacon nodes has no effect with a single beacon node endpoint")
+		}
+		conn, err := grpc.DialContext(ctx, s.endpoint, dialOpts...)
+		if err != nil {
+			return s, err
+		}
+		s.conn = conn
 	}
 	if s.withCert != "" {
 		log.Info("Established secure gRPC connection")
 	}
-	s.conn = conn
+	s.validatorClient = ethpb.NewBeaconNodeValidatorClient(s.conn)
+	s.beaconClient = ethpb.NewBeaconChainClient(s.conn)
+	s.nodeClient = ethpb.NewNodeClient(s.conn)
+	s.livenessClient = ethpbservice.NewBeaconValidatorClient(s.conn)
 
 	return s, nil
 }
@@ -180,12 +265,22 @@ func (v *ValidatorService) Start() {
 		return
 	}
 
+	var slashingProtectionClient ethpb.SlasherClient
+	if v.conn != nil {
+		slashingProtectionClient = ethpb.NewSlasherClient(v.conn)
+	} else if features.Get().RemoteSlasherProtection {
+		log.Error("Remote slashing protection is not supported over the Beacon API")
+		return
+	}
+
 	valStruct := &validator{
 		db:                             v.db,
-		validatorClient:                ethpb.NewBeaconNodeValidatorClient(v.conn),
-		beaconClient:                   ethpb.NewBeaconChainClient(v.conn),
-		slashingProtectionClient:       ethpb.NewSlasherClient(v.conn),
-		node:                           ethpb.NewNodeClient(v.conn),
+		validatorClient:                v.validatorClient,
+		beaconClient:                   v.beaconClient,
+		slashingProtectionClient:       slashingProtectionClient,
+		remoteSlashingProtection:       v.slashingProtection,
+		node:                           v.nodeClient,
+		livenessClient:                 v.livenessClient,
 		graffiti:                       v.graffiti,
 		logValidatorBalances:           v.logValidatorBalances,
 		emitAccountMetrics:             v.emitAccountMetrics,
@@ -208,7 +303,6 @@ func (v *ValidatorService) Start() {
 		eipImportBlacklistedPublicKeys: slashablePublicKeys,
 		logDutyCountDown:               v.logDutyCountDown,
 		Web3SignerConfig:               v.Web3SignerConfig,
-		ProposerSettings:               v.ProposerSettings,
 		walletIntializedChannel:        make(chan *wallet.Wallet, 1),
 	}
 	// To resolve a race condition at startup due to the interface
@@ -221,14 +315,26 @@ func (v *ValidatorService) Start() {
 	sub.Unsubscribe()
 	close(tempChan)
 
+	if c, ok := v.conn.(*failoverConn); ok {
+		valStruct.beaconNodeFailovers = c.Failovers()
+	}
+
+	v.proposerSettingsLock.Lock()
+	valStruct.ProposerSettings = v.ProposerSettings
 	v.validator = valStruct
-	go run(v.ctx, v.validator)
+	v.proposerSettingsLock.Unlock()
+	go run(v.ctx, valStruct)
 }
 
 // Stop the validator service.
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
+	if v.slashingProtection != nil {
+		if err := v.slashingProtection.close(); err != nil {
+			log.WithError(err).Error("Could not close connection to remote slashing protection server")
+		}
+	}
 	if v.conn != nil {
 		return v.conn.Close()
 	}
@@ -237,7 +343,7 @@ func (v *ValidatorService) Stop() error {
 
 // Status of the validator service.
 func (v *ValidatorService) Status() error {
-	if v.conn == nil {
+	if v.conn == nil && v.beaconApiEndpoint == "" {
 		return errors.New("no connection to beacon RPC")
 	}
 	return nil
@@ -252,6 +358,70 @@ func (v *ValidatorService) Keymanager() (keymanager.IKeymanager, error) {
 	return v.validator.Keymanager()
 }
 
+// ProposerSettingsCopy returns a copy of the proposer settings in use, which is safe to read while the settings are
+// edited through the keymanager API.
+func (v *ValidatorService) ProposerSettingsCopy() *validatorserviceconfig.ProposerSettings {
+	v.proposerSettingsLock.Lock()
+	defer v.proposerSettingsLock.Unlock()
+	return v.ProposerSettings.Clone()
+}
+
+// setProposerSettings sets the proposer settings of the validator service and of the running validator. The caller
+// must hold proposerSettingsLock.
+func (v *ValidatorService) setProposerSettings(settings *validatorserviceconfig.ProposerSettings) {
+	v.ProposerSettings = settings
+	if v.validator != nil {
+		v.validator.SetProposerSettings(settings)
+	}
+}
+
+// UpdateProposerSettingsOverride applies update to the proposer settings of the public key edited through the
+// keymanager API, persists them, and updates the proposer settings in use accordingly.
+func (v *ValidatorService) UpdateProposerSettingsOverride(
+	ctx context.Context,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	update func(override *validatorserviceconfig.ProposerOptionOverride),
+) error {
+	if v.db == nil {
+		return errors.New("validator database is not available")
+	}
+	v.proposerSettingsLock.Lock()
+	defer v.proposerSettingsLock.Unlock()
+	override, err := v.db.ProposerSettingsOverride(ctx, pubKey)
+	if err != nil {
+		return errors.Wrap(err, "could not get proposer settings override")
+	}
+	update(override)
+	if err := v.db.SaveProposerSettingsOverride(ctx, pubKey, override); err != nil {
+		return errors.Wrap(err, "could not save proposer settings override")
+	}
+	return v.refreshProposerSettings(ctx)
+}
+
+// refreshProposerSettings applies the settings edited through the keymanager API to the loaded proposer settings,
+// and sets the result as the proposer settings in use. The caller must hold proposerSettingsLock.
+func (v *ValidatorService) refreshProposerSettings(ctx context.Context) error {
+	overrides, err := v.db.ProposerSettingsOverrides(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get proposer settings overrides")
+	}
+	v.setProposerSettings(validatorserviceconfig.WithOverrides(v.loadedProposerSettings, overrides))
+	return nil
+}
+
+// PushProposerSettings sends the proposer settings of the running validator to the beacon node, which registers
+// the validators with the builder again if their registration changed.
+func (v *ValidatorService) PushProposerSettings(ctx context.Context) error {
+	if v.validator == nil {
+		return errors.New("validator is not running")
+	}
+	km, err := v.validator.Keymanager()
+	if err != nil {
+		return err
+	}
+	return v.validator.PushProposerSettings(ctx, km)
+}
+
 // ConstructDialOptions constructs a list of grpc dial options
 func ConstructDialOptions(
 	maxCallRecvMsgSize int,
@@ -308,8 +478,7 @@ func ConstructDialOptions(
 
 // Syncing returns whether or not the beacon node is currently synchronizing the chain.
 func (v *ValidatorService) Syncing(ctx context.Context) (bool, error) {
-	nc := ethpb.NewNodeClient(v.conn)
-	resp, err := nc.GetSyncStatus(ctx, &emptypb.Empty{})
+	resp, err := v.nodeClient.GetSyncStatus(ctx, &emptypb.Empty{})
 	if err != nil {
 		return false, err
 	}
@@ -319,6 +488,5 @@ func (v *ValidatorService) Syncing(ctx context.Context) (bool, error) {
 // GenesisInfo queries the beacon node for the chain genesis info containing
 // the genesis time along with the validator deposit contract address.
 func (v *ValidatorService) GenesisInfo(ctx context.Context) (*ethpb.Genesis, error) {
-	nc := ethpb.NewNodeClient(v.conn)
-	return nc.GetGenesis(ctx, &emptypb.Empty{})
+	return v.nodeClient.GetGenesis(ctx, &emptypb.Empty{})
 }
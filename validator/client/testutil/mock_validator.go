@@ -6,6 +6,7 @@ import (
 	"time"
 
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	validatorserviceconfig "github.com/prysmaticlabs/prysm/config/validator/service"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	prysmTime "github.com/prysmaticlabs/prysm/time"
@@ -23,6 +24,8 @@ type FakeValidator struct {
 	SlasherReadyCalled                bool
 	NextSlotCalled                    bool
 	UpdateDutiesCalled                bool
+	ResetDutiesCalled                 bool
+	PushProposerSettingsCalled        int
 	UpdateProtectionsCalled           bool
 	RoleAtCalled                      bool
 	AttestToBlockHeadCalled           bool
@@ -43,9 +46,11 @@ type FakeValidator struct {
 	RoleAtArg1                        uint64
 	UpdateDutiesArg1                  uint64
 	NextSlotRet                       <-chan types.Slot
+	BeaconNodeFailoversRet            <-chan struct{}
 	PublicKey                         string
 	UpdateDutiesRet                   error
 	ProposerSettingsErr               error
+	ProposerSettings                  *validatorserviceconfig.ProposerSettings
 	RolesAtRet                        []iface.ValidatorRole
 	Balances                          map[[fieldparams.BLSPubkeyLength]byte]uint64
 	IndexToPubkeyMap                  map[uint64][fieldparams.BLSPubkeyLength]byte
@@ -127,6 +132,16 @@ func (fv *FakeValidator) NextSlot() <-chan types.Slot {
 	return fv.NextSlotRet
 }
 
+// BeaconNodeFailovers for mocking.
+func (fv *FakeValidator) BeaconNodeFailovers() <-chan struct{} {
+	return fv.BeaconNodeFailoversRet
+}
+
+// ResetDuties for mocking.
+func (fv *FakeValidator) ResetDuties() {
+	fv.ResetDutiesCalled = true
+}
+
 // UpdateDuties for mocking.
 func (fv *FakeValidator) UpdateDuties(_ context.Context, slot types.Slot) error {
 	fv.UpdateDutiesCalled = true
@@ -224,8 +239,13 @@ func (fv *FakeValidator) Keymanager() (keymanager.IKeymanager, error) {
 	return fv.Km, nil
 }
 
+// StartDoppelGangerDetection for mocking
+func (_ *FakeValidator) StartDoppelGangerDetection(_ context.Context) error {
+	return nil
+}
+
 // CheckDoppelGanger for mocking
-func (_ *FakeValidator) CheckDoppelGanger(_ context.Context) error {
+func (_ *FakeValidator) CheckDoppelGanger(_ context.Context, _ types.Slot) error {
 	return nil
 }
 
@@ -254,6 +274,7 @@ func (_ *FakeValidator) SubmitSignedContributionAndProof(_ context.Context, _ ty
 
 // PushProposerSettings for mocking
 func (fv *FakeValidator) PushProposerSettings(_ context.Context, _ keymanager.IKeymanager) error {
+	fv.PushProposerSettingsCalled++
 	if fv.ProposerSettingsErr != nil {
 		return fv.ProposerSettingsErr
 	}
@@ -261,6 +282,11 @@ func (fv *FakeValidator) PushProposerSettings(_ context.Context, _ keymanager.IK
 	return nil
 }
 
+// SetProposerSettings for mocking
+func (fv *FakeValidator) SetProposerSettings(settings *validatorserviceconfig.ProposerSettings) {
+	fv.ProposerSettings = settings
+}
+
 // SetPubKeyToValidatorIndexMap for mocking
 func (_ *FakeValidator) SetPubKeyToValidatorIndexMap(_ context.Context, _ keymanager.IKeymanager) error {
 	return nil
@@ -22,7 +22,6 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/async/event"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
-	"github.com/prysmaticlabs/prysm/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	validatorserviceconfig "github.com/prysmaticlabs/prysm/config/validator/service"
@@ -37,7 +36,6 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/validator/client/iface"
 	vdb "github.com/prysmaticlabs/prysm/validator/db"
-	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/local"
@@ -71,6 +69,12 @@ type validator struct {
 	highestValidSlotLock               sync.Mutex
 	prevBalanceLock                    sync.RWMutex
 	slashableKeysLock                  sync.RWMutex
+	doppelGangerLock                   sync.RWMutex
+	proposerSettingsLock               sync.RWMutex
+	doppelGangerKeys                   map[[fieldparams.BLSPubkeyLength]byte]types.Epoch
+	doppelGangerLoadedKeys             map[[fieldparams.BLSPubkeyLength]byte]bool
+	doppelGangerLivenessFailing        bool
+	doppelGangerLivenessFailingSince   types.Epoch
 	eipImportBlacklistedPublicKeys     map[[fieldparams.BLSPubkeyLength]byte]bool
 	walletInitializedFeed              *event.Feed
 	attLogs                            map[[32]byte]*attSubmitted
@@ -88,19 +92,22 @@ type validator struct {
 	interopKeysConfig                  *local.InteropKeymanagerConfig
 	wallet                             *wallet.Wallet
 	graffitiStruct                     *graffiti.Graffiti
-	node                               ethpb.NodeClient
+	node                               iface.NodeClient
 	slashingProtectionClient           ethpb.SlasherClient
+	remoteSlashingProtection           *remoteSlashingProtection
 	db                                 vdb.Database
-	beaconClient                       ethpb.BeaconChainClient
+	beaconClient                       iface.BeaconChainClient
 	keyManager                         keymanager.IKeymanager
 	ticker                             slots.Ticker
-	validatorClient                    ethpb.BeaconNodeValidatorClient
+	validatorClient                    iface.ValidatorClient
+	livenessClient                     iface.LivenessClient
 	graffiti                           []byte
 	voteStats                          voteStats
 	syncCommitteeStats                 syncCommitteeStats
 	Web3SignerConfig                   *remoteweb3signer.SetupConfig
 	ProposerSettings                   *validatorserviceconfig.ProposerSettings
 	walletIntializedChannel            chan *wallet.Wallet
+	beaconNodeFailovers                <-chan struct{}
 }
 
 type validatorStatus struct {
@@ -128,14 +135,14 @@ func (v *validator) WaitForKeymanagerInitialization(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
-		v.keyManager = km
+		v.setKeymanager(km)
 	} else {
 		if v.interopKeysConfig != nil {
 			keyManager, err := local.NewInteropKeymanager(ctx, v.interopKeysConfig.Offset, v.interopKeysConfig.NumValidatorKeys)
 			if err != nil {
 				return errors.Wrap(err, "could not generate interop keys for key manager")
 			}
-			v.keyManager = keyManager
+			v.setKeymanager(keyManager)
 		} else if v.wallet == nil {
 			return errors.New("wallet not set")
 		} else {
@@ -146,13 +153,22 @@ func (v *validator) WaitForKeymanagerInitialization(ctx context.Context) error {
 			if err != nil {
 				return errors.Wrap(err, "could not initialize key manager")
 			}
-			v.keyManager = keyManager
+			v.setKeymanager(keyManager)
 		}
 	}
 	recheckKeys(ctx, v.db, v.keyManager)
 	return nil
 }
 
+// setKeymanager replaces the keymanager of the validator. A replaced web3signer keymanager is stopped, so that it
+// no longer refreshes its public keys.
+func (v *validator) setKeymanager(km keymanager.IKeymanager) {
+	if previous, ok := v.keyManager.(*remoteweb3signer.Keymanager); ok && previous != km {
+		previous.Stop()
+	}
+	v.keyManager = km
+}
+
 // subscribe to channel for when the wallet is initialized
 func waitForWebWalletInitialization(
 	ctx context.Context,
@@ -449,104 +465,22 @@ func (v *validator) NextSlot() <-chan types.Slot {
 	return v.ticker.C()
 }
 
-// SlotDeadline is the start time of the next slot.
-func (v *validator) SlotDeadline(slot types.Slot) time.Time {
-	secs := time.Duration((slot + 1).Mul(params.BeaconConfig().SecondsPerSlot))
-	return time.Unix(int64(v.genesisTime), 0 /*ns*/).Add(secs * time.Second)
-}
-
-// CheckDoppelGanger checks if the current actively provided keys have
-// any duplicates active in the network.
-func (v *validator) CheckDoppelGanger(ctx context.Context) error {
-	if !features.Get().EnableDoppelGanger {
-		return nil
-	}
-	pubkeys, err := v.keyManager.FetchValidatingPublicKeys(ctx)
-	if err != nil {
-		return err
-	}
-	log.WithField("keys", len(pubkeys)).Info("Running doppelganger check")
-	// Exit early if no validating pub keys are found.
-	if len(pubkeys) == 0 {
-		return nil
-	}
-	req := &ethpb.DoppelGangerRequest{ValidatorRequests: []*ethpb.DoppelGangerRequest_ValidatorRequest{}}
-	for _, pkey := range pubkeys {
-		copiedKey := pkey
-		attRec, err := v.db.AttestationHistoryForPubKey(ctx, copiedKey)
-		if err != nil {
-			return err
-		}
-		if len(attRec) == 0 {
-			// If no history exists we simply send in a zero
-			// value for the request epoch and root.
-			req.ValidatorRequests = append(req.ValidatorRequests,
-				&ethpb.DoppelGangerRequest_ValidatorRequest{
-					PublicKey:  copiedKey[:],
-					Epoch:      0,
-					SignedRoot: make([]byte, fieldparams.RootLength),
-				})
-			continue
-		}
-		r := retrieveLatestRecord(attRec)
-		if copiedKey != r.PubKey {
-			return errors.New("attestation record mismatched public key")
-		}
-		req.ValidatorRequests = append(req.ValidatorRequests,
-			&ethpb.DoppelGangerRequest_ValidatorRequest{
-				PublicKey:  r.PubKey[:],
-				Epoch:      r.Target,
-				SignedRoot: r.SigningRoot[:],
-			})
-	}
-	resp, err := v.validatorClient.CheckDoppelGanger(ctx, req)
-	if err != nil {
-		return err
-	}
-	// If nothing is returned by the beacon node, we return an
-	// error as it is unsafe for us to proceed.
-	if resp == nil || resp.Responses == nil || len(resp.Responses) == 0 {
-		return errors.New("beacon node returned 0 responses for doppelganger check")
-	}
-	return buildDuplicateError(resp.Responses)
+// BeaconNodeFailovers is signaled after the validator client failed over to another beacon node. It is never
+// signaled with a single beacon node.
+func (v *validator) BeaconNodeFailovers() <-chan struct{} {
+	return v.beaconNodeFailovers
 }
 
-func buildDuplicateError(response []*ethpb.DoppelGangerResponse_ValidatorResponse) error {
-	duplicates := make([][]byte, 0)
-	for _, valRes := range response {
-		if valRes.DuplicateExists {
-			copiedKey := [fieldparams.BLSPubkeyLength]byte{}
-			copy(copiedKey[:], valRes.PublicKey)
-			duplicates = append(duplicates, copiedKey[:])
-		}
-	}
-	if len(duplicates) == 0 {
-		return nil
-	}
-	return errors.Errorf("Duplicate instances exists in the network for validator keys: %#x", duplicates)
+// ResetDuties clears the duties of the validators, so that the next call to UpdateDuties fetches them again and
+// subscribes to their subnets.
+func (v *validator) ResetDuties() {
+	v.duties = nil
 }
 
-// Ensures that the latest attestation history is retrieved.
-func retrieveLatestRecord(recs []*kv.AttestationRecord) *kv.AttestationRecord {
-	if len(recs) == 0 {
-		return nil
-	}
-	lastSource := recs[len(recs)-1].Source
-	chosenRec := recs[len(recs)-1]
-	for i := len(recs) - 1; i >= 0; i-- {
-		// Exit if we are now on a different source
-		// as it is assumed that all source records are
-		// byte sorted.
-		if recs[i].Source != lastSource {
-			break
-		}
-		// If we have a smaller target, we do
-		// change our chosen record.
-		if chosenRec.Target < recs[i].Target {
-			chosenRec = recs[i]
-		}
-	}
-	return chosenRec
+// SlotDeadline is the start time of the next slot.
+func (v *validator) SlotDeadline(slot types.Slot) time.Time {
+	secs := time.Duration((slot + 1).Mul(params.BeaconConfig().SecondsPerSlot))
+	return time.Unix(int64(v.genesisTime), 0 /*ns*/).Add(secs * time.Second)
 }
 
 // UpdateDuties checks the slot number to determine if the validator's
@@ -690,6 +624,10 @@ func (v *validator) RolesAt(ctx context.Context, slot types.Slot) (map[[fieldpar
 		if duty == nil {
 			continue
 		}
+		// Keys under doppelganger detection must stay silent on the network.
+		if v.isUnderDoppelGangerDetection(bytesutil.ToBytes48(duty.PublicKey)) {
+			continue
+		}
 		if len(duty.ProposerSlots) > 0 {
 			for _, proposerSlot := range duty.ProposerSlots {
 				if proposerSlot != 0 && proposerSlot == slot {
@@ -946,6 +884,8 @@ func (v *validator) logDuties(slot types.Slot, duties []*ethpb.DutiesResponse_Du
 
 // PushProposerSettings calls the prepareBeaconProposer RPC to set the fee recipient and also the register validator API if using a custom builder.
 func (v *validator) PushProposerSettings(ctx context.Context, km keymanager.IKeymanager) error {
+	v.proposerSettingsLock.RLock()
+	defer v.proposerSettingsLock.RUnlock()
 	// only used after Bellatrix
 	if v.ProposerSettings == nil {
 		e := params.BeaconConfig().BellatrixForkEpoch
@@ -988,13 +928,21 @@ func (v *validator) PushProposerSettings(ctx context.Context, km keymanager.IKey
 	}
 	log.Infoln("Prepared beacon proposer with fee recipient to validator index mapping")
 
-	if err := SubmitValidatorRegistrations(ctx, v.validatorClient, signedRegisterValidatorRequests); err != nil {
+	if err := submitValidatorRegistrationsToRelays(ctx, v.validatorClient, signedRegisterValidatorRequests, v.ProposerSettings.Relays); err != nil {
 		return errors.Wrap(ErrBuilderValidatorRegistration, err.Error())
 	}
 
 	return nil
 }
 
+// SetProposerSettings sets the proposer settings used to prepare the beacon node for proposals and to register
+// the validators with the builder.
+func (v *validator) SetProposerSettings(settings *validatorserviceconfig.ProposerSettings) {
+	v.proposerSettingsLock.Lock()
+	defer v.proposerSettingsLock.Unlock()
+	v.ProposerSettings = settings
+}
+
 func (v *validator) buildProposerSettingsRequests(ctx context.Context, pubkeys [][fieldparams.BLSPubkeyLength]byte, signer iface.SigningFunc) ([]*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer, []*ethpb.SignedValidatorRegistrationV1, error) {
 	var proposerFeeRecipientRequests []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer
 	var signedRegisterValidatorRequests []*ethpb.SignedValidatorRegistrationV1
@@ -1257,7 +1257,8 @@ func TestValidator_CheckDoppelGanger(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			v := tt.validatorSetter(t)
-			if err := v.CheckDoppelGanger(context.Background()); tt.err != "" {
+			require.NoError(t, v.StartDoppelGangerDetection(context.Background()))
+			if err := v.CheckDoppelGanger(context.Background(), 0); tt.err != "" {
 				assert.ErrorContains(t, tt.err, err)
 			}
 		})
@@ -4,8 +4,10 @@ package iface
 import (
 	"context"
 	"io"
+	"time"
 
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	validatorServiceConfig "github.com/prysmaticlabs/prysm/config/validator/service"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/monitoring/backup"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -62,4 +64,19 @@ type ValidatorDB interface {
 	// Graffiti ordered index related methods
 	SaveGraffitiOrderedIndex(ctx context.Context, index uint64) error
 	GraffitiOrderedIndex(ctx context.Context, fileHash [32]byte) (uint64, error)
+
+	// Proposer settings related methods.
+	SaveProposerSettingsOverride(
+		ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, override *validatorServiceConfig.ProposerOptionOverride,
+	) error
+	ProposerSettingsOverride(
+		ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte,
+	) (*validatorServiceConfig.ProposerOptionOverride, error)
+	ProposerSettingsOverrides(
+		ctx context.Context,
+	) (map[[fieldparams.BLSPubkeyLength]byte]*validatorServiceConfig.ProposerOptionOverride, error)
+
+	// Slashing protection server lease related methods.
+	SaveLease(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, holder string, expiry time.Time) error
+	Lease(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (string, time.Time, error)
 }
@@ -151,6 +151,8 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			pubKeysBucket,
 			migrationsBucket,
 			graffitiBucket,
+			proposerSettingsOverridesBucket,
+			leasesBucket,
 		)
 	}); err != nil {
 		return nil, err
@@ -0,0 +1,47 @@
+package kv
+
+import (
+	"context"
+	"time"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveLease persists the holder of the lease of a validator public key leased by the slashing protection server,
+// and the expiry of the lease. A lease without holder is removed from the database.
+func (s *Store) SaveLease(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, holder string, expiry time.Time,
+) error {
+	_, span := trace.StartSpan(ctx, "Validator.SaveLease")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(leasesBucket)
+		if holder == "" {
+			return bkt.Delete(pubKey[:])
+		}
+		enc := append(bytesutil.Uint64ToBytesBigEndian(uint64(expiry.UnixNano())), holder...)
+		return bkt.Put(pubKey[:], enc)
+	})
+}
+
+// Lease returns the holder of the lease of a validator public key and the expiry of the lease. An empty holder is
+// returned if the public key is not leased.
+func (s *Store) Lease(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (string, time.Time, error) {
+	_, span := trace.StartSpan(ctx, "Validator.Lease")
+	defer span.End()
+	var holder string
+	var expiry time.Time
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(leasesBucket).Get(pubKey[:])
+		if len(enc) < 8 {
+			return nil
+		}
+		expiry = time.Unix(0, int64(bytesutil.BytesToUint64BigEndian(enc[:8])))
+		holder = string(enc[8:])
+		return nil
+	})
+	return holder, expiry, err
+}
@@ -0,0 +1,34 @@
+package kv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStore_Leases(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{})
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+
+	holder, _, err := db.Lease(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, "", holder)
+
+	expiry := time.Unix(0, 1656000000123456789)
+	require.NoError(t, db.SaveLease(ctx, pubKey, "validator-a", expiry))
+	holder, savedExpiry, err := db.Lease(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, "validator-a", holder)
+	assert.Equal(t, true, expiry.Equal(savedExpiry))
+
+	// Saving a lease without holder removes it.
+	require.NoError(t, db.SaveLease(ctx, pubKey, "", time.Time{}))
+	holder, _, err = db.Lease(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, "", holder)
+}
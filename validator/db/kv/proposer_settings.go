@@ -0,0 +1,73 @@
+package kv
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	validatorServiceConfig "github.com/prysmaticlabs/prysm/config/validator/service"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveProposerSettingsOverride persists the proposer settings of a validator public key edited through the
+// keymanager API. An empty override is removed from the database.
+func (s *Store) SaveProposerSettingsOverride(
+	ctx context.Context,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	override *validatorServiceConfig.ProposerOptionOverride,
+) error {
+	_, span := trace.StartSpan(ctx, "Validator.SaveProposerSettingsOverride")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(proposerSettingsOverridesBucket)
+		if override.IsEmpty() {
+			return bkt.Delete(pubKey[:])
+		}
+		enc, err := json.Marshal(override)
+		if err != nil {
+			return errors.Wrap(err, "could not marshal proposer settings override")
+		}
+		return bkt.Put(pubKey[:], enc)
+	})
+}
+
+// ProposerSettingsOverride returns the proposer settings of a validator public key edited through the keymanager
+// API. An empty override is returned if the settings of the key were not edited.
+func (s *Store) ProposerSettingsOverride(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte,
+) (*validatorServiceConfig.ProposerOptionOverride, error) {
+	_, span := trace.StartSpan(ctx, "Validator.ProposerSettingsOverride")
+	defer span.End()
+	override := &validatorServiceConfig.ProposerOptionOverride{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(proposerSettingsOverridesBucket).Get(pubKey[:])
+		if enc == nil {
+			return nil
+		}
+		return json.Unmarshal(enc, override)
+	})
+	return override, err
+}
+
+// ProposerSettingsOverrides returns the proposer settings edited through the keymanager API, by validator public key.
+func (s *Store) ProposerSettingsOverrides(
+	ctx context.Context,
+) (map[[fieldparams.BLSPubkeyLength]byte]*validatorServiceConfig.ProposerOptionOverride, error) {
+	_, span := trace.StartSpan(ctx, "Validator.ProposerSettingsOverrides")
+	defer span.End()
+	overrides := make(map[[fieldparams.BLSPubkeyLength]byte]*validatorServiceConfig.ProposerOptionOverride)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(proposerSettingsOverridesBucket).ForEach(func(k, v []byte) error {
+			override := &validatorServiceConfig.ProposerOptionOverride{}
+			if err := json.Unmarshal(v, override); err != nil {
+				return errors.Wrapf(err, "could not unmarshal proposer settings override of %#x", k)
+			}
+			overrides[bytesutil.ToBytes48(k)] = override
+			return nil
+		})
+	})
+	return overrides, err
+}
@@ -0,0 +1,49 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	validatorServiceConfig "github.com/prysmaticlabs/prysm/config/validator/service"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStore_ProposerSettingsOverrides(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{})
+	pubKey1 := [fieldparams.BLSPubkeyLength]byte{1}
+	pubKey2 := [fieldparams.BLSPubkeyLength]byte{2}
+	feeRecipient := common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9")
+	gasLimit := uint64(35000000)
+
+	overrides, err := db.ProposerSettingsOverrides(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(overrides))
+	override, err := db.ProposerSettingsOverride(ctx, pubKey1)
+	require.NoError(t, err)
+	require.Equal(t, true, override.IsEmpty())
+
+	override1 := &validatorServiceConfig.ProposerOptionOverride{FeeRecipient: &feeRecipient, GasLimit: &gasLimit}
+	override2 := &validatorServiceConfig.ProposerOptionOverride{GasLimit: &gasLimit}
+	require.NoError(t, db.SaveProposerSettingsOverride(ctx, pubKey1, override1))
+	require.NoError(t, db.SaveProposerSettingsOverride(ctx, pubKey2, override2))
+	override, err = db.ProposerSettingsOverride(ctx, pubKey1)
+	require.NoError(t, err)
+	require.DeepEqual(t, override1, override)
+	overrides, err = db.ProposerSettingsOverrides(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, map[[fieldparams.BLSPubkeyLength]byte]*validatorServiceConfig.ProposerOptionOverride{
+		pubKey1: override1,
+		pubKey2: override2,
+	}, overrides)
+
+	// Saving an empty override removes it.
+	require.NoError(t, db.SaveProposerSettingsOverride(ctx, pubKey1, &validatorServiceConfig.ProposerOptionOverride{}))
+	overrides, err = db.ProposerSettingsOverrides(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, map[[fieldparams.BLSPubkeyLength]byte]*validatorServiceConfig.ProposerOptionOverride{
+		pubKey2: override2,
+	}, overrides)
+}
@@ -34,6 +34,12 @@ var (
 	// Graffiti
 	graffitiBucket = []byte("graffiti")
 
+	// Proposer settings edited through the keymanager API, by validator public key.
+	proposerSettingsOverridesBucket = []byte("proposer-settings-overrides-bucket")
+
+	// Public key leases of the slashing protection server, by validator public key.
+	leasesBucket = []byte("leases-bucket")
+
 	// Graffiti ordered index and hash keys
 	graffitiOrderedIndexKey = []byte("graffiti-ordered-index")
 	graffitiFileHashKey     = []byte("graffiti-file-hash")
@@ -3,9 +3,11 @@ package internal
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
@@ -25,6 +27,10 @@ import (
 
 const (
 	ethApiNamespace = "/api/v1/eth2/sign/"
+	// The connections to the web3signer are kept open and reused, enough of them to sign for
+	// many validators concurrently at the start of a slot without opening new connections.
+	maxIdleConnsPerHost = 64
+	idleConnTimeout     = 90 * time.Second
 )
 
 type SignRequestJson []byte
@@ -46,8 +52,32 @@ type ApiClient struct {
 	RestClient *http.Client
 }
 
-// NewApiClient method instantiates a new ApiClient object.
-func NewApiClient(baseEndpoint string) (*ApiClient, error) {
+// ApiClientOpt is a functional option for the ApiClient.
+type ApiClientOpt func(*apiClientOpts)
+
+type apiClientOpts struct {
+	tlsConfig      *tls.Config
+	requestTimeout time.Duration
+}
+
+// WithTLSConfig sets the TLS configuration of the connections to the web3signer, such as the client
+// certificate for mutual TLS and the CA certificate of the web3signer.
+func WithTLSConfig(tlsConfig *tls.Config) ApiClientOpt {
+	return func(opts *apiClientOpts) {
+		opts.tlsConfig = tlsConfig
+	}
+}
+
+// WithRequestTimeout bounds the time spent on each request to the web3signer, including reading its response.
+func WithRequestTimeout(timeout time.Duration) ApiClientOpt {
+	return func(opts *apiClientOpts) {
+		opts.requestTimeout = timeout
+	}
+}
+
+// NewApiClient method instantiates a new ApiClient object. Its requests share a pool of persistent connections to
+// the web3signer, using HTTP/2 when the web3signer supports it over TLS.
+func NewApiClient(baseEndpoint string, opts ...ApiClientOpt) (*ApiClient, error) {
 	u, err := url.ParseRequestURI(baseEndpoint)
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid format, unable to parse url")
@@ -55,9 +85,32 @@ func NewApiClient(baseEndpoint string) (*ApiClient, error) {
 	if u.Scheme == "" || u.Host == "" {
 		return nil, fmt.Errorf("web3signer url must be in the format of http(s)://host:port url used: %v", baseEndpoint)
 	}
+	o := &apiClientOpts{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.tlsConfig != nil && u.Scheme != "https" {
+		return nil, fmt.Errorf("web3signer url must use https when a TLS configuration is provided, url used: %v", baseEndpoint)
+	}
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSClientConfig:     o.tlsConfig,
+		ForceAttemptHTTP2:   true,
+		MaxIdleConns:        maxIdleConnsPerHost,
+		MaxIdleConnsPerHost: maxIdleConnsPerHost,
+		IdleConnTimeout:     idleConnTimeout,
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
 	return &ApiClient{
-		BaseURL:    u,
-		RestClient: &http.Client{},
+		BaseURL: u,
+		RestClient: &http.Client{
+			Transport: transport,
+			Timeout:   o.requestTimeout,
+		},
 	}, nil
 }
 
@@ -155,7 +208,13 @@ func (client *ApiClient) doRequest(ctx context.Context, httpMethod, fullPath str
 	resp, err := client.RestClient.Do(req)
 	duration := time.Since(start)
 	if err != nil {
-		signRequestDurationSeconds.WithLabelValues(req.Method, "error").Observe(duration.Seconds())
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			signRequestDurationSeconds.WithLabelValues(req.Method, "timeout").Observe(duration.Seconds())
+			requestTimeoutsTotal.WithLabelValues(req.Method).Inc()
+		} else {
+			signRequestDurationSeconds.WithLabelValues(req.Method, "error").Observe(duration.Seconds())
+		}
 		err = errors.Wrap(err, "failed to execute json request")
 		tracing.AnnotateError(span, err)
 		return resp, err
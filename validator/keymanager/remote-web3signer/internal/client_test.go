@@ -3,12 +3,15 @@ package internal_test
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/prysm/testing/require"
@@ -32,6 +35,32 @@ func TestNewApiClient(t *testing.T) {
 	assert.NotNil(t, apiClient)
 }
 
+func TestNewApiClient_TLSRequiresHTTPS(t *testing.T) {
+	_, err := internal.NewApiClient("http://localhost:8545", internal.WithTLSConfig(&tls.Config{MinVersion: tls.VersionTLS13}))
+	require.ErrorContains(t, "https", err)
+	apiClient, err := internal.NewApiClient("https://localhost:8545", internal.WithTLSConfig(&tls.Config{MinVersion: tls.VersionTLS13}))
+	assert.NoError(t, err)
+	assert.NotNil(t, apiClient)
+}
+
+func TestClient_Sign_Timeout(t *testing.T) {
+	done := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	defer close(done)
+	cl, err := internal.NewApiClient(srv.URL, internal.WithRequestTimeout(50*time.Millisecond))
+	require.NoError(t, err)
+	jsonRequest, err := json.Marshal(`{message: "hello"}`)
+	require.NoError(t, err)
+	_, err = cl.Sign(context.Background(), "a2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820", jsonRequest)
+	require.ErrorContains(t, "Client.Timeout exceeded", err)
+}
+
 func TestClient_Sign_HappyPath(t *testing.T) {
 	jsonSig := `0xb3baa751d0a9132cfe93e4e3d5ff9075111100e3789dca219ade5a24d27e19d16b3353149da1833e9b691bb38634e8dc04469be7032132906c927d7e1a49b414730612877bc6b2810c8f202daf793d1ab0d6b5cb21d52f9e52e883859887a5d9`
 	// create a new reader with that JSON
@@ -14,4 +14,11 @@ var (
 		},
 		[]string{"method", "status_code"},
 	)
+	requestTimeoutsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "remote_web3signer_internal_client_request_timeouts_total",
+			Help: "Total number of client HTTP requests which timed out",
+		},
+		[]string{"method"},
+	)
 )
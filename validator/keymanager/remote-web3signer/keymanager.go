@@ -3,9 +3,14 @@ package remote_web3signer
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
+	"os"
 	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/go-playground/validator/v10"
@@ -40,6 +45,19 @@ type SetupConfig struct {
 	// a static list of public keys to be passed by the user to determine what accounts should sign.
 	// This will provide a layer of safety against slashing if the web3signer is shared across validators.
 	ProvidedPublicKeys [][48]byte
+
+	// If set along with the URL, the public keys are fetched again from the URL at this interval, and the
+	// public keys added to or removed from the web3signer are propagated to the subscribers of account changes.
+	PublicKeysRefreshInterval time.Duration
+
+	// Optional mutual TLS configuration. The client certificate and key authenticate the validator client
+	// to the web3signer, and the CA certificate authenticates the web3signer.
+	ClientCertPath string
+	ClientKeyPath  string
+	CACertPath     string
+
+	// Optional timeout of each request to the web3signer.
+	RequestTimeout time.Duration
 }
 
 // Keymanager defines the web3signer keymanager.
@@ -51,18 +69,32 @@ type Keymanager struct {
 	accountsChangedFeed   *event.Feed
 	validator             *validator.Validate
 	publicKeysUrlCalled   bool
+	// deletedPublicKeys are the public keys deleted through the keymanager API, which are not used again when
+	// the public keys are fetched from the remote server url, unless they are added back through the API.
+	deletedPublicKeys map[[fieldparams.BLSPubkeyLength]byte]bool
+	// cancel stops refreshing the public keys.
+	cancel context.CancelFunc
+	lock   sync.RWMutex
 }
 
 // NewKeymanager instantiates a new web3signer key manager.
-func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 	if cfg.BaseEndpoint == "" || !bytesutil.IsValidRoot(cfg.GenesisValidatorsRoot) {
 		return nil, fmt.Errorf("invalid setup config, one or more configs are empty: BaseEndpoint: %v, GenesisValidatorsRoot: %#x", cfg.BaseEndpoint, cfg.GenesisValidatorsRoot)
 	}
-	client, err := internal.NewApiClient(cfg.BaseEndpoint)
+	opts := []internal.ApiClientOpt{internal.WithRequestTimeout(cfg.RequestTimeout)}
+	tlsConfig, err := loadTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		opts = append(opts, internal.WithTLSConfig(tlsConfig))
+	}
+	client, err := internal.NewApiClient(cfg.BaseEndpoint, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create apiClient")
 	}
-	return &Keymanager{
+	km := &Keymanager{
 		client:                internal.HttpSignerClient(client),
 		genesisValidatorsRoot: cfg.GenesisValidatorsRoot,
 		accountsChangedFeed:   new(event.Feed),
@@ -70,13 +102,59 @@ func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
 		providedPublicKeys:    cfg.ProvidedPublicKeys,
 		validator:             validator.New(),
 		publicKeysUrlCalled:   false,
-	}, nil
+		deletedPublicKeys:     make(map[[fieldparams.BLSPubkeyLength]byte]bool),
+	}
+	if cfg.PublicKeysURL != "" && cfg.PublicKeysRefreshInterval > 0 {
+		ctx, km.cancel = context.WithCancel(ctx)
+		go km.refreshPublicKeys(ctx, cfg.PublicKeysRefreshInterval)
+	}
+	return km, nil
+}
+
+// Stop stops refreshing the public keys from the remote server url. It must be called once the keymanager is no
+// longer used.
+func (km *Keymanager) Stop() {
+	if km.cancel != nil {
+		km.cancel()
+	}
+}
+
+// loadTLSConfig loads the TLS configuration of the connections to the web3signer, if any is set.
+func loadTLSConfig(cfg *SetupConfig) (*tls.Config, error) {
+	if cfg.ClientCertPath == "" && cfg.ClientKeyPath == "" && cfg.CACertPath == "" {
+		return nil, nil
+	}
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS13}
+	if cfg.ClientCertPath != "" || cfg.ClientKeyPath != "" {
+		if cfg.ClientCertPath == "" || cfg.ClientKeyPath == "" {
+			return nil, errors.New("both a client certificate and a client key are required for mutual TLS")
+		}
+		clientPair, err := tls.LoadX509KeyPair(cfg.ClientCertPath, cfg.ClientKeyPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain client's certificate and/or key")
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientPair}
+	}
+	if cfg.CACertPath != "" {
+		serverCA, err := os.ReadFile(cfg.CACertPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain web3signer's CA certificate")
+		}
+		cp := x509.NewCertPool()
+		if !cp.AppendCertsFromPEM(serverCA) {
+			return nil, errors.New("failed to add web3signer's CA certificate to pool")
+		}
+		tlsConfig.RootCAs = cp
+	}
+	return tlsConfig, nil
 }
 
 // FetchValidatingPublicKeys fetches the validating public keys
 // from the remote server or from the provided keys if there are no existing public keys set
 // or provides the existing keys in the keymanager.
 func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	km.lock.Lock()
+	defer km.lock.Unlock()
 	if km.publicKeysURL != "" && !km.publicKeysUrlCalled {
 		providedPublicKeys, err := km.client.GetPublicKeys(ctx, km.publicKeysURL)
 		if err != nil {
@@ -85,18 +163,93 @@ func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldpa
 		}
 		// makes sure that if the public keys are deleted the validator does not call URL again.
 		km.publicKeysUrlCalled = true
-		km.providedPublicKeys = providedPublicKeys
+		km.providedPublicKeys = km.withoutDeletedPublicKeys(providedPublicKeys)
 	}
-	return km.providedPublicKeys, nil
+	return km.copyProvidedPublicKeys(), nil
 }
 
-// Sign signs the message by using a remote web3signer server.
+// refreshPublicKeys fetches the public keys from the remote server url at every interval, and notifies the
+// subscribers of account changes when public keys were added or removed, until the context is canceled.
+// The fetched public keys replace the existing ones, except for the public keys deleted through the keymanager
+// API, which are never used again unless they are added back through the API.
+func (km *Keymanager) refreshPublicKeys(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			fetchedPublicKeys, err := km.client.GetPublicKeys(ctx, km.publicKeysURL)
+			if err != nil {
+				erroredResponsesTotal.Inc()
+				log.WithError(err).WithField("url", km.publicKeysURL).Error("Could not refresh public keys from remote server url")
+				continue
+			}
+			km.lock.Lock()
+			fetchedPublicKeys = km.withoutDeletedPublicKeys(fetchedPublicKeys)
+			added, removed := diffPublicKeys(km.providedPublicKeys, fetchedPublicKeys)
+			km.publicKeysUrlCalled = true
+			km.providedPublicKeys = fetchedPublicKeys
+			providedPublicKeys := km.copyProvidedPublicKeys()
+			km.lock.Unlock()
+			if added == 0 && removed == 0 {
+				continue
+			}
+			log.WithFields(log.Fields{
+				"added":   added,
+				"removed": removed,
+			}).Info("Public keys of web3signer changed")
+			km.accountsChangedFeed.Send(providedPublicKeys)
+		}
+	}
+}
+
+// withoutDeletedPublicKeys returns the public keys which were not deleted through the keymanager API. The caller
+// must hold the lock.
+func (km *Keymanager) withoutDeletedPublicKeys(pubKeys [][fieldparams.BLSPubkeyLength]byte) [][fieldparams.BLSPubkeyLength]byte {
+	kept := make([][fieldparams.BLSPubkeyLength]byte, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		if !km.deletedPublicKeys[pubKey] {
+			kept = append(kept, pubKey)
+		}
+	}
+	return kept
+}
+
+// diffPublicKeys returns the number of public keys added and removed between two lists of public keys.
+func diffPublicKeys(previous, current [][fieldparams.BLSPubkeyLength]byte) (added, removed int) {
+	previousSet := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(previous))
+	for _, pubKey := range previous {
+		previousSet[pubKey] = true
+	}
+	currentSet := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(current))
+	for _, pubKey := range current {
+		currentSet[pubKey] = true
+		if !previousSet[pubKey] {
+			added++
+		}
+	}
+	for pubKey := range previousSet {
+		if !currentSet[pubKey] {
+			removed++
+		}
+	}
+	return added, removed
+}
+
+// Sign signs the message by using a remote web3signer server. The web3signer API has no batch signing endpoint,
+// so every message is signed by its own request, and concurrent requests share the pooled connections.
 func (km *Keymanager) Sign(ctx context.Context, request *validatorpb.SignRequest) (bls.Signature, error) {
 	signRequest, err := getSignRequestJson(ctx, km.validator, request, km.genesisValidatorsRoot)
 	if err != nil {
 		erroredResponsesTotal.Inc()
 		return nil, err
 	}
+	if err := verifySigningRoot(request); err != nil {
+		erroredResponsesTotal.Inc()
+		return nil, err
+	}
 
 	signRequestsTotal.Inc()
 
@@ -302,6 +455,7 @@ func (km *Keymanager) AddPublicKeys(ctx context.Context, pubKeys [][fieldparams.
 	if ctx == nil {
 		return nil, errors.New("context is nil")
 	}
+	km.lock.Lock()
 	importedRemoteKeysStatuses := make([]*ethpbservice.ImportedRemoteKeysStatus, len(pubKeys))
 	for i, pubKey := range pubKeys {
 		found := false
@@ -319,13 +473,16 @@ func (km *Keymanager) AddPublicKeys(ctx context.Context, pubKeys [][fieldparams.
 			continue
 		}
 		km.providedPublicKeys = append(km.providedPublicKeys, pubKey)
+		delete(km.deletedPublicKeys, pubKey)
 		importedRemoteKeysStatuses[i] = &ethpbservice.ImportedRemoteKeysStatus{
 			Status:  ethpbservice.ImportedRemoteKeysStatus_IMPORTED,
 			Message: fmt.Sprintf("Successfully added pubkey: %v", hexutil.Encode(pubKey[:])),
 		}
 		log.Debug("Added pubkey to keymanager for web3signer", "pubkey", hexutil.Encode(pubKey[:]))
 	}
-	km.accountsChangedFeed.Send(km.providedPublicKeys)
+	providedPublicKeys := km.copyProvidedPublicKeys()
+	km.lock.Unlock()
+	km.accountsChangedFeed.Send(providedPublicKeys)
 	return importedRemoteKeysStatuses, nil
 }
 
@@ -334,8 +491,10 @@ func (km *Keymanager) DeletePublicKeys(ctx context.Context, pubKeys [][fieldpara
 	if ctx == nil {
 		return nil, errors.New("context is nil")
 	}
+	km.lock.Lock()
 	deletedRemoteKeysStatuses := make([]*ethpbservice.DeletedRemoteKeysStatus, len(pubKeys))
 	if len(km.providedPublicKeys) == 0 {
+		km.lock.Unlock()
 		for i := range deletedRemoteKeysStatuses {
 			deletedRemoteKeysStatuses[i] = &ethpbservice.DeletedRemoteKeysStatus{
 				Status:  ethpbservice.DeletedRemoteKeysStatus_NOT_FOUND,
@@ -348,6 +507,7 @@ func (km *Keymanager) DeletePublicKeys(ctx context.Context, pubKeys [][fieldpara
 		for in, key := range km.providedPublicKeys {
 			if bytes.Equal(key[:], pubkey[:]) {
 				km.providedPublicKeys = append(km.providedPublicKeys[:in], km.providedPublicKeys[in+1:]...)
+				km.deletedPublicKeys[pubkey] = true
 				deletedRemoteKeysStatuses[i] = &ethpbservice.DeletedRemoteKeysStatus{
 					Status:  ethpbservice.DeletedRemoteKeysStatus_DELETED,
 					Message: fmt.Sprintf("Successfully deleted pubkey: %v", hexutil.Encode(pubkey[:])),
@@ -363,6 +523,16 @@ func (km *Keymanager) DeletePublicKeys(ctx context.Context, pubKeys [][fieldpara
 			}
 		}
 	}
-	km.accountsChangedFeed.Send(km.providedPublicKeys)
+	providedPublicKeys := km.copyProvidedPublicKeys()
+	km.lock.Unlock()
+	km.accountsChangedFeed.Send(providedPublicKeys)
 	return deletedRemoteKeysStatuses, nil
 }
+
+// copyProvidedPublicKeys returns a copy of the provided public keys, which subscribers can use without locking.
+// The caller must hold the lock.
+func (km *Keymanager) copyProvidedPublicKeys() [][fieldparams.BLSPubkeyLength]byte {
+	providedPublicKeys := make([][fieldparams.BLSPubkeyLength]byte, len(km.providedPublicKeys))
+	copy(providedPublicKeys, km.providedPublicKeys)
+	return providedPublicKeys
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
@@ -174,6 +175,10 @@ func TestKeymanager_Sign(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			tt.args.request.SignatureDomain = make([]byte, 32)
+			signingRoot, err := computeSigningRoot(tt.args.request)
+			require.NoError(t, err)
+			tt.args.request.SigningRoot = signingRoot[:]
 			got, err := km.Sign(ctx, tt.args.request)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetVoluntaryExitSignRequest() error = %v, wantErr %v", err, tt.wantErr)
@@ -345,3 +350,125 @@ func TestKeymanager_DeletePublicKeys(t *testing.T) {
 		require.Equal(t, ethpbservice.DeletedRemoteKeysStatus_NOT_FOUND, status.Status)
 	}
 }
+
+func TestKeymanager_RefreshPublicKeys(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	config := &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		PublicKeysURL:         "http://example2.com/api/v1/eth2/publicKeys",
+	}
+	km, err := NewKeymanager(ctx, config)
+	require.NoError(t, err)
+	km.client = &MockClient{
+		PublicKeys: []string{"0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"},
+	}
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keys))
+
+	refreshedKey, err := hexutil.Decode("0x8000091c2ae64ee414a54c1cc1fc67dec663408bc636cb86756e0200e41a75c8f86603f104f02c856983d2783116be13")
+	require.NoError(t, err)
+	km.client = &MockClient{
+		PublicKeys: []string{
+			"0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820",
+			hexutil.Encode(refreshedKey),
+		},
+	}
+	keysChan := make(chan [][fieldparams.BLSPubkeyLength]byte, 1)
+	sub := km.SubscribeAccountChanges(keysChan)
+	defer sub.Unsubscribe()
+	go km.refreshPublicKeys(ctx, 10*time.Millisecond)
+
+	select {
+	case refreshedKeys := <-keysChan:
+		require.Equal(t, 2, len(refreshedKeys))
+		require.DeepEqual(t, bytesutil.ToBytes48(refreshedKey), refreshedKeys[1])
+	case <-time.After(5 * time.Second):
+		t.Fatal("public keys were not refreshed")
+	}
+	keys, err = km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(keys))
+}
+
+func TestKeymanager_RefreshPublicKeys_KeepsDeletedKeysOut(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	deletedKey := "0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"
+	refreshedKey := "0x8000091c2ae64ee414a54c1cc1fc67dec663408bc636cb86756e0200e41a75c8f86603f104f02c856983d2783116be13"
+	km, err := NewKeymanager(ctx, &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		PublicKeysURL:         "http://example2.com/api/v1/eth2/publicKeys",
+	})
+	require.NoError(t, err)
+	km.client = &MockClient{PublicKeys: []string{deletedKey}}
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keys))
+
+	statuses, err := km.DeletePublicKeys(ctx, keys)
+	require.NoError(t, err)
+	require.Equal(t, ethpbservice.DeletedRemoteKeysStatus_DELETED, statuses[0].Status)
+
+	// The key deleted through the API is not used again when the keys still listed by the web3signer are refreshed.
+	keysChan := make(chan [][fieldparams.BLSPubkeyLength]byte, 1)
+	sub := km.SubscribeAccountChanges(keysChan)
+	defer sub.Unsubscribe()
+	km.client = &MockClient{PublicKeys: []string{deletedKey, refreshedKey}}
+	go km.refreshPublicKeys(ctx, 10*time.Millisecond)
+	select {
+	case refreshedKeys := <-keysChan:
+		require.Equal(t, 1, len(refreshedKeys))
+		assert.Equal(t, refreshedKey, hexutil.Encode(refreshedKeys[0][:]))
+	case <-time.After(5 * time.Second):
+		t.Fatal("public keys were not refreshed")
+	}
+
+	// The key is used again once added back through the API.
+	_, err = km.AddPublicKeys(ctx, keys)
+	require.NoError(t, err)
+	keys, err = km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(keys))
+}
+
+func TestKeymanager_Stop(t *testing.T) {
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	km, err := NewKeymanager(context.Background(), &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		ProvidedPublicKeys:    [][48]byte{{1}},
+	})
+	require.NoError(t, err)
+	// Stopping a keymanager which does not refresh its public keys has no effect.
+	km.Stop()
+
+	km, err = NewKeymanager(context.Background(), &SetupConfig{
+		BaseEndpoint:              "http://example.com",
+		GenesisValidatorsRoot:     root,
+		PublicKeysURL:             "http://example2.com/api/v1/eth2/publicKeys",
+		PublicKeysRefreshInterval: time.Hour,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, km.cancel)
+	km.Stop()
+}
+
+func TestDiffPublicKeys(t *testing.T) {
+	previous := [][fieldparams.BLSPubkeyLength]byte{{1}, {2}, {3}}
+	current := [][fieldparams.BLSPubkeyLength]byte{{2}, {3}, {4}, {5}}
+	added, removed := diffPublicKeys(previous, current)
+	require.Equal(t, 2, added)
+	require.Equal(t, 1, removed)
+	added, removed = diffPublicKeys(current, current)
+	require.Equal(t, 0, added)
+	require.Equal(t, 0, removed)
+}
@@ -0,0 +1,74 @@
+package remote_web3signer
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkg/errors"
+	fssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+)
+
+// verifySigningRoot recomputes the signing root of the object of a sign request with its signature domain, and
+// checks that it matches the signing root of the request. The web3signer signs the object it is sent, while the
+// validator client checks the signing root of the request against its slashing protection history, so a request
+// whose object does not match its signing root is never sent.
+func verifySigningRoot(request *validatorpb.SignRequest) error {
+	signingRoot, err := computeSigningRoot(request)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(signingRoot[:], request.SigningRoot) {
+		return fmt.Errorf(
+			"signing root %#x of sign request does not match the signing root %#x of its object",
+			request.SigningRoot,
+			signingRoot,
+		)
+	}
+	return nil
+}
+
+// computeSigningRoot computes the signing root of the object of a sign request with its signature domain.
+func computeSigningRoot(request *validatorpb.SignRequest) ([32]byte, error) {
+	var object fssz.HashRoot
+	switch o := request.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		object = o.Block
+	case *validatorpb.SignRequest_BlockV2:
+		object = o.BlockV2
+	case *validatorpb.SignRequest_BlockV3:
+		object = o.BlockV3
+	case *validatorpb.SignRequest_BlindedBlockV3:
+		object = o.BlindedBlockV3
+	case *validatorpb.SignRequest_AttestationData:
+		object = o.AttestationData
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		object = o.AggregateAttestationAndProof
+	case *validatorpb.SignRequest_Slot:
+		slot := types.SSZUint64(o.Slot)
+		object = &slot
+	case *validatorpb.SignRequest_Epoch:
+		epoch := types.SSZUint64(o.Epoch)
+		object = &epoch
+	case *validatorpb.SignRequest_Exit:
+		object = o.Exit
+	case *validatorpb.SignRequest_SyncMessageBlockRoot:
+		root := types.SSZBytes(o.SyncMessageBlockRoot)
+		object = &root
+	case *validatorpb.SignRequest_SyncAggregatorSelectionData:
+		object = o.SyncAggregatorSelectionData
+	case *validatorpb.SignRequest_ContributionAndProof:
+		object = o.ContributionAndProof
+	case *validatorpb.SignRequest_Registration:
+		object = o.Registration
+	default:
+		return [32]byte{}, fmt.Errorf("web3signer sign request type %T not supported", request.Object)
+	}
+	signingRoot, err := signing.ComputeSigningRoot(object, request.SignatureDomain)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not compute signing root of sign request")
+	}
+	return signingRoot, nil
+}
@@ -0,0 +1,46 @@
+package remote_web3signer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer/v1/mock"
+)
+
+func TestVerifySigningRoot(t *testing.T) {
+	request := mock.GetMockSignRequest("ATTESTATION")
+	request.SignatureDomain = make([]byte, 32)
+	request.SignatureDomain[0] = 1
+	want, err := signing.ComputeSigningRoot(request.GetAttestationData(), request.SignatureDomain)
+	require.NoError(t, err)
+	request.SigningRoot = want[:]
+	require.NoError(t, verifySigningRoot(request))
+
+	request.SigningRoot = make([]byte, 32)
+	require.ErrorContains(t, "does not match the signing root", verifySigningRoot(request))
+
+	request.SignatureDomain = make([]byte, 4)
+	require.ErrorContains(t, "could not compute signing root", verifySigningRoot(request))
+}
+
+func TestKeymanager_Sign_SigningRootMismatch(t *testing.T) {
+	ctx := context.Background()
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	km, err := NewKeymanager(ctx, &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+	})
+	require.NoError(t, err)
+	km.client = &MockClient{
+		Signature: "0xb3baa751d0a9132cfe93e4e3d5ff9075111100e3789dca219ade5a24d27e19d16b3353149da1833e9b691bb38634e8dc04469be7032132906c927d7e1a49b414730612877bc6b2810c8f202daf793d1ab0d6b5cb21d52f9e52e883859887a5d9",
+	}
+	request := mock.GetMockSignRequest("BLOCK_V2")
+	request.SignatureDomain = make([]byte, 32)
+	request.SigningRoot = make([]byte, 32)
+	_, err = km.Sign(ctx, request)
+	require.ErrorContains(t, "does not match the signing root", err)
+}
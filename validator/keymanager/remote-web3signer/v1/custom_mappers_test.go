@@ -233,7 +233,7 @@ func TestMapBeaconBlockAltair(t *testing.T) {
 					ParentRoot:    make([]byte, fieldparams.RootLength),
 					StateRoot:     make([]byte, fieldparams.RootLength),
 					Body: &ethpb.BeaconBlockBodyAltair{
-						RandaoReveal: make([]byte, 32),
+						RandaoReveal: make([]byte, fieldparams.BLSSignatureLength),
 						Eth1Data: &ethpb.Eth1Data{
 							DepositRoot:  make([]byte, fieldparams.RootLength),
 							DepositCount: 0,
@@ -311,7 +311,7 @@ func TestMapBeaconBlockAltair(t *testing.T) {
 						},
 						Deposits: []*ethpb.Deposit{
 							{
-								Proof: [][]byte{[]byte("A")},
+								Proof: mock.MockDepositProof(),
 								Data: &ethpb.Deposit_Data{
 									PublicKey:             make([]byte, fieldparams.BLSPubkeyLength),
 									WithdrawalCredentials: make([]byte, 32),
@@ -368,7 +368,7 @@ func TestMapBeaconBlockBody(t *testing.T) {
 			name: "Happy Path Test",
 			args: args{
 				body: &ethpb.BeaconBlockBody{
-					RandaoReveal: make([]byte, 32),
+					RandaoReveal: make([]byte, fieldparams.BLSSignatureLength),
 					Eth1Data: &ethpb.Eth1Data{
 						DepositRoot:  make([]byte, fieldparams.RootLength),
 						DepositCount: 0,
@@ -446,7 +446,7 @@ func TestMapBeaconBlockBody(t *testing.T) {
 					},
 					Deposits: []*ethpb.Deposit{
 						{
-							Proof: [][]byte{[]byte("A")},
+							Proof: mock.MockDepositProof(),
 							Data: &ethpb.Deposit_Data{
 								PublicKey:             make([]byte, fieldparams.BLSPubkeyLength),
 								WithdrawalCredentials: make([]byte, 32),
@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/go-bitfield"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
 	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/testing/util"
@@ -28,6 +29,25 @@ func MockSyncComitteeBits() []byte {
 	}
 }
 
+// MockDepositProof returns an empty deposit proof of the correct length.
+func MockDepositProof() [][]byte {
+	proof := make([][]byte, params.BeaconConfig().DepositContractTreeDepth+1)
+	for i := range proof {
+		proof[i] = make([]byte, 32)
+	}
+	return proof
+}
+
+// MockDepositProofHex returns the hex representation of MockDepositProof.
+func MockDepositProofHex() []string {
+	proof := MockDepositProof()
+	proofHex := make([]string, len(proof))
+	for i := range proof {
+		proofHex[i] = hexutil.Encode(proof[i])
+	}
+	return proofHex
+}
+
 func MockAggregationBits() []byte {
 	currSize := new(eth.SyncCommitteeContribution).AggregationBits.Len()
 	switch currSize {
@@ -109,7 +129,7 @@ func GetMockSignRequest(t string) *validatorpb.SignRequest {
 					ParentRoot:    make([]byte, fieldparams.RootLength),
 					StateRoot:     make([]byte, fieldparams.RootLength),
 					Body: &eth.BeaconBlockBody{
-						RandaoReveal: make([]byte, 32),
+						RandaoReveal: make([]byte, fieldparams.BLSSignatureLength),
 						Eth1Data: &eth.Eth1Data{
 							DepositRoot:  make([]byte, fieldparams.RootLength),
 							DepositCount: 0,
@@ -187,7 +207,7 @@ func GetMockSignRequest(t string) *validatorpb.SignRequest {
 						},
 						Deposits: []*eth.Deposit{
 							{
-								Proof: [][]byte{[]byte("A")},
+								Proof: MockDepositProof(),
 								Data: &eth.Deposit_Data{
 									PublicKey:             make([]byte, fieldparams.BLSPubkeyLength),
 									WithdrawalCredentials: make([]byte, 32),
@@ -222,7 +242,7 @@ func GetMockSignRequest(t string) *validatorpb.SignRequest {
 					ParentRoot:    make([]byte, fieldparams.RootLength),
 					StateRoot:     make([]byte, fieldparams.RootLength),
 					Body: &eth.BeaconBlockBodyAltair{
-						RandaoReveal: make([]byte, 32),
+						RandaoReveal: make([]byte, fieldparams.BLSSignatureLength),
 						Eth1Data: &eth.Eth1Data{
 							DepositRoot:  make([]byte, fieldparams.RootLength),
 							DepositCount: 0,
@@ -300,7 +320,7 @@ func GetMockSignRequest(t string) *validatorpb.SignRequest {
 						},
 						Deposits: []*eth.Deposit{
 							{
-								Proof: [][]byte{[]byte("A")},
+								Proof: MockDepositProof(),
 								Data: &eth.Deposit_Data{
 									PublicKey:             make([]byte, fieldparams.BLSPubkeyLength),
 									WithdrawalCredentials: make([]byte, 32),
@@ -421,7 +441,7 @@ func GetMockSignRequest(t string) *validatorpb.SignRequest {
 					FeeRecipient: make([]byte, fieldparams.FeeRecipientLength),
 					GasLimit:     uint64(0),
 					Timestamp:    uint64(0),
-					Pubkey:       make([]byte, fieldparams.BLSSignatureLength),
+					Pubkey:       make([]byte, fieldparams.BLSPubkeyLength),
 				},
 			},
 			SigningSlot: 0,
@@ -583,7 +603,7 @@ func MockValidatorRegistrationSignRequest() *v1.ValidatorRegistrationSignRequest
 			FeeRecipient: make([]byte, fieldparams.FeeRecipientLength),
 			GasLimit:     fmt.Sprint(0),
 			Timestamp:    fmt.Sprint(0),
-			Pubkey:       make([]byte, fieldparams.BLSSignatureLength),
+			Pubkey:       make([]byte, fieldparams.BLSPubkeyLength),
 		},
 	}
 }
@@ -653,7 +673,7 @@ func MockBeaconBlockAltair() *v1.BeaconBlockAltair {
 		ParentRoot:    make([]byte, fieldparams.RootLength),
 		StateRoot:     make([]byte, fieldparams.RootLength),
 		Body: &v1.BeaconBlockBodyAltair{
-			RandaoReveal: make([]byte, 32),
+			RandaoReveal: make([]byte, fieldparams.BLSSignatureLength),
 			Eth1Data: &v1.Eth1Data{
 				DepositRoot:  make([]byte, fieldparams.RootLength),
 				DepositCount: "0",
@@ -695,7 +715,7 @@ func MockBeaconBlockAltair() *v1.BeaconBlockAltair {
 			},
 			Deposits: []*v1.Deposit{
 				{
-					Proof: []string{"0x41"},
+					Proof: MockDepositProofHex(),
 					Data: &v1.DepositData{
 						PublicKey:             make([]byte, fieldparams.BLSPubkeyLength),
 						WithdrawalCredentials: make([]byte, 32),
@@ -723,7 +743,7 @@ func MockBeaconBlockAltair() *v1.BeaconBlockAltair {
 
 func MockBeaconBlockBody() *v1.BeaconBlockBody {
 	return &v1.BeaconBlockBody{
-		RandaoReveal: make([]byte, 32),
+		RandaoReveal: make([]byte, fieldparams.BLSSignatureLength),
 		Eth1Data: &v1.Eth1Data{
 			DepositRoot:  make([]byte, fieldparams.RootLength),
 			DepositCount: "0",
@@ -765,7 +785,7 @@ func MockBeaconBlockBody() *v1.BeaconBlockBody {
 		},
 		Deposits: []*v1.Deposit{
 			{
-				Proof: []string{"0x41"},
+				Proof: MockDepositProofHex(),
 				Data: &v1.DepositData{
 					PublicKey:             make([]byte, fieldparams.BLSPubkeyLength),
 					WithdrawalCredentials: make([]byte, 32),
@@ -16,6 +16,7 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -412,6 +413,8 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 
 	v, err := client.NewValidatorService(c.cliCtx.Context, &client.Config{
 		Endpoint:                   endpoint,
+		BeaconApiEndpoint:          c.cliCtx.String(flags.BeaconRESTApiProviderFlag.Name),
+		BeaconApiTimeout:           time.Second * time.Duration(c.cliCtx.Int(cmd.ApiTimeoutFlag.Name)),
 		DataDir:                    dataDir,
 		LogValidatorBalances:       logValidatorBalances,
 		EmitAccountMetrics:         emitAccountMetrics,
@@ -428,6 +431,10 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		WalletInitializedFeed:      c.walletInitialized,
 		GraffitiStruct:             gStruct,
 		LogDutyCountDown:           c.cliCtx.Bool(flags.EnableDutyCountDown.Name),
+		BroadcastToBeaconNodes:     c.cliCtx.Bool(flags.BroadcastToBeaconNodesFlag.Name),
+		SlashingProtectionEndpoint: c.cliCtx.String(flags.SlashingProtectionProviderFlag.Name),
+		SlashingProtectionCert:     c.cliCtx.String(flags.SlashingProtectionCertFlag.Name),
+		SlashingProtectionHolder:   c.cliCtx.String(flags.SlashingProtectionHolderFlag.Name),
 		Web3SignerConfig:           wsc,
 		ProposerSettings:           bpc,
 	})
@@ -450,8 +457,13 @@ func web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error
 			return nil, fmt.Errorf("web3signer url must be in the format of http(s)://host:port url used: %v", urlStr)
 		}
 		web3signerConfig = &remoteweb3signer.SetupConfig{
-			BaseEndpoint:          u.String(),
-			GenesisValidatorsRoot: nil,
+			BaseEndpoint:              u.String(),
+			GenesisValidatorsRoot:     nil,
+			PublicKeysRefreshInterval: cliCtx.Duration(flags.Web3SignerPublicKeysRefreshIntervalFlag.Name),
+			ClientCertPath:            cliCtx.String(flags.Web3SignerClientCertFlag.Name),
+			ClientKeyPath:             cliCtx.String(flags.Web3SignerClientKeyFlag.Name),
+			CACertPath:                cliCtx.String(flags.Web3SignerCACertFlag.Name),
+			RequestTimeout:            cliCtx.Duration(flags.Web3SignerRequestTimeoutFlag.Name),
 		}
 		if cliCtx.IsSet(flags.WalletPasswordFileFlag.Name) {
 			log.Warnf("%s was provided while using web3signer and will be ignored", flags.WalletPasswordFileFlag.Name)
@@ -679,6 +691,7 @@ func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
 		validatorpb.RegisterAccountsHandler,
 		validatorpb.RegisterBeaconHandler,
 		validatorpb.RegisterSlashingProtectionHandler,
+		validatorpb.RegisterProposerSettingsHandler,
 		ethpbservice.RegisterKeyManagementHandler,
 	}
 	gwmux := gwruntime.NewServeMux(
@@ -79,3 +79,16 @@ type getFeeRecipientByPubkeyResponseJson struct {
 type setFeeRecipientByPubkeyRequestJson struct {
 	Ethaddress string `json:"ethaddress" hex:"true"`
 }
+
+type gasLimitJson struct {
+	Pubkey   string `json:"pubkey" hex:"true"`
+	GasLimit string `json:"gas_limit"`
+}
+
+type getGasLimitResponseJson struct {
+	Data *gasLimitJson `json:"data"`
+}
+
+type setGasLimitRequestJson struct {
+	GasLimit string `json:"gas_limit"`
+}
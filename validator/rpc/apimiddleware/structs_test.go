@@ -228,6 +228,29 @@ func TestDeleteRemoteKeys_JSONisEqual(t *testing.T) {
 	require.Equal(t, resp, true)
 }
 
+func TestGasLimit_JSONisEqual(t *testing.T) {
+	getGasLimitResponse := &getGasLimitResponseJson{
+		Data: &gasLimitJson{
+			Pubkey:   "0x0",
+			GasLimit: "30000000",
+		},
+	}
+	protoGetResponse := &service.GetGasLimitResponse{
+		Data: &service.GetGasLimitResponse_GasLimit{
+			Pubkey:   make([]byte, fieldparams.BLSPubkeyLength),
+			GasLimit: 30000000,
+		},
+	}
+
+	getResp, err := areJsonPropertyNamesEqual(getGasLimitResponse, protoGetResponse)
+	require.NoError(t, err)
+	require.Equal(t, getResp, true)
+
+	resp, err := areJsonPropertyNamesEqual(getGasLimitResponse.Data, protoGetResponse.Data)
+	require.NoError(t, err)
+	require.Equal(t, resp, true)
+}
+
 // note: this does not do a deep comparison of the structs
 func areJsonPropertyNamesEqual(internal, proto interface{}) (bool, error) {
 	internalJSON, err := json.Marshal(internal)
@@ -0,0 +1,45 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v2"
+)
+
+// ExportProposerSettings handles the rpc call returning the proposer settings in use by the validator client, which
+// include the settings edited through the keymanager API. The export follows the format of the proposer settings
+// file, so that it can be used as the proposer settings file of a validator client.
+func (s *Server) ExportProposerSettings(
+	_ context.Context, req *pb.ExportProposerSettingsRequest,
+) (*pb.ExportProposerSettingsResponse, error) {
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not ready")
+	}
+	settings := s.validatorService.ProposerSettingsCopy()
+	if settings == nil {
+		return nil, status.Error(codes.FailedPrecondition, "No proposer settings are in use")
+	}
+	payload := settings.ToPayload()
+
+	var encoded []byte
+	var err error
+	switch strings.ToLower(req.Format) {
+	case "", "json":
+		encoded, err = json.MarshalIndent(payload, "", "\t")
+	case "yaml", "yml":
+		encoded, err = yaml.Marshal(payload)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Unsupported proposer settings format %q, expected json or yaml", req.Format)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not marshal proposer settings: %v", err)
+	}
+	return &pb.ExportProposerSettingsResponse{
+		File: string(encoded),
+	}, nil
+}
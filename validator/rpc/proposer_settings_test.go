@@ -0,0 +1,87 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	validatorserviceconfig "github.com/prysmaticlabs/prysm/config/validator/service"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"gopkg.in/yaml.v2"
+)
+
+func TestServer_ExportProposerSettings(t *testing.T) {
+	ctx := context.Background()
+	pubkey1 := bytesutil.ToBytes48([]byte{1})
+	pubkey2 := bytesutil.ToBytes48([]byte{2})
+	valDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{})
+	feeRecipient := common.HexToAddress("0x077Fb65722E7b2455012BFEBf6177F1D2e9738D7")
+	require.NoError(t, valDB.SaveProposerSettingsOverride(ctx, pubkey2, &validatorserviceconfig.ProposerOptionOverride{FeeRecipient: &feeRecipient}))
+	vs, err := client.NewValidatorService(ctx, &client.Config{
+		ValDB: valDB,
+		ProposerSettings: &validatorserviceconfig.ProposerSettings{
+			ProposeConfig: map[[48]byte]*validatorserviceconfig.ProposerOption{
+				pubkey1: {
+					FeeRecipient:  common.HexToAddress("0x055Fb65722E7b2455012BFEBf6177F1D2e9738D5"),
+					BuilderConfig: &validatorserviceconfig.BuilderConfig{Enabled: true, GasLimit: 40000000, Relays: []string{"https://relay.example.com"}},
+				},
+			},
+			DefaultConfig: &validatorserviceconfig.ProposerOption{
+				FeeRecipient: common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9"),
+			},
+		},
+	})
+	require.NoError(t, err)
+	s := &Server{
+		validatorService: vs,
+	}
+	want := &validatorserviceconfig.ProposerSettingsPayload{
+		ProposerConfig: map[string]*validatorserviceconfig.ProposerOptionPayload{
+			"0x010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000": {
+				FeeRecipient:  common.HexToAddress("0x055Fb65722E7b2455012BFEBf6177F1D2e9738D5").Hex(),
+				BuilderConfig: &validatorserviceconfig.BuilderConfig{Enabled: true, GasLimit: 40000000, Relays: []string{"https://relay.example.com"}},
+			},
+			"0x020000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000": {
+				FeeRecipient: common.HexToAddress("0x077Fb65722E7b2455012BFEBf6177F1D2e9738D7").Hex(),
+			},
+		},
+		DefaultConfig: &validatorserviceconfig.ProposerOptionPayload{
+			FeeRecipient: common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9").Hex(),
+		},
+	}
+
+	t.Run("json", func(t *testing.T) {
+		resp, err := s.ExportProposerSettings(ctx, &pb.ExportProposerSettingsRequest{})
+		require.NoError(t, err)
+		got := &validatorserviceconfig.ProposerSettingsPayload{}
+		require.NoError(t, json.Unmarshal([]byte(resp.File), got))
+		assert.DeepEqual(t, want, got)
+	})
+	t.Run("yaml", func(t *testing.T) {
+		resp, err := s.ExportProposerSettings(ctx, &pb.ExportProposerSettingsRequest{Format: "yaml"})
+		require.NoError(t, err)
+		got := &validatorserviceconfig.ProposerSettingsPayload{}
+		require.NoError(t, yaml.Unmarshal([]byte(resp.File), got))
+		assert.DeepEqual(t, want, got)
+	})
+	t.Run("unsupported format", func(t *testing.T) {
+		_, err := s.ExportProposerSettings(ctx, &pb.ExportProposerSettingsRequest{Format: "toml"})
+		require.ErrorContains(t, "Unsupported proposer settings format", err)
+	})
+	t.Run("no proposer settings", func(t *testing.T) {
+		vs, err := client.NewValidatorService(ctx, &client.Config{})
+		require.NoError(t, err)
+		s := &Server{
+			validatorService: vs,
+		}
+		_, err = s.ExportProposerSettings(ctx, &pb.ExportProposerSettingsRequest{})
+		require.ErrorContains(t, "No proposer settings are in use", err)
+	})
+}
@@ -178,6 +178,7 @@ func (s *Server) Start() {
 	validatorpb.RegisterAccountsServer(s.grpcServer, s)
 	ethpbservice.RegisterKeyManagementServer(s.grpcServer, s)
 	validatorpb.RegisterSlashingProtectionServer(s.grpcServer, s)
+	validatorpb.RegisterProposerSettingsServer(s.grpcServer, s)
 
 	go func() {
 		if s.listener != nil {
@@ -399,7 +399,8 @@ func (s *Server) ListFeeRecipientByPubkey(_ context.Context, req *ethpbservice.P
 		return nil, status.Error(codes.FailedPrecondition, err.Error())
 	}
 	defaultFeeRecipient := params.BeaconConfig().DefaultFeeRecipient.Bytes()
-	if s.validatorService.ProposerSettings == nil {
+	settings := s.validatorService.ProposerSettingsCopy()
+	if settings == nil {
 		return &ethpbservice.GetFeeRecipientByPubkeyResponse{
 			Data: &ethpbservice.GetFeeRecipientByPubkeyResponse_FeeRecipient{
 				Pubkey:     validatorKey,
@@ -407,8 +408,8 @@ func (s *Server) ListFeeRecipientByPubkey(_ context.Context, req *ethpbservice.P
 			},
 		}, nil
 	}
-	if s.validatorService.ProposerSettings.ProposeConfig != nil {
-		proposerOption, found := s.validatorService.ProposerSettings.ProposeConfig[bytesutil.ToBytes48(validatorKey)]
+	if settings.ProposeConfig != nil {
+		proposerOption, found := settings.ProposeConfig[bytesutil.ToBytes48(validatorKey)]
 		if found {
 			return &ethpbservice.GetFeeRecipientByPubkeyResponse{
 				Data: &ethpbservice.GetFeeRecipientByPubkeyResponse_FeeRecipient{
@@ -418,8 +419,8 @@ func (s *Server) ListFeeRecipientByPubkey(_ context.Context, req *ethpbservice.P
 			}, nil
 		}
 	}
-	if s.validatorService.ProposerSettings.DefaultConfig != nil {
-		defaultFeeRecipient = s.validatorService.ProposerSettings.DefaultConfig.FeeRecipient.Bytes()
+	if settings.DefaultConfig != nil {
+		defaultFeeRecipient = settings.DefaultConfig.FeeRecipient.Bytes()
 	}
 	return &ethpbservice.GetFeeRecipientByPubkeyResponse{
 		Data: &ethpbservice.GetFeeRecipientByPubkeyResponse_FeeRecipient{
@@ -429,7 +430,8 @@ func (s *Server) ListFeeRecipientByPubkey(_ context.Context, req *ethpbservice.P
 	}, nil
 }
 
-// SetFeeRecipientByPubkey updates the eth address mapped to the public key.
+// SetFeeRecipientByPubkey updates the eth address mapped to the public key. The fee recipient is persisted so that
+// it survives restarts, and takes precedence over the fee recipient of the proposer settings file or URL.
 func (s *Server) SetFeeRecipientByPubkey(ctx context.Context, req *ethpbservice.SetFeeRecipientByPubkeyRequest) (*empty.Empty, error) {
 	if s.validatorService == nil {
 		return nil, status.Error(codes.FailedPrecondition, "Validator service not ready")
@@ -438,36 +440,18 @@ func (s *Server) SetFeeRecipientByPubkey(ctx context.Context, req *ethpbservice.
 	if err := validatePublicKey(validatorKey); err != nil {
 		return nil, status.Error(codes.FailedPrecondition, err.Error())
 	}
-	defaultOption := validatorServiceConfig.DefaultProposerOption()
 	encoded := hexutil.Encode(req.Ethaddress)
 	if !common.IsHexAddress(encoded) {
 		return nil, status.Error(
 			codes.InvalidArgument, "Fee recipient is not a valid Ethereum address")
 	}
-	pOption := validatorServiceConfig.DefaultProposerOption()
-	pOption.FeeRecipient = common.BytesToAddress(req.Ethaddress)
-	switch {
-	case s.validatorService.ProposerSettings == nil:
-		s.validatorService.ProposerSettings = &validatorServiceConfig.ProposerSettings{
-			ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*validatorServiceConfig.ProposerOption{
-				bytesutil.ToBytes48(validatorKey): &pOption,
-			},
-			DefaultConfig: &defaultOption,
-		}
-	case s.validatorService.ProposerSettings.ProposeConfig == nil:
-		pOption.BuilderConfig = s.validatorService.ProposerSettings.DefaultConfig.BuilderConfig
-		s.validatorService.ProposerSettings.ProposeConfig = map[[fieldparams.BLSPubkeyLength]byte]*validatorServiceConfig.ProposerOption{
-			bytesutil.ToBytes48(validatorKey): &pOption,
-		}
-	default:
-		proposerOption, found := s.validatorService.ProposerSettings.ProposeConfig[bytesutil.ToBytes48(validatorKey)]
-		if found {
-			proposerOption.FeeRecipient = common.BytesToAddress(req.Ethaddress)
-		} else {
-			pOption.BuilderConfig = s.validatorService.ProposerSettings.DefaultConfig.BuilderConfig
-			s.validatorService.ProposerSettings.ProposeConfig[bytesutil.ToBytes48(validatorKey)] = &pOption
-		}
+	feeRecipient := common.BytesToAddress(req.Ethaddress)
+	if err := s.validatorService.UpdateProposerSettingsOverride(ctx, bytesutil.ToBytes48(validatorKey), func(o *validatorServiceConfig.ProposerOptionOverride) {
+		o.FeeRecipient = &feeRecipient
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save fee recipient: %v", err)
 	}
+	s.pushProposerSettings(ctx)
 	// override the 200 success with 202 according to the specs
 	if err := grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "202")); err != nil {
 		return &empty.Empty{}, status.Errorf(codes.Internal, "Could not set custom success code header: %v", err)
@@ -475,7 +459,8 @@ func (s *Server) SetFeeRecipientByPubkey(ctx context.Context, req *ethpbservice.
 	return &empty.Empty{}, nil
 }
 
-// DeleteFeeRecipientByPubkey updates the eth address mapped to the public key to the default fee recipient listed
+// DeleteFeeRecipientByPubkey removes the eth address set for the public key through the API, which falls back to the
+// fee recipient of the proposer settings file or URL, or to the default fee recipient.
 func (s *Server) DeleteFeeRecipientByPubkey(ctx context.Context, req *ethpbservice.PubkeyRequest) (*empty.Empty, error) {
 	if s.validatorService == nil {
 		return nil, status.Error(codes.FailedPrecondition, "Validator service not ready")
@@ -484,16 +469,83 @@ func (s *Server) DeleteFeeRecipientByPubkey(ctx context.Context, req *ethpbservi
 	if err := validatePublicKey(validatorKey); err != nil {
 		return nil, status.Error(codes.FailedPrecondition, err.Error())
 	}
-	defaultFeeRecipient := params.BeaconConfig().DefaultFeeRecipient
-	if s.validatorService.ProposerSettings != nil && s.validatorService.ProposerSettings.DefaultConfig != nil {
-		defaultFeeRecipient = s.validatorService.ProposerSettings.DefaultConfig.FeeRecipient
+	if err := s.validatorService.UpdateProposerSettingsOverride(ctx, bytesutil.ToBytes48(validatorKey), func(o *validatorServiceConfig.ProposerOptionOverride) {
+		o.FeeRecipient = nil
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not delete fee recipient: %v", err)
 	}
-	if s.validatorService.ProposerSettings != nil && s.validatorService.ProposerSettings.ProposeConfig != nil {
-		proposerOption, found := s.validatorService.ProposerSettings.ProposeConfig[bytesutil.ToBytes48(validatorKey)]
-		if found {
-			proposerOption.FeeRecipient = defaultFeeRecipient
-		}
+	s.pushProposerSettings(ctx)
+	// override the 200 success with 204 according to the specs
+	if err := grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "204")); err != nil {
+		return &empty.Empty{}, status.Errorf(codes.Internal, "Could not set custom success code header: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// GetGasLimit returns the execution gas limit used in the builder registration of the public key.
+func (s *Server) GetGasLimit(_ context.Context, req *ethpbservice.PubkeyRequest) (*ethpbservice.GetGasLimitResponse, error) {
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not ready")
+	}
+	validatorKey := req.Pubkey
+	if err := validatePublicKey(validatorKey); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	gasLimit := params.BeaconConfig().DefaultBuilderGasLimit
+	if settings := s.validatorService.ProposerSettingsCopy(); settings != nil {
+		gasLimit = settings.GasLimit(bytesutil.ToBytes48(validatorKey))
+	}
+	return &ethpbservice.GetGasLimitResponse{
+		Data: &ethpbservice.GetGasLimitResponse_GasLimit{
+			Pubkey:   validatorKey,
+			GasLimit: gasLimit,
+		},
+	}, nil
+}
+
+// SetGasLimit updates the execution gas limit used in the builder registration of the public key. The gas limit is
+// persisted so that it survives restarts, and the validator is registered with the builder again.
+func (s *Server) SetGasLimit(ctx context.Context, req *ethpbservice.SetGasLimitRequest) (*empty.Empty, error) {
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not ready")
+	}
+	validatorKey := req.Pubkey
+	if err := validatePublicKey(validatorKey); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if req.GasLimit == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Gas limit must be greater than 0")
+	}
+	gasLimit := req.GasLimit
+	if err := s.validatorService.UpdateProposerSettingsOverride(ctx, bytesutil.ToBytes48(validatorKey), func(o *validatorServiceConfig.ProposerOptionOverride) {
+		o.GasLimit = &gasLimit
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save gas limit: %v", err)
+	}
+	s.pushProposerSettings(ctx)
+	// override the 200 success with 202 according to the specs
+	if err := grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "202")); err != nil {
+		return &empty.Empty{}, status.Errorf(codes.Internal, "Could not set custom success code header: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// DeleteGasLimit removes the execution gas limit set for the public key through the API, which falls back to the gas
+// limit of the proposer settings file or URL, or to the default gas limit.
+func (s *Server) DeleteGasLimit(ctx context.Context, req *ethpbservice.PubkeyRequest) (*empty.Empty, error) {
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not ready")
 	}
+	validatorKey := req.Pubkey
+	if err := validatePublicKey(validatorKey); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err := s.validatorService.UpdateProposerSettingsOverride(ctx, bytesutil.ToBytes48(validatorKey), func(o *validatorServiceConfig.ProposerOptionOverride) {
+		o.GasLimit = nil
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not delete gas limit: %v", err)
+	}
+	s.pushProposerSettings(ctx)
 	// override the 200 success with 204 according to the specs
 	if err := grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "204")); err != nil {
 		return &empty.Empty{}, status.Errorf(codes.Internal, "Could not set custom success code header: %v", err)
@@ -501,6 +553,14 @@ func (s *Server) DeleteFeeRecipientByPubkey(ctx context.Context, req *ethpbservi
 	return &empty.Empty{}, nil
 }
 
+// pushProposerSettings registers the validators with the builder again after their proposer settings changed.
+// Failures are not returned to the caller, as the proposer settings are pushed again at the start of every epoch.
+func (s *Server) pushProposerSettings(ctx context.Context) {
+	if err := s.validatorService.PushProposerSettings(ctx); err != nil {
+		log.WithError(err).Warn("Could not push updated proposer settings, they will be pushed at the start of the next epoch")
+	}
+}
+
 func validatePublicKey(pubkey []byte) error {
 	if len(pubkey) != fieldparams.BLSPubkeyLength {
 		return status.Errorf(
@@ -27,6 +27,7 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/validator/client"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer"
@@ -766,11 +767,28 @@ func TestServer_SetFeeRecipientByPubkey(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Overrides the fee recipient of the settings file",
+			args: "0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9",
+			proposerSettings: &validatorserviceconfig.ProposerSettings{
+				ProposeConfig: map[[48]byte]*validatorserviceconfig.ProposerOption{
+					bytesutil.ToBytes48(byteval): {
+						FeeRecipient: common.HexToAddress("0x055Fb65722E7b2455012BFEBf6177F1D2e9738D5"),
+					},
+				},
+			},
+			want: &want{
+				EthAddress: "0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9",
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			valDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{})
 			vs, err := client.NewValidatorService(ctx, &client.Config{
 				Validator:        &mock.MockValidator{},
+				ValDB:            valDB,
 				ProposerSettings: tt.proposerSettings,
 			})
 			require.NoError(t, err)
@@ -780,6 +798,15 @@ func TestServer_SetFeeRecipientByPubkey(t *testing.T) {
 			_, err = s.SetFeeRecipientByPubkey(ctx, &ethpbservice.SetFeeRecipientByPubkeyRequest{Pubkey: byteval, Ethaddress: common.HexToAddress(tt.args).Bytes()})
 			require.NoError(t, err)
 			assert.Equal(t, tt.want.EthAddress, s.validatorService.ProposerSettings.ProposeConfig[bytesutil.ToBytes48(byteval)].FeeRecipient.Hex())
+
+			// The fee recipient survives a restart of the validator client.
+			restarted, err := client.NewValidatorService(ctx, &client.Config{
+				Validator:        &mock.MockValidator{},
+				ValDB:            valDB,
+				ProposerSettings: tt.proposerSettings,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.EthAddress, restarted.ProposerSettings.ProposeConfig[bytesutil.ToBytes48(byteval)].FeeRecipient.Hex())
 		})
 	}
 }
@@ -798,7 +825,7 @@ func TestServer_DeleteFeeRecipientByPubkey(t *testing.T) {
 		wantErr          bool
 	}{
 		{
-			name: "Happy Path Test",
+			name: "Reverts to the fee recipient of the settings file",
 			proposerSettings: &validatorserviceconfig.ProposerSettings{
 				ProposeConfig: map[[48]byte]*validatorserviceconfig.ProposerOption{
 					bytesutil.ToBytes48(byteval): {
@@ -809,6 +836,18 @@ func TestServer_DeleteFeeRecipientByPubkey(t *testing.T) {
 					FeeRecipient: common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9"),
 				},
 			},
+			want: &want{
+				EthAddress: common.HexToAddress("0x055Fb65722E7b2455012BFEBf6177F1D2e9738D5").Hex(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Reverts to the default fee recipient",
+			proposerSettings: &validatorserviceconfig.ProposerSettings{
+				DefaultConfig: &validatorserviceconfig.ProposerOption{
+					FeeRecipient: common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9"),
+				},
+			},
 			want: &want{
 				EthAddress: common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9").Hex(),
 			},
@@ -819,15 +858,166 @@ func TestServer_DeleteFeeRecipientByPubkey(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			vs, err := client.NewValidatorService(ctx, &client.Config{
 				Validator:        &mock.MockValidator{},
+				ValDB:            dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{}),
 				ProposerSettings: tt.proposerSettings,
 			})
 			require.NoError(t, err)
 			s := &Server{
 				validatorService: vs,
 			}
+			_, err = s.SetFeeRecipientByPubkey(ctx, &ethpbservice.SetFeeRecipientByPubkeyRequest{
+				Pubkey:     byteval,
+				Ethaddress: common.HexToAddress("0x077Fb65722E7b2455012BFEBf6177F1D2e9738D7").Bytes(),
+			})
+			require.NoError(t, err)
 			_, err = s.DeleteFeeRecipientByPubkey(ctx, &ethpbservice.PubkeyRequest{Pubkey: byteval})
 			require.NoError(t, err)
-			assert.Equal(t, tt.want.EthAddress, s.validatorService.ProposerSettings.ProposeConfig[bytesutil.ToBytes48(byteval)].FeeRecipient.Hex())
+			got, err := s.ListFeeRecipientByPubkey(ctx, &ethpbservice.PubkeyRequest{Pubkey: byteval})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.EthAddress, common.BytesToAddress(got.Data.Ethaddress).Hex())
 		})
 	}
 }
+
+func TestServer_GetGasLimit(t *testing.T) {
+	ctx := context.Background()
+	pubkey1 := bytesutil.ToBytes48([]byte{1})
+	pubkey2 := bytesutil.ToBytes48([]byte{2})
+	tests := []struct {
+		name             string
+		proposerSettings *validatorserviceconfig.ProposerSettings
+		pubkey           [fieldparams.BLSPubkeyLength]byte
+		want             uint64
+	}{
+		{
+			name: "gas limit of the public key",
+			proposerSettings: &validatorserviceconfig.ProposerSettings{
+				ProposeConfig: map[[48]byte]*validatorserviceconfig.ProposerOption{
+					pubkey1: {BuilderConfig: &validatorserviceconfig.BuilderConfig{GasLimit: 40000000}},
+				},
+				DefaultConfig: &validatorserviceconfig.ProposerOption{
+					BuilderConfig: &validatorserviceconfig.BuilderConfig{GasLimit: 35000000},
+				},
+			},
+			pubkey: pubkey1,
+			want:   40000000,
+		},
+		{
+			name: "default gas limit of the settings",
+			proposerSettings: &validatorserviceconfig.ProposerSettings{
+				ProposeConfig: map[[48]byte]*validatorserviceconfig.ProposerOption{
+					pubkey1: {BuilderConfig: &validatorserviceconfig.BuilderConfig{GasLimit: 40000000}},
+				},
+				DefaultConfig: &validatorserviceconfig.ProposerOption{
+					BuilderConfig: &validatorserviceconfig.BuilderConfig{GasLimit: 35000000},
+				},
+			},
+			pubkey: pubkey2,
+			want:   35000000,
+		},
+		{
+			name:             "empty settings",
+			proposerSettings: nil,
+			pubkey:           pubkey1,
+			want:             params.BeaconConfig().DefaultBuilderGasLimit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vs, err := client.NewValidatorService(ctx, &client.Config{
+				Validator:        &mock.MockValidator{},
+				ProposerSettings: tt.proposerSettings,
+			})
+			require.NoError(t, err)
+			s := &Server{
+				validatorService: vs,
+			}
+			got, err := s.GetGasLimit(ctx, &ethpbservice.PubkeyRequest{Pubkey: tt.pubkey[:]})
+			require.NoError(t, err)
+			assert.DeepEqual(t, tt.pubkey[:], got.Data.Pubkey)
+			assert.Equal(t, tt.want, got.Data.GasLimit)
+		})
+	}
+}
+
+func TestServer_SetGasLimit(t *testing.T) {
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
+	pubkey1 := bytesutil.ToBytes48([]byte{1})
+	pubkey2 := bytesutil.ToBytes48([]byte{2})
+	defaultBuilderConfig := &validatorserviceconfig.BuilderConfig{Enabled: true, GasLimit: 30000000}
+	valDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{})
+	vs, err := client.NewValidatorService(ctx, &client.Config{
+		Validator: &mock.MockValidator{},
+		ValDB:     valDB,
+		ProposerSettings: &validatorserviceconfig.ProposerSettings{
+			DefaultConfig: &validatorserviceconfig.ProposerOption{
+				FeeRecipient:  common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9"),
+				BuilderConfig: defaultBuilderConfig,
+			},
+		},
+	})
+	require.NoError(t, err)
+	s := &Server{
+		validatorService: vs,
+	}
+
+	_, err = s.SetGasLimit(ctx, &ethpbservice.SetGasLimitRequest{Pubkey: pubkey1[:]})
+	require.ErrorContains(t, "Gas limit must be greater than 0", err)
+
+	_, err = s.SetGasLimit(ctx, &ethpbservice.SetGasLimitRequest{Pubkey: pubkey1[:], GasLimit: 40000000})
+	require.NoError(t, err)
+	settings := s.validatorService.ProposerSettings
+	assert.Equal(t, uint64(40000000), settings.GasLimit(pubkey1))
+	assert.Equal(t, true, settings.ProposeConfig[pubkey1].BuilderConfig.Enabled)
+	assert.Equal(t, settings.DefaultConfig.FeeRecipient, settings.ProposeConfig[pubkey1].FeeRecipient)
+	// Other keys keep the default gas limit.
+	assert.Equal(t, uint64(30000000), settings.GasLimit(pubkey2))
+	assert.Equal(t, uint64(30000000), defaultBuilderConfig.GasLimit)
+
+	override, err := valDB.ProposerSettingsOverride(ctx, pubkey1)
+	require.NoError(t, err)
+	require.NotNil(t, override.GasLimit)
+	assert.Equal(t, uint64(40000000), *override.GasLimit)
+}
+
+func TestServer_DeleteGasLimit(t *testing.T) {
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
+	pubkey1 := bytesutil.ToBytes48([]byte{1})
+	pubkey2 := bytesutil.ToBytes48([]byte{2})
+	gasLimit := uint64(50000000)
+	valDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{})
+	require.NoError(t, valDB.SaveProposerSettingsOverride(ctx, pubkey1, &validatorserviceconfig.ProposerOptionOverride{GasLimit: &gasLimit}))
+	require.NoError(t, valDB.SaveProposerSettingsOverride(ctx, pubkey2, &validatorserviceconfig.ProposerOptionOverride{GasLimit: &gasLimit}))
+	vs, err := client.NewValidatorService(ctx, &client.Config{
+		Validator: &mock.MockValidator{},
+		ValDB:     valDB,
+		ProposerSettings: &validatorserviceconfig.ProposerSettings{
+			ProposeConfig: map[[48]byte]*validatorserviceconfig.ProposerOption{
+				pubkey1: {BuilderConfig: &validatorserviceconfig.BuilderConfig{Enabled: true, GasLimit: 40000000}},
+			},
+			DefaultConfig: &validatorserviceconfig.ProposerOption{
+				BuilderConfig: &validatorserviceconfig.BuilderConfig{Enabled: true, GasLimit: 35000000},
+			},
+		},
+	})
+	require.NoError(t, err)
+	s := &Server{
+		validatorService: vs,
+	}
+	assert.Equal(t, gasLimit, s.validatorService.ProposerSettings.GasLimit(pubkey1))
+	assert.Equal(t, gasLimit, s.validatorService.ProposerSettings.GasLimit(pubkey2))
+
+	// The gas limit of the settings file applies again.
+	_, err = s.DeleteGasLimit(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubkey1[:]})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(40000000), s.validatorService.ProposerSettings.GasLimit(pubkey1))
+
+	// The default gas limit applies again.
+	_, err = s.DeleteGasLimit(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubkey2[:]})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(35000000), s.validatorService.ProposerSettings.GasLimit(pubkey2))
+
+	overrides, err := valDB.ProposerSettingsOverrides(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(overrides))
+}
@@ -10,8 +10,10 @@ import (
 	"github.com/pkg/errors"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/monitoring/progress"
+	"github.com/prysmaticlabs/prysm/time/slots"
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/slashing-protection-history/format"
 )
@@ -23,6 +25,33 @@ func ExportStandardProtectionJSON(
 	validatorDB db.Database,
 	filteredKeys ...[]byte,
 ) (*format.EIPSlashingProtectionFormat, error) {
+	return exportStandardProtectionJSON(ctx, validatorDB, 0, filteredKeys)
+}
+
+// ExportStandardProtectionJSONSince extracts the slashing protection data signed since an epoch from a
+// validator database, that is the attestations with a target epoch and the blocks with a slot no older than
+// the epoch, and packages it into an EIP-3076 compliant, standard JSON. Public keys without any data since
+// the epoch are left out. Importing such an incremental export is safe, as validator clients refuse to sign
+// anything older than the oldest data in their slashing protection history.
+func ExportStandardProtectionJSONSince(
+	ctx context.Context,
+	validatorDB db.Database,
+	sinceEpoch types.Epoch,
+	filteredKeys ...[]byte,
+) (*format.EIPSlashingProtectionFormat, error) {
+	return exportStandardProtectionJSON(ctx, validatorDB, sinceEpoch, filteredKeys)
+}
+
+func exportStandardProtectionJSON(
+	ctx context.Context,
+	validatorDB db.Database,
+	sinceEpoch types.Epoch,
+	filteredKeys [][]byte,
+) (*format.EIPSlashingProtectionFormat, error) {
+	sinceSlot, err := slots.EpochStart(sinceEpoch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get start slot of epoch %d", sinceEpoch)
+	}
 	interchangeJSON := &format.EIPSlashingProtectionFormat{}
 	genesisValidatorsRoot, err := validatorDB.GenesisValidatorsRoot(ctx)
 	if err != nil {
@@ -69,7 +98,7 @@ func ExportStandardProtectionJSON(
 		if err != nil {
 			return nil, errors.Wrap(err, "could not convert public key to hex string")
 		}
-		signedBlocks, err := signedBlocksByPubKey(ctx, validatorDB, pubKey)
+		signedBlocks, err := signedBlocksByPubKey(ctx, validatorDB, pubKey, sinceSlot)
 		if err != nil {
 			return nil, errors.Wrapf(err, "could not retrieve signed blocks for public key %s", pubKeyHex)
 		}
@@ -95,7 +124,7 @@ func ExportStandardProtectionJSON(
 		if err != nil {
 			return nil, errors.Wrap(err, "could not convert public key to hex string")
 		}
-		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKey)
+		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKey, sinceEpoch)
 		if err != nil {
 			return nil, errors.Wrapf(err, "could not retrieve signed attestations for public key %s", pubKeyHex)
 		}
@@ -116,6 +145,10 @@ func ExportStandardProtectionJSON(
 	// Next we turn our map into a slice as expected by the EIP-3076 JSON standard.
 	dataList := make([]*format.ProtectionData, 0)
 	for _, item := range dataByPubKey {
+		// An incremental export leaves out the public keys without any data since the epoch.
+		if sinceEpoch > 0 && len(item.SignedAttestations) == 0 && len(item.SignedBlocks) == 0 {
+			continue
+		}
 		if item.SignedAttestations == nil {
 			item.SignedAttestations = make([]*format.SignedAttestation, 0)
 		}
@@ -131,7 +164,9 @@ func ExportStandardProtectionJSON(
 	return interchangeJSON, nil
 }
 
-func signedAttestationsByPubKey(ctx context.Context, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte) ([]*format.SignedAttestation, error) {
+func signedAttestationsByPubKey(
+	ctx context.Context, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte, sinceEpoch types.Epoch,
+) ([]*format.SignedAttestation, error) {
 	// If a key does not have an attestation history in our database, we return nil.
 	// This way, a user will be able to export their slashing protection history
 	// even if one of their keys does not have a history of signed attestations.
@@ -157,6 +192,9 @@ func signedAttestationsByPubKey(ctx context.Context, validatorDB db.Database, pu
 				continue
 			}
 		}
+		if att.Target < sinceEpoch {
+			continue
+		}
 		var root string
 		if !bytes.Equal(att.SigningRoot[:], params.BeaconConfig().ZeroHash[:]) {
 			root, err = rootToHexString(att.SigningRoot[:])
@@ -173,7 +211,9 @@ func signedAttestationsByPubKey(ctx context.Context, validatorDB db.Database, pu
 	return signedAttestations, nil
 }
 
-func signedBlocksByPubKey(ctx context.Context, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte) ([]*format.SignedBlock, error) {
+func signedBlocksByPubKey(
+	ctx context.Context, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte, sinceSlot types.Slot,
+) ([]*format.SignedBlock, error) {
 	// If a key does not have a lowest or highest signed proposal history
 	// in our database, we return nil. This way, a user will be able to export their
 	// slashing protection history even if one of their keys does not have a history
@@ -187,6 +227,9 @@ func signedBlocksByPubKey(ctx context.Context, validatorDB db.Database, pubKey [
 		if ctx.Err() != nil {
 			return nil, errors.Wrap(err, "context canceled")
 		}
+		if proposal.Slot < sinceSlot {
+			continue
+		}
 		signingRootHex, err := rootToHexString(proposal.SigningRoot)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not convert signing root to hex string")
@@ -37,7 +37,7 @@ func Test_getSignedAttestationsByPubKey(t *testing.T) {
 		validatorDB := dbtest.SetupDB(t, pubKeys)
 
 		// No attestation history stored should return empty.
-		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0])
+		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0], 0)
 		require.NoError(t, err)
 		assert.Equal(t, 0, len(signedAttestations))
 
@@ -55,7 +55,7 @@ func Test_getSignedAttestationsByPubKey(t *testing.T) {
 		)))
 
 		// We then retrieve the signed attestations and expect a correct result.
-		signedAttestations, err = signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0])
+		signedAttestations, err = signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0], 0)
 		require.NoError(t, err)
 
 		wanted := []*format.SignedAttestation{
@@ -80,7 +80,7 @@ func Test_getSignedAttestationsByPubKey(t *testing.T) {
 		validatorDB := dbtest.SetupDB(t, pubKeys)
 
 		// No attestation history stored should return empty.
-		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0])
+		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0], 0)
 		require.NoError(t, err)
 		assert.Equal(t, 0, len(signedAttestations))
 
@@ -102,7 +102,7 @@ func Test_getSignedAttestationsByPubKey(t *testing.T) {
 
 		// We then retrieve the signed attestations and expect to have
 		// skipped the 0th, corrupted entry.
-		signedAttestations, err = signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0])
+		signedAttestations, err = signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0], 0)
 		require.NoError(t, err)
 
 		wanted := []*format.SignedAttestation{
@@ -122,7 +122,7 @@ func Test_getSignedAttestationsByPubKey(t *testing.T) {
 		validatorDB := dbtest.SetupDB(t, pubKeys)
 
 		// No attestation history stored should return empty.
-		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0])
+		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0], 0)
 		require.NoError(t, err)
 		assert.Equal(t, 0, len(signedAttestations))
 
@@ -144,7 +144,7 @@ func Test_getSignedAttestationsByPubKey(t *testing.T) {
 
 		// We then retrieve the signed attestations and do not expect changes
 		// as the bug only manifests in the genesis epoch.
-		signedAttestations, err = signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0])
+		signedAttestations, err = signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0], 0)
 		require.NoError(t, err)
 
 		wanted := []*format.SignedAttestation{
@@ -171,7 +171,7 @@ func Test_getSignedBlocksByPubKey(t *testing.T) {
 	validatorDB := dbtest.SetupDB(t, pubKeys)
 
 	// No highest and/or lowest signed blocks will return empty.
-	signedBlocks, err := signedBlocksByPubKey(ctx, validatorDB, pubKeys[0])
+	signedBlocks, err := signedBlocksByPubKey(ctx, validatorDB, pubKeys[0], 0)
 	require.NoError(t, err)
 	assert.Equal(t, 0, len(signedBlocks))
 
@@ -191,7 +191,7 @@ func Test_getSignedBlocksByPubKey(t *testing.T) {
 
 	// We expect a valid proposal history containing slot 1 and slot 5 only
 	// when we attempt to retrieve it from disk.
-	signedBlocks, err = signedBlocksByPubKey(ctx, validatorDB, pubKeys[0])
+	signedBlocks, err = signedBlocksByPubKey(ctx, validatorDB, pubKeys[0], 0)
 	require.NoError(t, err)
 	wanted := []*format.SignedBlock{
 		{
@@ -211,3 +211,54 @@ func Test_getSignedBlocksByPubKey(t *testing.T) {
 		assert.DeepEqual(t, blk, signedBlocks[i])
 	}
 }
+
+func TestExportStandardProtectionJSONSince(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := [][fieldparams.BLSPubkeyLength]byte{
+		{1},
+		{2},
+	}
+	validatorDB := dbtest.SetupDB(t, pubKeys)
+	genesisValidatorsRoot := [32]byte{1}
+	require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, genesisValidatorsRoot[:]))
+
+	// The first public key signed attestations and blocks before and since epoch 2,
+	// the second one only signed an attestation before epoch 2.
+	for target := types.Epoch(1); target <= 3; target++ {
+		require.NoError(t, validatorDB.SaveAttestationForPubKey(
+			ctx, pubKeys[0], [32]byte{byte(target)}, createAttestation(target-1, target),
+		))
+	}
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKeys[1], [32]byte{1}, createAttestation(0, 1)))
+	dummyRoot := [32]byte{1}
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKeys[0], 40, dummyRoot[:]))
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKeys[0], 70, dummyRoot[:]))
+
+	interchangeJSON, err := ExportStandardProtectionJSONSince(ctx, validatorDB, 2)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(interchangeJSON.Data))
+	assert.Equal(t, fmt.Sprintf("%#x", pubKeys[0]), interchangeJSON.Data[0].Pubkey)
+	assert.DeepEqual(t, []*format.SignedBlock{
+		{
+			Slot:        "70",
+			SigningRoot: fmt.Sprintf("%#x", dummyRoot),
+		},
+	}, interchangeJSON.Data[0].SignedBlocks)
+	assert.DeepEqual(t, []*format.SignedAttestation{
+		{
+			SourceEpoch: "1",
+			TargetEpoch: "2",
+			SigningRoot: "0x0200000000000000000000000000000000000000000000000000000000000000",
+		},
+		{
+			SourceEpoch: "2",
+			TargetEpoch: "3",
+			SigningRoot: "0x0300000000000000000000000000000000000000000000000000000000000000",
+		},
+	}, interchangeJSON.Data[0].SignedAttestations)
+
+	// A full export keeps every public key.
+	interchangeJSON, err = ExportStandardProtectionJSON(ctx, validatorDB)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(interchangeJSON.Data))
+}
@@ -0,0 +1,192 @@
+package history
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection-history/format"
+	"github.com/sirupsen/logrus"
+)
+
+// MergeStandardProtectionJSON merges EIP-3076 slashing protection JSONs created on the same chain, such as
+// the exports of several machines or incremental exports, into a single one. The histories of a public key
+// appearing in several JSONs are merged, without duplicate entries. If the merged history of a public key is
+// slashable, the JSONs disagree on what was signed and the history is replaced by its minimal form from the
+// standard: a single block at the highest signed slot and a single attestation with the highest signed source
+// and target epochs, without signing roots, which prevents signing anything older.
+func MergeStandardProtectionJSON(
+	ctx context.Context,
+	interchangeJSONs ...*format.EIPSlashingProtectionFormat,
+) (*format.EIPSlashingProtectionFormat, error) {
+	if len(interchangeJSONs) == 0 {
+		return nil, errors.New("no slashing protection JSON to merge")
+	}
+	var genesisValidatorsRoot [32]byte
+	data := make([]*format.ProtectionData, 0)
+	for i, interchangeJSON := range interchangeJSONs {
+		gvr, err := interchangeGenesisValidatorsRoot(interchangeJSON)
+		if err != nil {
+			return nil, errors.Wrapf(err, "slashing protection JSON %d metadata was incorrect", i)
+		}
+		if i == 0 {
+			genesisValidatorsRoot = gvr
+		} else if gvr != genesisValidatorsRoot {
+			return nil, fmt.Errorf(
+				"genesis validators root %#x of slashing protection JSON %d does not match %#x, "+
+					"slashing protection JSONs of different chains cannot be merged",
+				gvr,
+				i,
+				genesisValidatorsRoot,
+			)
+		}
+		data = append(data, interchangeJSON.Data...)
+	}
+	histories, err := parseProtectionHistories(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	dataList := make([]*format.ProtectionData, 0, len(histories))
+	for _, pubKey := range sortedPubKeys(histories) {
+		history := deduplicateHistory(histories[pubKey])
+		if violations := findViolations(pubKey, history); len(violations) > 0 {
+			log.WithFields(logrus.Fields{
+				"publicKey":  fmt.Sprintf("%#x", pubKey),
+				"violations": len(violations),
+			}).Warn("Slashing protection histories of public key conflict, keeping its minimal history")
+			history = minimalHistory(history)
+		}
+		protectionData, err := historyToProtectionData(pubKey, history)
+		if err != nil {
+			return nil, err
+		}
+		dataList = append(dataList, protectionData)
+	}
+
+	interchangeJSON := &format.EIPSlashingProtectionFormat{Data: dataList}
+	interchangeJSON.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	interchangeJSON.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", genesisValidatorsRoot)
+	return interchangeJSON, nil
+}
+
+// deduplicateHistory removes the blocks and attestations appearing several times in a slashing protection
+// history, and sorts them by slot and target epoch.
+func deduplicateHistory(history *protectionHistory) *protectionHistory {
+	type blockKey struct {
+		slot        types.Slot
+		signingRoot [32]byte
+	}
+	seenBlocks := make(map[blockKey]bool)
+	proposals := make([]kv.Proposal, 0, len(history.proposals))
+	for _, proposal := range history.proposals {
+		key := blockKey{slot: proposal.Slot}
+		copy(key.signingRoot[:], proposal.SigningRoot)
+		if seenBlocks[key] {
+			continue
+		}
+		seenBlocks[key] = true
+		proposals = append(proposals, proposal)
+	}
+	sort.SliceStable(proposals, func(i, j int) bool {
+		return proposals[i].Slot < proposals[j].Slot
+	})
+
+	type attestationKey struct {
+		source, target types.Epoch
+		signingRoot    [32]byte
+	}
+	seenAtts := make(map[attestationKey]bool)
+	attestations := make([]*kv.AttestationRecord, 0, len(history.attestations))
+	for _, att := range history.attestations {
+		key := attestationKey{source: att.Source, target: att.Target, signingRoot: att.SigningRoot}
+		if seenAtts[key] {
+			continue
+		}
+		seenAtts[key] = true
+		attestations = append(attestations, att)
+	}
+	sort.SliceStable(attestations, func(i, j int) bool {
+		if attestations[i].Target == attestations[j].Target {
+			return attestations[i].Source < attestations[j].Source
+		}
+		return attestations[i].Target < attestations[j].Target
+	})
+	return &protectionHistory{proposals: proposals, attestations: attestations}
+}
+
+// minimalHistory returns the minimal form of a slashing protection history, as defined by EIP-3076.
+func minimalHistory(history *protectionHistory) *protectionHistory {
+	minimal := &protectionHistory{}
+	if len(history.proposals) > 0 {
+		var maxSlot types.Slot
+		for _, proposal := range history.proposals {
+			if proposal.Slot > maxSlot {
+				maxSlot = proposal.Slot
+			}
+		}
+		minimal.proposals = []kv.Proposal{{Slot: maxSlot}}
+	}
+	if len(history.attestations) > 0 {
+		var maxSource, maxTarget types.Epoch
+		for _, att := range history.attestations {
+			if att.Source > maxSource {
+				maxSource = att.Source
+			}
+			if att.Target > maxTarget {
+				maxTarget = att.Target
+			}
+		}
+		minimal.attestations = []*kv.AttestationRecord{{
+			PubKey: history.attestations[0].PubKey,
+			Source: maxSource,
+			Target: maxTarget,
+		}}
+	}
+	return minimal
+}
+
+func historyToProtectionData(
+	pubKey [fieldparams.BLSPubkeyLength]byte, history *protectionHistory,
+) (*format.ProtectionData, error) {
+	pubKeyHex, err := pubKeyToHexString(pubKey[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert public key to hex string")
+	}
+	signedBlocks := make([]*format.SignedBlock, len(history.proposals))
+	for i, proposal := range history.proposals {
+		var signingRoot string
+		if len(proposal.SigningRoot) > 0 && !bytes.Equal(proposal.SigningRoot, make([]byte, 32)) {
+			signingRoot, err = rootToHexString(proposal.SigningRoot)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not convert signing root to hex string")
+			}
+		}
+		signedBlocks[i] = &format.SignedBlock{
+			Slot:        fmt.Sprintf("%d", proposal.Slot),
+			SigningRoot: signingRoot,
+		}
+	}
+	signedAttestations := make([]*format.SignedAttestation, len(history.attestations))
+	for i, att := range history.attestations {
+		var signingRoot string
+		if att.SigningRoot != [32]byte{} {
+			signingRoot = fmt.Sprintf("%#x", att.SigningRoot)
+		}
+		signedAttestations[i] = &format.SignedAttestation{
+			SourceEpoch: fmt.Sprintf("%d", att.Source),
+			TargetEpoch: fmt.Sprintf("%d", att.Target),
+			SigningRoot: signingRoot,
+		}
+	}
+	return &format.ProtectionData{
+		Pubkey:             pubKeyHex,
+		SignedBlocks:       signedBlocks,
+		SignedAttestations: signedAttestations,
+	}, nil
+}
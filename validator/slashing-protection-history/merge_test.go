@@ -0,0 +1,103 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection-history/format"
+)
+
+func TestMergeStandardProtectionJSON(t *testing.T) {
+	ctx := context.Background()
+	genesisValidatorsRoot := [32]byte{1}
+	pubKey := fmt.Sprintf("%#x", [fieldparams.BLSPubkeyLength]byte{1})
+	conflictingPubKey := fmt.Sprintf("%#x", [fieldparams.BLSPubkeyLength]byte{2})
+	root := func(b byte) string {
+		return fmt.Sprintf("%#x", [32]byte{b})
+	}
+
+	first := interchangeWithData(genesisValidatorsRoot,
+		&format.ProtectionData{
+			Pubkey:       pubKey,
+			SignedBlocks: []*format.SignedBlock{{Slot: "1", SigningRoot: root(1)}},
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "0", TargetEpoch: "1", SigningRoot: root(1)},
+				{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: root(2)},
+			},
+		},
+		&format.ProtectionData{
+			Pubkey:       conflictingPubKey,
+			SignedBlocks: []*format.SignedBlock{{Slot: "5", SigningRoot: root(1)}},
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: root(1)},
+			},
+		},
+	)
+	second := interchangeWithData(genesisValidatorsRoot,
+		&format.ProtectionData{
+			Pubkey:       pubKey,
+			SignedBlocks: []*format.SignedBlock{{Slot: "3", SigningRoot: root(3)}},
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: root(2)},
+				{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: root(3)},
+			},
+		},
+		&format.ProtectionData{
+			Pubkey: conflictingPubKey,
+			SignedBlocks: []*format.SignedBlock{
+				{Slot: "5", SigningRoot: root(2)},
+				{Slot: "4", SigningRoot: root(2)},
+			},
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "1", TargetEpoch: "4", SigningRoot: root(2)},
+			},
+		},
+	)
+
+	merged, err := MergeStandardProtectionJSON(ctx, first, second)
+	require.NoError(t, err)
+	assert.Equal(t, format.InterchangeFormatVersion, merged.Metadata.InterchangeFormatVersion)
+	assert.Equal(t, fmt.Sprintf("%#x", genesisValidatorsRoot), merged.Metadata.GenesisValidatorsRoot)
+	require.Equal(t, 2, len(merged.Data))
+	// The histories are merged without duplicates.
+	assert.DeepEqual(t, &format.ProtectionData{
+		Pubkey: pubKey,
+		SignedBlocks: []*format.SignedBlock{
+			{Slot: "1", SigningRoot: root(1)},
+			{Slot: "3", SigningRoot: root(3)},
+		},
+		SignedAttestations: []*format.SignedAttestation{
+			{SourceEpoch: "0", TargetEpoch: "1", SigningRoot: root(1)},
+			{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: root(2)},
+			{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: root(3)},
+		},
+	}, merged.Data[0])
+	// Conflicting histories are replaced by the minimal history.
+	assert.DeepEqual(t, &format.ProtectionData{
+		Pubkey:       conflictingPubKey,
+		SignedBlocks: []*format.SignedBlock{{Slot: "5"}},
+		SignedAttestations: []*format.SignedAttestation{
+			{SourceEpoch: "2", TargetEpoch: "4"},
+		},
+	}, merged.Data[1])
+
+	violations, err := VerifyStandardProtectionJSON(ctx, merged, genesisValidatorsRoot)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(violations))
+}
+
+func TestMergeStandardProtectionJSON_DifferentChains(t *testing.T) {
+	_, err := MergeStandardProtectionJSON(
+		context.Background(),
+		interchangeWithData([32]byte{1}),
+		interchangeWithData([32]byte{2}),
+	)
+	require.ErrorContains(t, "cannot be merged", err)
+
+	_, err = MergeStandardProtectionJSON(context.Background())
+	require.ErrorContains(t, "no slashing protection JSON to merge", err)
+}
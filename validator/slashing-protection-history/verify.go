@@ -0,0 +1,212 @@
+package history
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/slashings"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection-history/format"
+)
+
+// ViolationKind is the kind of slashable offence found within a slashing protection history.
+type ViolationKind string
+
+const (
+	// DoubleProposal is two different blocks signed at the same slot.
+	DoubleProposal ViolationKind = "double proposal"
+	// DoubleVote is two different attestations signed with the same target epoch.
+	DoubleVote ViolationKind = "double vote"
+	// SurroundVote is an attestation signed with a source and target epoch surrounding those of another.
+	SurroundVote ViolationKind = "surround vote"
+)
+
+// Violation is a slashable offence found within the slashing protection history of a public key.
+type Violation struct {
+	PubKey      [fieldparams.BLSPubkeyLength]byte
+	Kind        ViolationKind
+	Description string
+}
+
+// String describes the violation.
+func (v *Violation) String() string {
+	return fmt.Sprintf("%s by %#x: %s", v.Kind, v.PubKey, v.Description)
+}
+
+// protectionHistory is the slashing protection history of a public key within an EIP-3076 slashing protection
+// JSON, in Prysm's internal representation.
+type protectionHistory struct {
+	proposals    []kv.Proposal
+	attestations []*kv.AttestationRecord
+}
+
+// VerifyStandardProtectionJSON checks that an EIP-3076 slashing protection JSON is well formed and was created
+// on the chain of the genesis validators root, and reports the double proposals, double votes and surround
+// votes found within the slashing protection history of each of its public keys. As signing roots are optional
+// in the standard, two blocks or attestations are only considered the same if they have the same, non-empty
+// signing root.
+func VerifyStandardProtectionJSON(
+	ctx context.Context,
+	interchangeJSON *format.EIPSlashingProtectionFormat,
+	genesisValidatorsRoot [32]byte,
+) ([]*Violation, error) {
+	gvr, err := interchangeGenesisValidatorsRoot(interchangeJSON)
+	if err != nil {
+		return nil, err
+	}
+	if gvr != genesisValidatorsRoot {
+		return nil, fmt.Errorf(
+			"genesis validators root %#x of the slashing protection JSON does not match the expected %#x",
+			gvr,
+			genesisValidatorsRoot,
+		)
+	}
+	histories, err := parseProtectionHistories(ctx, interchangeJSON.Data)
+	if err != nil {
+		return nil, err
+	}
+	violations := make([]*Violation, 0)
+	for _, pubKey := range sortedPubKeys(histories) {
+		violations = append(violations, findViolations(pubKey, histories[pubKey])...)
+	}
+	return violations, nil
+}
+
+// interchangeGenesisValidatorsRoot checks that the version of an EIP-3076 slashing protection JSON is supported
+// and returns its genesis validators root.
+func interchangeGenesisValidatorsRoot(interchangeJSON *format.EIPSlashingProtectionFormat) ([32]byte, error) {
+	version := interchangeJSON.Metadata.InterchangeFormatVersion
+	if version != format.InterchangeFormatVersion {
+		return [32]byte{}, fmt.Errorf(
+			"slashing protection JSON version '%s' is not supported, wanted '%s'",
+			version,
+			format.InterchangeFormatVersion,
+		)
+	}
+	gvr, err := RootFromHex(interchangeJSON.Metadata.GenesisValidatorsRoot)
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("%s is not a valid root: %w", interchangeJSON.Metadata.GenesisValidatorsRoot, err)
+	}
+	return gvr, nil
+}
+
+// parseProtectionHistories parses the slashing protection history of each public key of an EIP-3076 slashing
+// protection JSON, merging the entries of public keys appearing several times.
+func parseProtectionHistories(
+	ctx context.Context,
+	data []*format.ProtectionData,
+) (map[[fieldparams.BLSPubkeyLength]byte]*protectionHistory, error) {
+	signedBlocksByPubKey, err := parseBlocksForUniquePublicKeys(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse unique entries for blocks by public key")
+	}
+	signedAttsByPubKey, err := parseAttestationsForUniquePublicKeys(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse unique entries for attestations by public key")
+	}
+	histories := make(map[[fieldparams.BLSPubkeyLength]byte]*protectionHistory)
+	for pubKey, signedBlocks := range signedBlocksByPubKey {
+		proposalHistory, err := transformSignedBlocks(ctx, signedBlocks)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse signed blocks in JSON file for key %#x", pubKey)
+		}
+		histories[pubKey] = &protectionHistory{proposals: proposalHistory.Proposals}
+	}
+	for pubKey, signedAtts := range signedAttsByPubKey {
+		attestations, err := transformSignedAttestations(pubKey, signedAtts)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse signed attestations in JSON file for key %#x", pubKey)
+		}
+		if _, ok := histories[pubKey]; !ok {
+			histories[pubKey] = &protectionHistory{}
+		}
+		histories[pubKey].attestations = attestations
+	}
+	return histories, nil
+}
+
+// findViolations returns the slashable offences within the slashing protection history of a public key.
+func findViolations(pubKey [fieldparams.BLSPubkeyLength]byte, history *protectionHistory) []*Violation {
+	violations := make([]*Violation, 0)
+	violation := func(kind ViolationKind, msg string, args ...interface{}) {
+		violations = append(violations, &Violation{
+			PubKey:      pubKey,
+			Kind:        kind,
+			Description: fmt.Sprintf(msg, args...),
+		})
+	}
+
+	// Signing roots are optional, so a block without signing root at the same slot as
+	// another block is considered a double proposal.
+	signingRootsBySlot := make(map[types.Slot][]byte)
+	for _, proposal := range history.proposals {
+		if signingRoot, ok := signingRootsBySlot[proposal.Slot]; ok {
+			if bytes.Equal(signingRoot, make([]byte, 32)) || !bytes.Equal(signingRoot, proposal.SigningRoot) {
+				violation(DoubleProposal, "two blocks signed at slot %d", proposal.Slot)
+			}
+			continue
+		}
+		signingRootsBySlot[proposal.Slot] = proposal.SigningRoot
+	}
+
+	signingRootsByTarget := make(map[types.Epoch][32]byte)
+	for _, att := range history.attestations {
+		if signingRoot, ok := signingRootsByTarget[att.Target]; ok {
+			if slashings.SigningRootsDiffer(signingRoot, att.SigningRoot) {
+				violation(DoubleVote, "two attestations signed with target epoch %d", att.Target)
+			}
+			continue
+		}
+		signingRootsByTarget[att.Target] = att.SigningRoot
+	}
+
+	// An attestation is surrounded by another one with a smaller source epoch and a greater target
+	// epoch. Sweeping the attestations by increasing source epoch, an attestation is surrounded if the
+	// greatest target epoch of the attestations with a smaller source epoch is greater than its own.
+	atts := make([]*kv.AttestationRecord, len(history.attestations))
+	copy(atts, history.attestations)
+	sort.Slice(atts, func(i, j int) bool {
+		return atts[i].Source < atts[j].Source
+	})
+	var surrounding *kv.AttestationRecord
+	for i := 0; i < len(atts); {
+		j := i
+		for ; j < len(atts) && atts[j].Source == atts[i].Source; j++ {
+			if surrounding != nil && atts[j].Target < surrounding.Target {
+				violation(
+					SurroundVote,
+					"attestation with source epoch %d and target epoch %d surrounds attestation with "+
+						"source epoch %d and target epoch %d",
+					surrounding.Source,
+					surrounding.Target,
+					atts[j].Source,
+					atts[j].Target,
+				)
+			}
+		}
+		for ; i < j; i++ {
+			if surrounding == nil || atts[i].Target > surrounding.Target {
+				surrounding = atts[i]
+			}
+		}
+	}
+	return violations
+}
+
+func sortedPubKeys(
+	histories map[[fieldparams.BLSPubkeyLength]byte]*protectionHistory,
+) [][fieldparams.BLSPubkeyLength]byte {
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(histories))
+	for pubKey := range histories {
+		pubKeys = append(pubKeys, pubKey)
+	}
+	sort.Slice(pubKeys, func(i, j int) bool {
+		return bytes.Compare(pubKeys[i][:], pubKeys[j][:]) < 0
+	})
+	return pubKeys
+}
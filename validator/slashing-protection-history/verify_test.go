@@ -0,0 +1,121 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection-history/format"
+)
+
+func interchangeWithData(genesisValidatorsRoot [32]byte, data ...*format.ProtectionData) *format.EIPSlashingProtectionFormat {
+	interchangeJSON := &format.EIPSlashingProtectionFormat{Data: data}
+	interchangeJSON.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	interchangeJSON.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", genesisValidatorsRoot)
+	return interchangeJSON
+}
+
+func TestVerifyStandardProtectionJSON(t *testing.T) {
+	ctx := context.Background()
+	genesisValidatorsRoot := [32]byte{1}
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	otherPubKey := [fieldparams.BLSPubkeyLength]byte{2}
+	root := func(b byte) string {
+		return fmt.Sprintf("%#x", [32]byte{b})
+	}
+
+	t.Run("wrong genesis validators root", func(t *testing.T) {
+		_, err := VerifyStandardProtectionJSON(ctx, interchangeWithData(genesisValidatorsRoot), [32]byte{2})
+		require.ErrorContains(t, "does not match", err)
+	})
+	t.Run("unsupported version", func(t *testing.T) {
+		interchangeJSON := interchangeWithData(genesisValidatorsRoot)
+		interchangeJSON.Metadata.InterchangeFormatVersion = "4"
+		_, err := VerifyStandardProtectionJSON(ctx, interchangeJSON, genesisValidatorsRoot)
+		require.ErrorContains(t, "is not supported", err)
+	})
+	t.Run("malformed data", func(t *testing.T) {
+		interchangeJSON := interchangeWithData(genesisValidatorsRoot, &format.ProtectionData{
+			Pubkey:       fmt.Sprintf("%#x", pubKey),
+			SignedBlocks: []*format.SignedBlock{{Slot: "abc"}},
+		})
+		_, err := VerifyStandardProtectionJSON(ctx, interchangeJSON, genesisValidatorsRoot)
+		require.ErrorContains(t, "could not parse signed blocks", err)
+	})
+	t.Run("not slashable", func(t *testing.T) {
+		interchangeJSON := interchangeWithData(genesisValidatorsRoot, &format.ProtectionData{
+			Pubkey: fmt.Sprintf("%#x", pubKey),
+			SignedBlocks: []*format.SignedBlock{
+				{Slot: "1", SigningRoot: root(1)},
+				{Slot: "1", SigningRoot: root(1)},
+				{Slot: "2"},
+			},
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "0", TargetEpoch: "1", SigningRoot: root(1)},
+				{SourceEpoch: "0", TargetEpoch: "1", SigningRoot: root(1)},
+				{SourceEpoch: "1", TargetEpoch: "3"},
+				{SourceEpoch: "1", TargetEpoch: "2"},
+				{SourceEpoch: "3", TargetEpoch: "4"},
+			},
+		})
+		violations, err := VerifyStandardProtectionJSON(ctx, interchangeJSON, genesisValidatorsRoot)
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(violations))
+	})
+	t.Run("slashable", func(t *testing.T) {
+		interchangeJSON := interchangeWithData(genesisValidatorsRoot,
+			&format.ProtectionData{
+				Pubkey: fmt.Sprintf("%#x", pubKey),
+				SignedBlocks: []*format.SignedBlock{
+					{Slot: "1", SigningRoot: root(1)},
+					{Slot: "1", SigningRoot: root(2)},
+				},
+				SignedAttestations: []*format.SignedAttestation{
+					{SourceEpoch: "1", TargetEpoch: "2"},
+					{SourceEpoch: "0", TargetEpoch: "5"},
+				},
+			},
+			&format.ProtectionData{
+				Pubkey: fmt.Sprintf("%#x", otherPubKey),
+				SignedAttestations: []*format.SignedAttestation{
+					{SourceEpoch: "0", TargetEpoch: "1"},
+					{SourceEpoch: "0", TargetEpoch: "1"},
+				},
+			},
+			// A public key appearing several times has its histories merged.
+			&format.ProtectionData{
+				Pubkey: fmt.Sprintf("%#x", pubKey),
+				SignedAttestations: []*format.SignedAttestation{
+					{SourceEpoch: "2", TargetEpoch: "5"},
+				},
+			},
+		)
+		violations, err := VerifyStandardProtectionJSON(ctx, interchangeJSON, genesisValidatorsRoot)
+		require.NoError(t, err)
+		require.Equal(t, 4, len(violations))
+		assert.DeepEqual(t, &Violation{
+			PubKey:      pubKey,
+			Kind:        DoubleProposal,
+			Description: "two blocks signed at slot 1",
+		}, violations[0])
+		assert.DeepEqual(t, &Violation{
+			PubKey:      pubKey,
+			Kind:        DoubleVote,
+			Description: "two attestations signed with target epoch 5",
+		}, violations[1])
+		assert.DeepEqual(t, &Violation{
+			PubKey:      pubKey,
+			Kind:        SurroundVote,
+			Description: "attestation with source epoch 0 and target epoch 5 surrounds attestation with source epoch 1 and target epoch 2",
+		}, violations[2])
+		// Without signing roots, the same attestation signed twice is a double vote.
+		assert.DeepEqual(t, &Violation{
+			PubKey:      otherPubKey,
+			Kind:        DoubleVote,
+			Description: "two attestations signed with target epoch 1",
+		}, violations[3])
+	})
+}
@@ -0,0 +1,87 @@
+package slashingprotection
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	prysmTime "github.com/prysmaticlabs/prysm/time"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// leasedKey is the lease of a public key. Its lock is held while checking and saving the messages signed for the
+// public key, so that the lease and the slashing protection history of the public key are checked and updated
+// atomically. Leases are persisted in the validator database, so that they survive restarts of the server.
+type leasedKey struct {
+	sync.Mutex
+	holder string
+	expiry time.Time
+}
+
+// leasedKey returns the lease of the public key, which is read from the validator database the first time.
+func (s *Server) leasedKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (*leasedKey, error) {
+	s.keysLock.Lock()
+	defer s.keysLock.Unlock()
+	k, ok := s.keys[pubKey]
+	if ok {
+		return k, nil
+	}
+	holder, expiry, err := s.valDB.Lease(ctx, pubKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not get lease: %v", err)
+	}
+	k = &leasedKey{holder: holder, expiry: expiry}
+	s.keys[pubKey] = k
+	return k, nil
+}
+
+// leaseLocked leases the public key to the holder, or renews its lease, unless another holder holds an unexpired
+// lease of the public key. The caller must hold the lock of the lease.
+func (s *Server) leaseLocked(
+	ctx context.Context, k *leasedKey, pubKey [fieldparams.BLSPubkeyLength]byte, holder string,
+) error {
+	if holder == "" {
+		return status.Error(codes.InvalidArgument, "lease holder is required")
+	}
+	now := prysmTime.Now()
+	if k.holder != "" && k.holder != holder && now.Before(k.expiry) {
+		return status.Errorf(
+			codes.PermissionDenied,
+			"public key %#x is leased to %s until %s",
+			pubKey,
+			k.holder,
+			k.expiry.Format(time.RFC3339),
+		)
+	}
+	expiry := now.Add(s.leaseDuration)
+	if err := s.valDB.SaveLease(ctx, pubKey, holder, expiry); err != nil {
+		return status.Errorf(codes.Internal, "could not save lease: %v", err)
+	}
+	if k.holder != holder {
+		log.WithFields(logrus.Fields{
+			"publicKey":      fmt.Sprintf("%#x", pubKey),
+			"holder":         holder,
+			"previousHolder": k.holder,
+		}).Info("Leased public key")
+	}
+	k.holder = holder
+	k.expiry = expiry
+	return nil
+}
+
+// releaseLocked releases the lease of the public key. The caller must hold the lock of the lease.
+func (s *Server) releaseLocked(ctx context.Context, k *leasedKey, pubKey [fieldparams.BLSPubkeyLength]byte) error {
+	if err := s.valDB.SaveLease(ctx, pubKey, "", time.Time{}); err != nil {
+		return status.Errorf(codes.Internal, "could not release lease: %v", err)
+	}
+	log.WithFields(logrus.Fields{
+		"publicKey": fmt.Sprintf("%#x", pubKey),
+		"holder":    k.holder,
+	}).Info("Released public key lease")
+	k.holder = ""
+	return nil
+}
@@ -0,0 +1,244 @@
+package slashingprotection
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/slashings"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AcquireLease leases the public key to the holder, or renews its lease.
+func (s *Server) AcquireLease(ctx context.Context, req *validatorpb.LeaseRequest) (*validatorpb.Lease, error) {
+	pubKey, err := publicKey(req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	k, err := s.leasedKey(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	k.Lock()
+	defer k.Unlock()
+	if err := s.leaseLocked(ctx, k, pubKey, req.Holder); err != nil {
+		return nil, err
+	}
+	return &validatorpb.Lease{
+		PublicKey: pubKey[:],
+		Holder:    k.holder,
+		Expiry:    uint64(k.expiry.Unix()),
+	}, nil
+}
+
+// ReleaseLease releases the lease of the public key, if the holder holds it.
+func (s *Server) ReleaseLease(ctx context.Context, req *validatorpb.LeaseRequest) (*empty.Empty, error) {
+	pubKey, err := publicKey(req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	k, err := s.leasedKey(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	k.Lock()
+	defer k.Unlock()
+	if k.holder != "" && k.holder == req.Holder {
+		if err := s.releaseLocked(ctx, k, pubKey); err != nil {
+			return nil, err
+		}
+	}
+	return &empty.Empty{}, nil
+}
+
+// CheckAndSaveAttestation checks that the attestation is not slashable according to the slashing protection
+// history of the public key, and saves it, provided the holder holds the lease of the public key.
+func (s *Server) CheckAndSaveAttestation(
+	ctx context.Context, req *validatorpb.SlashableAttestationRequest,
+) (*empty.Empty, error) {
+	ctx, span := trace.StartSpan(ctx, "slashingprotection.CheckAndSaveAttestation")
+	defer span.End()
+
+	pubKey, err := publicKey(req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	signingRoot, err := signingRoot(req.SigningRoot)
+	if err != nil {
+		return nil, err
+	}
+	if req.SourceEpoch > req.TargetEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "source epoch %d is greater than target epoch %d", req.SourceEpoch, req.TargetEpoch,
+		)
+	}
+	k, err := s.leasedKey(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	k.Lock()
+	defer k.Unlock()
+	if err := s.leaseLocked(ctx, k, pubKey, req.Holder); err != nil {
+		return nil, err
+	}
+
+	att := &ethpb.IndexedAttestation{
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: types.Epoch(req.SourceEpoch)},
+			Target: &ethpb.Checkpoint{Epoch: types.Epoch(req.TargetEpoch)},
+		},
+	}
+	if err := s.checkAttestation(ctx, pubKey, signingRoot, att); err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"publicKey":   fmt.Sprintf("%#x", pubKey),
+			"holder":      req.Holder,
+			"sourceEpoch": req.SourceEpoch,
+			"targetEpoch": req.TargetEpoch,
+		}).Warn("Rejected slashable attestation")
+		return nil, err
+	}
+	if err := s.valDB.SaveAttestationForPubKey(ctx, pubKey, signingRoot, att); err != nil {
+		return nil, status.Errorf(codes.Internal, "could not save attestation history: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// checkAttestation checks the attestation against the slashing protection history of the public key, the same
+// way validator clients check it against their local history.
+func (s *Server) checkAttestation(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+) error {
+	// Based on EIP3076, refuse to sign any attestation with source epoch less than the minimum source epoch
+	// present in the attestations of the signer.
+	lowestSourceEpoch, exists, err := s.valDB.LowestSignedSourceEpoch(ctx, pubKey)
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not get lowest signed source epoch: %v", err)
+	}
+	if exists && att.Data.Source.Epoch < lowestSourceEpoch {
+		return status.Errorf(
+			codes.FailedPrecondition,
+			"could not sign attestation lower than lowest source epoch in db, %d < %d",
+			att.Data.Source.Epoch,
+			lowestSourceEpoch,
+		)
+	}
+	existingSigningRoot, err := s.valDB.SigningRootAtTargetEpoch(ctx, pubKey, att.Data.Target.Epoch)
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not get signing root at target epoch: %v", err)
+	}
+	// Based on EIP3076, refuse to sign any attestation with target epoch less than or equal to the minimum
+	// target epoch present in the attestations of the signer.
+	lowestTargetEpoch, exists, err := s.valDB.LowestSignedTargetEpoch(ctx, pubKey)
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not get lowest signed target epoch: %v", err)
+	}
+	if slashings.SigningRootsDiffer(existingSigningRoot, signingRoot) && exists && att.Data.Target.Epoch <= lowestTargetEpoch {
+		return status.Errorf(
+			codes.FailedPrecondition,
+			"could not sign attestation lower than or equal to lowest target epoch in db, %d <= %d",
+			att.Data.Target.Epoch,
+			lowestTargetEpoch,
+		)
+	}
+	if _, err := s.valDB.CheckSlashableAttestation(ctx, pubKey, signingRoot, att); err != nil {
+		return status.Errorf(codes.FailedPrecondition, "attestation is slashable: %v", err)
+	}
+	return nil
+}
+
+// CheckAndSaveProposal checks that the block proposal is not slashable according to the slashing protection
+// history of the public key, and saves it, provided the holder holds the lease of the public key.
+func (s *Server) CheckAndSaveProposal(
+	ctx context.Context, req *validatorpb.SlashableProposalRequest,
+) (*empty.Empty, error) {
+	ctx, span := trace.StartSpan(ctx, "slashingprotection.CheckAndSaveProposal")
+	defer span.End()
+
+	pubKey, err := publicKey(req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	signingRoot, err := signingRoot(req.SigningRoot)
+	if err != nil {
+		return nil, err
+	}
+	slot := types.Slot(req.Slot)
+	k, err := s.leasedKey(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	k.Lock()
+	defer k.Unlock()
+	if err := s.leaseLocked(ctx, k, pubKey, req.Holder); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkProposal(ctx, pubKey, signingRoot, slot); err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"publicKey": fmt.Sprintf("%#x", pubKey),
+			"holder":    req.Holder,
+			"slot":      slot,
+		}).Warn("Rejected slashable block proposal")
+		return nil, err
+	}
+	if err := s.valDB.SaveProposalHistoryForSlot(ctx, pubKey, slot, signingRoot[:]); err != nil {
+		return nil, status.Errorf(codes.Internal, "could not save proposal history: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// checkProposal checks the block proposal against the slashing protection history of the public key, the same
+// way validator clients check it against their local history.
+func (s *Server) checkProposal(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoot [32]byte, slot types.Slot,
+) error {
+	prevSigningRoot, proposalAtSlotExists, err := s.valDB.ProposalHistoryForSlot(ctx, pubKey, slot)
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not get proposal history: %v", err)
+	}
+	lowestSignedProposalSlot, lowestProposalExists, err := s.valDB.LowestSignedProposal(ctx, pubKey)
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not get lowest signed proposal: %v", err)
+	}
+	// An empty signing root in the history is considered slashable, as the signed block is unknown.
+	signingRootIsDifferent := prevSigningRoot == params.BeaconConfig().ZeroHash || prevSigningRoot != signingRoot
+	if proposalAtSlotExists && signingRootIsDifferent {
+		return status.Errorf(codes.FailedPrecondition, "a different block was already signed at slot %d", slot)
+	}
+	// Based on EIP3076, refuse to sign any proposal with slot less than or equal to the minimum signed proposal
+	// of the public key.
+	if lowestProposalExists && signingRootIsDifferent && lowestSignedProposalSlot >= slot {
+		return status.Errorf(
+			codes.FailedPrecondition,
+			"could not sign block with slot <= lowest signed slot in db, lowest signed slot: %d >= block slot: %d",
+			lowestSignedProposalSlot,
+			slot,
+		)
+	}
+	return nil
+}
+
+func publicKey(b []byte) ([fieldparams.BLSPubkeyLength]byte, error) {
+	if len(b) != fieldparams.BLSPubkeyLength {
+		return [fieldparams.BLSPubkeyLength]byte{}, status.Errorf(
+			codes.InvalidArgument, "public key length %d, expected %d", len(b), fieldparams.BLSPubkeyLength,
+		)
+	}
+	return bytesutil.ToBytes48(b), nil
+}
+
+func signingRoot(b []byte) ([32]byte, error) {
+	if len(b) != 32 {
+		return [32]byte{}, status.Errorf(codes.InvalidArgument, "signing root length %d, expected 32", len(b))
+	}
+	return bytesutil.ToBytes32(b), nil
+}
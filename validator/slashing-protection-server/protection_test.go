@@ -0,0 +1,177 @@
+package slashingprotection
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func setupServer(t *testing.T, pubKeys ...[fieldparams.BLSPubkeyLength]byte) *Server {
+	return NewServer(context.Background(), &Config{
+		ValDB: dbtest.SetupDB(t, pubKeys),
+	})
+}
+
+func TestServer_Leases(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	s := setupServer(t, pubKey)
+	ctx := context.Background()
+
+	lease, err := s.AcquireLease(ctx, &validatorpb.LeaseRequest{PublicKey: pubKey[:], Holder: "a"})
+	require.NoError(t, err)
+	assert.Equal(t, "a", lease.Holder)
+
+	// The lease is renewed by its holder, and denied to other holders.
+	_, err = s.AcquireLease(ctx, &validatorpb.LeaseRequest{PublicKey: pubKey[:], Holder: "a"})
+	require.NoError(t, err)
+	_, err = s.AcquireLease(ctx, &validatorpb.LeaseRequest{PublicKey: pubKey[:], Holder: "b"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.CheckAndSaveProposal(ctx, &validatorpb.SlashableProposalRequest{
+		Holder:      "b",
+		PublicKey:   pubKey[:],
+		SigningRoot: make([]byte, 32),
+		Slot:        1,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Releasing a lease held by another holder has no effect.
+	_, err = s.ReleaseLease(ctx, &validatorpb.LeaseRequest{PublicKey: pubKey[:], Holder: "b"})
+	require.NoError(t, err)
+	_, err = s.AcquireLease(ctx, &validatorpb.LeaseRequest{PublicKey: pubKey[:], Holder: "b"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = s.ReleaseLease(ctx, &validatorpb.LeaseRequest{PublicKey: pubKey[:], Holder: "a"})
+	require.NoError(t, err)
+	lease, err = s.AcquireLease(ctx, &validatorpb.LeaseRequest{PublicKey: pubKey[:], Holder: "b"})
+	require.NoError(t, err)
+	assert.Equal(t, "b", lease.Holder)
+
+	// An expired lease can be acquired by another holder.
+	k, err := s.leasedKey(ctx, pubKey)
+	require.NoError(t, err)
+	k.expiry = time.Now().Add(-time.Second)
+	lease, err = s.AcquireLease(ctx, &validatorpb.LeaseRequest{PublicKey: pubKey[:], Holder: "a"})
+	require.NoError(t, err)
+	assert.Equal(t, "a", lease.Holder)
+
+	_, err = s.AcquireLease(ctx, &validatorpb.LeaseRequest{PublicKey: pubKey[:]})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.AcquireLease(ctx, &validatorpb.LeaseRequest{PublicKey: []byte{1}, Holder: "a"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_Leases_Persisted(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	valDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+	ctx := context.Background()
+	s := NewServer(ctx, &Config{ValDB: valDB})
+	_, err := s.AcquireLease(ctx, &validatorpb.LeaseRequest{PublicKey: pubKey[:], Holder: "a"})
+	require.NoError(t, err)
+
+	// The lease is still held after a restart of the server.
+	s = NewServer(ctx, &Config{ValDB: valDB})
+	_, err = s.AcquireLease(ctx, &validatorpb.LeaseRequest{PublicKey: pubKey[:], Holder: "b"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = s.ReleaseLease(ctx, &validatorpb.LeaseRequest{PublicKey: pubKey[:], Holder: "a"})
+	require.NoError(t, err)
+	s = NewServer(ctx, &Config{ValDB: valDB})
+	lease, err := s.AcquireLease(ctx, &validatorpb.LeaseRequest{PublicKey: pubKey[:], Holder: "b"})
+	require.NoError(t, err)
+	assert.Equal(t, "b", lease.Holder)
+}
+
+func TestServer_CheckAndSaveAttestation(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	s := setupServer(t, pubKey)
+	ctx := context.Background()
+	attest := func(signingRoot byte, source, target uint64) error {
+		_, err := s.CheckAndSaveAttestation(ctx, &validatorpb.SlashableAttestationRequest{
+			Holder:      "a",
+			PublicKey:   pubKey[:],
+			SigningRoot: bytesOf(signingRoot),
+			SourceEpoch: source,
+			TargetEpoch: target,
+		})
+		return err
+	}
+
+	require.NoError(t, attest(1, 2, 3))
+	// The same attestation can be signed again.
+	require.NoError(t, attest(1, 2, 3))
+	// Double vote.
+	assert.Equal(t, codes.FailedPrecondition, status.Code(attest(2, 2, 3)))
+	// Surrounding vote.
+	require.NoError(t, attest(3, 4, 5))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(attest(4, 3, 6)))
+	// Source lower than the lowest signed source.
+	assert.Equal(t, codes.FailedPrecondition, status.Code(attest(5, 1, 7)))
+	require.NoError(t, attest(6, 5, 7))
+
+	_, err := s.AcquireLease(ctx, &validatorpb.LeaseRequest{PublicKey: pubKey[:], Holder: "b"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, codes.InvalidArgument, status.Code(attest(7, 8, 7)))
+}
+
+func TestServer_CheckAndSaveProposal(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	s := setupServer(t, pubKey)
+	ctx := context.Background()
+	propose := func(signingRoot byte, slot uint64) error {
+		_, err := s.CheckAndSaveProposal(ctx, &validatorpb.SlashableProposalRequest{
+			Holder:      "a",
+			PublicKey:   pubKey[:],
+			SigningRoot: bytesOf(signingRoot),
+			Slot:        slot,
+		})
+		return err
+	}
+
+	require.NoError(t, propose(1, 10))
+	require.NoError(t, propose(1, 10))
+	// Double proposal.
+	assert.Equal(t, codes.FailedPrecondition, status.Code(propose(2, 10)))
+	// Slot lower than the lowest signed slot.
+	assert.Equal(t, codes.FailedPrecondition, status.Code(propose(3, 9)))
+	require.NoError(t, propose(4, 11))
+}
+
+func TestServer_CheckAndSaveAttestation_Concurrent(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	s := setupServer(t, pubKey)
+	ctx := context.Background()
+
+	// Two validator clients racing to sign conflicting attestations: only one of them succeeds.
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = s.CheckAndSaveAttestation(ctx, &validatorpb.SlashableAttestationRequest{
+				Holder:      "a",
+				PublicKey:   pubKey[:],
+				SigningRoot: bytesOf(byte(i + 1)),
+				SourceEpoch: 1,
+				TargetEpoch: 2,
+			})
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, true, (errs[0] == nil) != (errs[1] == nil), "Exactly one attestation should be signed")
+}
+
+func bytesOf(b byte) []byte {
+	r := make([]byte, 32)
+	r[0] = b
+	return r
+}
@@ -0,0 +1,143 @@
+// Package slashingprotection defines a gRPC server which keeps the slashing protection history of validators on
+// behalf of several validator clients. The validator clients consult it before signing, and each public key is
+// leased to a single validator client at a time, so that validator clients running the same keys for high
+// availability never both sign for a key.
+package slashingprotection
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	grpcprometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/monitoring/tracing"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/plugin/ocgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Config options for the slashing protection server.
+type Config struct {
+	Host     string
+	Port     int
+	CertFlag string
+	KeyFlag  string
+	ValDB    db.Database
+	// LeaseDuration is the time a public key stays leased to a validator client after it last acquired or
+	// renewed the lease. Defaults to two epochs.
+	LeaseDuration time.Duration
+}
+
+// Server serves the slashing protection history of a validator database to validator clients.
+type Server struct {
+	ctx           context.Context
+	cancel        context.CancelFunc
+	host          string
+	port          int
+	withCert      string
+	withKey       string
+	valDB         db.Database
+	leaseDuration time.Duration
+	grpcServer    *grpc.Server
+	listener      net.Listener
+	startFailure  error
+	keysLock      sync.Mutex
+	keys          map[[fieldparams.BLSPubkeyLength]byte]*leasedKey
+}
+
+var _ validatorpb.RemoteSlashingProtectionServer = (*Server)(nil)
+
+// NewServer instantiates a new slashing protection server.
+func NewServer(ctx context.Context, cfg *Config) *Server {
+	ctx, cancel := context.WithCancel(ctx)
+	leaseDuration := cfg.LeaseDuration
+	if leaseDuration == 0 {
+		slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+		leaseDuration = 2 * time.Duration(slotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second
+	}
+	return &Server{
+		ctx:           ctx,
+		cancel:        cancel,
+		host:          cfg.Host,
+		port:          cfg.Port,
+		withCert:      cfg.CertFlag,
+		withKey:       cfg.KeyFlag,
+		valDB:         cfg.ValDB,
+		leaseDuration: leaseDuration,
+		keys:          make(map[[fieldparams.BLSPubkeyLength]byte]*leasedKey),
+	}
+}
+
+// Start the gRPC server.
+func (s *Server) Start() {
+	address := fmt.Sprintf("%s:%d", s.host, s.port)
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		log.WithError(err).Errorf("Could not listen to port in Start() %s", address)
+		s.startFailure = err
+		return
+	}
+	s.listener = lis
+
+	opts := []grpc.ServerOption{
+		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
+		grpc.UnaryInterceptor(middleware.ChainUnaryServer(
+			recovery.UnaryServerInterceptor(
+				recovery.WithRecoveryHandlerContext(tracing.RecoveryHandlerFunc),
+			),
+			grpcprometheus.UnaryServerInterceptor,
+		)),
+	}
+	if s.withCert != "" && s.withKey != "" {
+		creds, err := credentials.NewServerTLSFromFile(s.withCert, s.withKey)
+		if err != nil {
+			log.WithError(err).Error("Could not load TLS keys")
+			s.startFailure = err
+			return
+		}
+		opts = append(opts, grpc.Creds(creds))
+		log.WithFields(logrus.Fields{
+			"crt-path": s.withCert,
+			"key-path": s.withKey,
+		}).Info("Loaded TLS certificates")
+	} else {
+		log.Warn("You are using an insecure gRPC server. Validator clients can only trust the slashing " +
+			"protection server over a secure connection, provide a TLS certificate and key")
+	}
+	s.grpcServer = grpc.NewServer(opts...)
+	validatorpb.RegisterRemoteSlashingProtectionServer(s.grpcServer, s)
+
+	go func() {
+		if err := s.grpcServer.Serve(s.listener); err != nil {
+			log.WithError(err).Error("Could not serve")
+		}
+	}()
+	log.WithFields(logrus.Fields{
+		"address":       address,
+		"leaseDuration": s.leaseDuration,
+	}).Info("Slashing protection server listening on address")
+}
+
+// Stop the gRPC server.
+func (s *Server) Stop() error {
+	s.cancel()
+	if s.listener != nil {
+		s.grpcServer.GracefulStop()
+		log.Debug("Initiated graceful stop of server")
+	}
+	return nil
+}
+
+// Status returns an error if the server could not start.
+func (s *Server) Status() error {
+	return s.startFailure
+}